@@ -0,0 +1,59 @@
+package baseline_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/qnighy/bqpb/baseline/bqassert"
+	"github.com/qnighy/bqpb/baseline/bqpb"
+)
+
+// TestAssertScript generates testdata/assert.sql, the BigQuery script
+// checking the UDF in dist/bqpb.sql against the Go port on the inputs of the
+// serialization cases. Run it in a sandbox project before upgrading the UDF;
+// regenerate it with -update after changing the cases or the UDF.
+//
+// The cases with outputs too long for the other golden files, which are
+// those of the recursion limits, are left out: they nest deeper than the JSON
+// type allows, and would make the script longer than a query may be. So are
+// the cases on which the Go port differs from bqpb.ts.
+func TestAssertScript(t *testing.T) {
+	udf, err := os.ReadFile("../dist/bqpb.sql")
+	if err != nil {
+		t.Fatalf("ReadFile error: %v\n", err)
+	}
+	var cases []*bqassert.Case
+	for _, tc := range serializationTestCases() {
+		if tc.bqpbTS != "" {
+			continue
+		}
+		md := tc.datatype.ProtoReflect().Descriptor()
+		typedefs := corpusTypedefs(md)
+		typedefsJSON, err := json.Marshal(typedefs)
+		if err != nil {
+			t.Fatalf("Marshal error: %v\n", err)
+		}
+		c := &bqassert.Case{
+			Name:        tc.name,
+			Input:       tc.data,
+			MessageType: string(md.FullName()),
+			Typedefs:    string(typedefsJSON),
+		}
+		got, err := bqpb.Parse(tc.data, c.MessageType, *typedefs)
+		if err != nil {
+			c.WantErr = err.Error()
+		} else if abbreviate(string(got)) != string(got) {
+			continue
+		} else {
+			c.Want = string(got)
+		}
+		cases = append(cases, c)
+	}
+	var buf bytes.Buffer
+	if err := bqassert.Write(&buf, string(udf), cases); err != nil {
+		t.Fatalf("Write error: %v\n", err)
+	}
+	checkGolden(t, "testdata/assert.sql", buf.Bytes())
+}
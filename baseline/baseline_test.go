@@ -1,4 +1,4 @@
-//go:generate ./gen.sh
+//go:generate go run ./fixturegen/gen
 package baseline_test
 
 import (
@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -19,13 +20,66 @@ import (
 	"github.com/qnighy/bqpb/baseline/examplepb"
 )
 
-func TestSerialization(t *testing.T) {
-	testcases := []struct {
-		name     string
-		data     []byte
-		datatype protoreflect.ProtoMessage
-		want     string
-	}{
+type serializationTestCase struct {
+	name     string
+	data     []byte
+	datatype protoreflect.ProtoMessage
+	want     string
+	// wantErr is a substring of the expected error from proto.Unmarshal.
+	wantErr string
+	// deviation is set if bqpb intentionally differs from protojson.
+	deviation *deviation
+	// bqpbTS is set if bqpb.ts differs from the Go port on the case, to the
+	// output of bqpb.ts; see the package doc of bqpb. The assert script
+	// leaves such cases out.
+	bqpbTS string
+}
+
+// deviation is a known difference between bqpb and protojson on a
+// serialization case. Do not make a case with a deviation match protojson
+// without changing bqpb.ts first.
+type deviation struct {
+	// want is the output of bqpb, or "error: " followed by its error.
+	want   string
+	reason string
+	// spec links to the behavior of protobuf that bqpb deviates from.
+	spec string
+}
+
+// with returns a copy of the deviation with the given output of bqpb.
+func (d deviation) with(want string) *deviation {
+	d.want = want
+	return &d
+}
+
+// Deviations shared by several serialization cases.
+var (
+	deviationWireType = deviation{
+		reason: "bqpb rejects wire type mismatches; protobuf-go keeps such fields as unknown fields",
+		spec:   "https://protobuf.dev/programming-guides/proto3/#unknowns",
+	}
+	deviationUnsetMessage = deviation{
+		reason: "bqpb omits unset message fields; protojson emits null with EmitUnpopulated, which BigQuery keeps",
+		spec:   "https://protobuf.dev/programming-guides/json/",
+	}
+	deviationMissingKey = deviation{
+		reason: "bqpb drops map entries without a key; protobuf-go uses the zero key",
+		spec:   "https://protobuf.dev/programming-guides/encoding/#maps",
+	}
+	deviationMissingValue = deviation{
+		reason: "bqpb uses null for missing message values; protobuf-go uses an empty message",
+		spec:   "https://protobuf.dev/programming-guides/encoding/#maps",
+	}
+	deviationRecursion = deviation{
+		reason: "bqpb.ts has no recursion limit and throws a RangeError when V8 runs out of stack, after 964 to 1150 levels of nesting; protobuf-go allows 10000",
+		spec:   "https://github.com/qnighy/bqpb/blob/main/bqpb.ts",
+	}
+)
+
+// serializationTestCases returns the golden cases checked against protojson.
+// Other tests also reuse their inputs.
+func serializationTestCases() []serializationTestCase {
+	return []serializationTestCase{
 		{
 			name:     "Parse field with implicit presence of size 1",
 			data:     []byte("\x08\x01"),
@@ -254,6 +308,136 @@ func TestSerialization(t *testing.T) {
 			datatype: &examplepb.RepeatedFixed64{},
 			want:     `{"myField":["0","1","2","18446744073709551615"]}`,
 		},
+		{
+			name:     "packed enum",
+			data:     []byte("\x0a\x04\x00\x01\x02\x03"),
+			datatype: &examplepb.RepeatedEnum{},
+			want:     `{"myField":["MY_ENUM_UNSPECIFIED","MY_ENUM_VALUE_1","MY_ENUM_VALUE_2",3]}`,
+		},
+		{
+			name:     "packed bool",
+			data:     []byte("\x0a\x03\x00\x01\x02"),
+			datatype: &examplepb.RepeatedBool{},
+			want:     `{"myField":[false,true,true]}`,
+		},
+		{
+			name:     "packed sint32",
+			data:     []byte("\x0a\x05\x00\x01\x02\x03\x04"),
+			datatype: &examplepb.RepeatedSint32{},
+			want:     `{"myField":[0,-1,1,-2,2]}`,
+		},
+		{
+			name:     "packed sint64",
+			data:     []byte("\x0a\x05\x00\x01\x02\x03\x04"),
+			datatype: &examplepb.RepeatedSint64{},
+			want:     `{"myField":["0","-1","1","-2","2"]}`,
+		},
+		{
+			name: "packed float",
+			data: []byte(
+				"\x0a\x0c" +
+					"\x00\x00\x00\x00" +
+					"\x00\x00\xc0\x3f" +
+					"\x00\x00\x80\xff",
+			),
+			datatype: &examplepb.RepeatedFloat{},
+			want:     `{"myField":[0,1.5,"-Infinity"]}`,
+		},
+		{
+			name: "packed double",
+			data: []byte(
+				"\x0a\x10" +
+					"\x00\x00\x00\x00\x00\x00\xf8\xbf" +
+					"\x00\x00\x00\x00\x00\x00\xf8\x7f",
+			),
+			datatype: &examplepb.RepeatedDouble{},
+			want:     `{"myField":[-1.5,"NaN"]}`,
+		},
+		{
+			name: "packed and expanded interleaved",
+			data: []byte(
+				"" +
+					"\x08\x01" +
+					"\x0a\x02\x02\x03" +
+					"\x08\x04" +
+					"\x0a\x01\x05",
+			),
+			datatype: &examplepb.RepeatedUint32{},
+			want:     `{"myField":[1,2,3,4,5]}`,
+		},
+		{
+			name: "packed and expanded I32 interleaved",
+			data: []byte(
+				"" +
+					"\x0d\x01\x00\x00\x00" +
+					"\x0a\x08\x02\x00\x00\x00\x03\x00\x00\x00" +
+					"\x0d\xff\xff\xff\xff",
+			),
+			datatype: &examplepb.RepeatedSfixed32{},
+			want:     `{"myField":[1,2,3,-1]}`,
+		},
+		{
+			name:     "empty packed",
+			data:     []byte("\x0a\x00"),
+			datatype: &examplepb.RepeatedUint32{},
+			want:     `{"myField":[]}`,
+		},
+		{
+			name:     "empty packed between expanded",
+			data:     []byte("\x08\x01\x0a\x00\x08\x02\x0a\x00"),
+			datatype: &examplepb.RepeatedUint32{},
+			want:     `{"myField":[1,2]}`,
+		},
+		{
+			name:     "packed varint with truncated final element",
+			data:     []byte("\x0a\x02\x01\x80"),
+			datatype: &examplepb.RepeatedUint32{},
+			wantErr:  "cannot parse invalid wire-format data",
+		},
+		{
+			name:     "varint wider than 64 bits",
+			data:     []byte("\x08\xff\xff\xff\xff\xff\xff\xff\xff\xff\x03"),
+			datatype: &examplepb.ImplicitUint32{},
+			wantErr:  "cannot parse invalid wire-format data",
+			bqpbTS:   `{"myField":36893488147419103000}`,
+		},
+		{
+			name:     "varint longer than 10 bytes",
+			data:     []byte("\x08\x81\x80\x80\x80\x80\x80\x80\x80\x80\x80\x00"),
+			datatype: &examplepb.ImplicitUint32{},
+			wantErr:  "cannot parse invalid wire-format data",
+			bqpbTS:   `{"myField":1}`,
+		},
+		{
+			name:     "packed I32 with truncated final element",
+			data:     []byte("\x0a\x06\x01\x00\x00\x00\x02\x00"),
+			datatype: &examplepb.RepeatedFixed32{},
+			wantErr:  "cannot parse invalid wire-format data",
+		},
+		{
+			name:     "packed I64 with truncated final element",
+			data:     []byte("\x0a\x0a\x01\x00\x00\x00\x00\x00\x00\x00\x02\x00"),
+			datatype: &examplepb.RepeatedFixed64{},
+			wantErr:  "cannot parse invalid wire-format data",
+		},
+		{
+			// Singular fields are not packable; protobuf-go keeps the
+			// mismatching occurrence as an unknown field. bqpb fails instead.
+			name:      "LEN-encoded singular uint32",
+			data:      []byte("\x0a\x01\x05"),
+			datatype:  &examplepb.ImplicitUint32{},
+			want:      `{"myField":0}`,
+			deviation: deviationWireType.with("error: Expected wire type 0, got 2"),
+		},
+		{
+			// string is not packable; protobuf-go keeps the mismatching
+			// occurrence as an unknown field. bqpb fails instead.
+			name:      "VARINT-encoded repeated string",
+			data:      []byte("\x0a\x01a\x08\x01"),
+			datatype:  &examplepb.RepeatedString{},
+			want:      `{"myField":["a"]}`,
+			deviation: deviationWireType.with("error: Expected wire type 2, got 0"),
+		},
 		{
 			name:     "bytes",
 			data:     []byte("\x0a\x00\x0a\x06\x00\x01\x02\x80\x81\x82"),
@@ -273,10 +457,11 @@ func TestSerialization(t *testing.T) {
 			want:     `{"myField":[{"submessageField":[42]}]}`,
 		},
 		{
-			name:     "submessage with implicit presence with default value",
-			data:     []byte(""),
-			datatype: &examplepb.ImplicitSubmessage{},
-			want:     `{"myField":null}`,
+			name:      "submessage with implicit presence with default value",
+			data:      []byte(""),
+			datatype:  &examplepb.ImplicitSubmessage{},
+			want:      `{"myField":null}`,
+			deviation: deviationUnsetMessage.with(`{}`),
 		},
 		{
 			name:     "submessage with explicit presence with default value",
@@ -374,6 +559,167 @@ func TestSerialization(t *testing.T) {
 			datatype: &examplepb.MapStringUint32{},
 			want:     `{"myField":{"あ":0,"い":0}}`,
 		},
+		{
+			name: "map: duplicate key",
+			data: []byte(
+				"" +
+					"\x0a\x05\x0a\x01a\x10\x01" +
+					"\x0a\x05\x0a\x01a\x10\x02",
+			),
+			datatype: &examplepb.MapStringUint32{},
+			want:     `{"myField":{"a":2}}`,
+		},
+		{
+			// bqpb drops the entry instead.
+			name: "map: missing key",
+			data: []byte(
+				"\x0a\x02\x10\x64",
+			),
+			datatype:  &examplepb.MapStringUint32{},
+			want:      `{"myField":{"":100}}`,
+			deviation: deviationMissingKey.with(`{"myField":{}}`),
+		},
+		{
+			// bqpb drops the entry instead.
+			name: "map: empty entry",
+			data: []byte(
+				"\x0a\x00",
+			),
+			datatype:  &examplepb.MapStringUint32{},
+			want:      `{"myField":{"":0}}`,
+			deviation: deviationMissingKey.with(`{"myField":{}}`),
+		},
+		{
+			name: "map: duplicate key within entry",
+			data: []byte(
+				"\x0a\x08\x0a\x01a\x0a\x01b\x10\x64",
+			),
+			datatype: &examplepb.MapStringUint32{},
+			want:     `{"myField":{"b":100}}`,
+		},
+		{
+			name: "map: unknown fields in entry",
+			data: []byte(
+				"\x0a\x0e\x18\x07\x0a\x01a\x22\x00\x10\x64\x2d\x01\x00\x00\x00",
+			),
+			datatype: &examplepb.MapStringUint32{},
+			want:     `{"myField":{"a":100}}`,
+		},
+		{
+			name: "map: message value",
+			data: []byte(
+				"\x0a\x07\x0a\x01a\x12\x02\x08\x2a",
+			),
+			datatype: &examplepb.MapStringSubmessage{},
+			want:     `{"myField":{"a":{"submessageField":[42]}}}`,
+		},
+		{
+			// bqpb emits null instead.
+			name: "map: missing message value",
+			data: []byte(
+				"\x0a\x03\x0a\x01a",
+			),
+			datatype:  &examplepb.MapStringSubmessage{},
+			want:      `{"myField":{"a":{"submessageField":[]}}}`,
+			deviation: deviationMissingValue.with(`{"myField":{"a":null}}`),
+		},
+		{
+			// bqpb only picks the last occurrence instead.
+			name: "map: message value split in entry",
+			data: []byte(
+				"\x0a\x0b\x0a\x01a\x12\x02\x08\x01\x12\x02\x08\x02",
+			),
+			datatype: &examplepb.MapStringSubmessage{},
+			want:     `{"myField":{"a":{"submessageField":[1,2]}}}`,
+			deviation: &deviation{
+				want:   `{"myField":{"a":{"submessageField":[2]}}}`,
+				reason: "bqpb takes the last occurrence of a map value; protobuf-go merges them",
+				spec:   "https://protobuf.dev/programming-guides/encoding/#last-one-wins",
+			},
+		},
+		{
+			name: "map: enum value",
+			data: []byte(
+				"" +
+					"\x0a\x05\x0a\x01a\x10\x01" +
+					"\x0a\x05\x0a\x01b\x10\x05" +
+					"\x0a\x03\x0a\x01c",
+			),
+			datatype: &examplepb.MapStringEnum{},
+			want:     `{"myField":{"a":"MY_ENUM_VALUE_1","b":5,"c":"MY_ENUM_UNSPECIFIED"}}`,
+		},
+		{
+			// bqpb emits null for the missing value instead.
+			name: "map: wrapper value",
+			data: []byte(
+				"" +
+					"\x0a\x07\x0a\x01a\x12\x02\x08\x2a" +
+					"\x0a\x05\x0a\x01b\x12\x00" +
+					"\x0a\x03\x0a\x01c",
+			),
+			datatype:  &examplepb.MapStringUint32Wrapper{},
+			want:      `{"myField":{"a":42,"b":0,"c":0}}`,
+			deviation: deviationMissingValue.with(`{"myField":{"a":42,"b":0,"c":null}}`),
+		},
+		{
+			// bqpb emits null for the missing value instead.
+			name: "map: Struct value",
+			data: []byte(
+				"" +
+					"\x0a\x0e\x0a\x01a\x12\x09\x0a\x07\x0a\x01x\x12\x02\x08\x00" +
+					"\x0a\x03\x0a\x01b",
+			),
+			datatype:  &examplepb.MapStringStruct{},
+			want:      `{"myField":{"a":{"x":null},"b":{}}}`,
+			deviation: deviationMissingValue.with(`{"myField":{"a":{"x":null},"b":null}}`),
+		},
+		{
+			// bqpb keeps "1" first, as JavaScript puts array-index-like keys first.
+			name: "map: int64 key",
+			data: []byte(
+				"" +
+					"\x0a\x04\x08\x01\x10\x64" +
+					"\x0a\x0d\x08\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01\x10\x65" +
+					"\x0a\x0b\x08\x81\x80\x80\x80\x80\x80\x80\x10\x10\x66",
+			),
+			datatype: &examplepb.MapInt64Uint32{},
+			want:     `{"myField":{"-1":101,"1":100,"9007199254740993":102}}`,
+		},
+		{
+			// bqpb keeps "1" first, as JavaScript puts array-index-like keys first.
+			name: "map: sint64 key",
+			data: []byte(
+				"" +
+					"\x0a\x04\x08\x02\x10\x64" +
+					"\x0a\x04\x08\x01\x10\x65" +
+					"\x0a\x0b\x08\x82\x80\x80\x80\x80\x80\x80\x20\x10\x66",
+			),
+			datatype: &examplepb.MapSint64Uint32{},
+			want:     `{"myField":{"-1":101,"1":100,"9007199254740993":102}}`,
+		},
+		{
+			// bqpb keeps "1" first, as JavaScript puts array-index-like keys first.
+			name: "map: sfixed64 key",
+			data: []byte(
+				"" +
+					"\x0a\x0b\x09\x01\x00\x00\x00\x00\x00\x00\x00\x10\x64" +
+					"\x0a\x0b\x09\xff\xff\xff\xff\xff\xff\xff\xff\x10\x65" +
+					"\x0a\x0b\x09\x01\x00\x00\x00\x00\x00\x20\x00\x10\x66",
+			),
+			datatype: &examplepb.MapSfixed64Uint32{},
+			want:     `{"myField":{"-1":101,"1":100,"9007199254740993":102}}`,
+		},
+		{
+			// bqpb keeps the order of appearance instead.
+			name: "map: bool key ordering",
+			data: []byte(
+				"" +
+					"\x0a\x04\x08\x01\x10\x65" +
+					"\x0a\x04\x08\x00\x10\x64",
+			),
+			datatype: &examplepb.MapBoolUint32{},
+			want:     `{"myField":{"false":100,"true":101}}`,
+		},
 		{
 			name: "group",
 			data: []byte(
@@ -383,6 +729,123 @@ func TestSerialization(t *testing.T) {
 			datatype: &example2pb.RepeatedGroup{},
 			want:     `{"myField":[{"submessageField":[42]}]}`,
 		},
+		{
+			name:     "optional group",
+			data:     []byte("\x0b\x08\x2a\x0c"),
+			datatype: &example2pb.OptionalGroup{},
+			want:     `{"myField":{"submessageField":42}}`,
+		},
+		{
+			// protojson emits null for unpopulated proto2 scalars; bqpb omits them.
+			name:      "optional group: empty",
+			data:      []byte("\x0b\x0c"),
+			datatype:  &example2pb.OptionalGroup{},
+			want:      `{"myField":{"submessageField":null}}`,
+			deviation: deviationUnsetMessage.with(`{"myField":{}}`),
+		},
+		{
+			// bqpb omits the field instead.
+			name:      "optional group: missing",
+			data:      []byte(""),
+			datatype:  &example2pb.OptionalGroup{},
+			want:      `{"myField":null}`,
+			deviation: deviationUnsetMessage.with(`{}`),
+		},
+		{
+			// protobuf-go keeps the mismatching occurrence as an unknown field.
+			// bqpb fails instead.
+			name:      "optional group: LEN-encoded",
+			data:      []byte("\x0a\x02\x08\x2a"),
+			datatype:  &example2pb.OptionalGroup{},
+			want:      `{"myField":null}`,
+			deviation: deviationWireType.with("error: Expected wire type 3, got 2"),
+		},
+		{
+			name:     "nested group",
+			data:     []byte("\x0b\x13\x18\x2a\x14\x0c"),
+			datatype: &example2pb.NestedGroup{},
+			want:     `{"outerField":{"innerField":{"submessageField":42}}}`,
+		},
+		{
+			name:     "group in oneof",
+			data:     []byte("\x13\x08\x2a\x14"),
+			datatype: &example2pb.OneofGroup{},
+			want:     `{"groupField":{"submessageField":42}}`,
+		},
+		{
+			// bqpb emits both members instead.
+			name:     "group in oneof followed by another member",
+			data:     []byte("\x13\x08\x2a\x14\x08\x01"),
+			datatype: &example2pb.OneofGroup{},
+			want:     `{"uint32Field":1}`,
+			deviation: &deviation{
+				want:   `{"uint32Field":1,"groupField":{"submessageField":42}}`,
+				reason: "bqpb does not enforce oneofs and emits every member present",
+				spec:   "https://protobuf.dev/programming-guides/proto3/#oneof",
+			},
+		},
+		{
+			// bqpb additionally emits the group as "#2".
+			name:     "unknown group",
+			data:     []byte("\x13\x08\x2a\x14"),
+			datatype: &example2pb.RepeatedGroup{},
+			want:     `{"myField":[]}`,
+			deviation: &deviation{
+				want:   `{"myField":[],"#2":{"#1":"unknown:int32:42"}}`,
+				reason: "bqpb emits unknown fields; protojson drops them",
+				spec:   "https://protobuf.dev/programming-guides/proto3/#unknowns",
+			},
+		},
+		{
+			name:     "group: END_GROUP with wrong field number",
+			data:     []byte("\x0b\x08\x2a\x14"),
+			datatype: &example2pb.OptionalGroup{},
+			wantErr:  "cannot parse invalid wire-format data",
+		},
+		{
+			name:     "unknown group: END_GROUP with wrong field number",
+			data:     []byte("\x13\x1c"),
+			datatype: &example2pb.OptionalGroup{},
+			wantErr:  "cannot parse invalid wire-format data",
+		},
+		{
+			name:     "group: stray END_GROUP",
+			data:     []byte("\x0c"),
+			datatype: &example2pb.OptionalGroup{},
+			wantErr:  "cannot parse invalid wire-format data",
+		},
+		{
+			name:     "group: missing END_GROUP",
+			data:     []byte("\x0b\x08\x2a"),
+			datatype: &example2pb.OptionalGroup{},
+			wantErr:  "cannot parse invalid wire-format data",
+		},
+		{
+			name:      "group: recursion at the limit",
+			data:      recursiveGroup(4999),
+			datatype:  &example2pb.RecursiveGroup{},
+			want:      strings.Repeat(`{"myField":{"recursiveField":`, 4999) + `{"myField":null}` + strings.Repeat(`}}`, 4999),
+			deviation: deviationRecursion.with("error: Maximum call stack size exceeded"),
+		},
+		{
+			name:     "group: recursion beyond the limit",
+			data:     recursiveGroup(5000),
+			datatype: &example2pb.RecursiveGroup{},
+			wantErr:  "exceeded maximum recursion depth",
+		},
+		{
+			name:      "unknown group: recursion at the limit",
+			data:      []byte(strings.Repeat("\x13", 10001) + strings.Repeat("\x14", 10001)),
+			datatype:  &example2pb.OptionalGroup{},
+			want:      `{"myField":null}`,
+			deviation: deviationRecursion.with("error: Maximum call stack size exceeded"),
+		},
+		{
+			name:     "unknown group: recursion beyond the limit",
+			data:     []byte(strings.Repeat("\x13", 10002) + strings.Repeat("\x14", 10002)),
+			datatype: &example2pb.OptionalGroup{},
+			wantErr:  "cannot parse invalid wire-format data",
+		},
 		{
 			name: "oneof",
 			data: []byte(
@@ -392,10 +855,11 @@ func TestSerialization(t *testing.T) {
 			want:     `{"stringField":"あ"}`,
 		},
 		{
-			name:     "wrapper: missing",
-			data:     []byte(""),
-			datatype: &examplepb.ImplicitUint32Wrapper{},
-			want:     `{"myField":null}`,
+			name:      "wrapper: missing",
+			data:      []byte(""),
+			datatype:  &examplepb.ImplicitUint32Wrapper{},
+			want:      `{"myField":null}`,
+			deviation: deviationUnsetMessage.with(`{}`),
 		},
 		{
 			name:     "wrapper: empty",
@@ -476,10 +940,19 @@ func TestSerialization(t *testing.T) {
 			want:     `{"@type":"type.googleapis.com/google.protobuf.FieldMask","value":"fooBar.baz,pork.eggHam"}`,
 		},
 	}
-	for _, tc := range testcases {
+}
+
+func TestSerialization(t *testing.T) {
+	for _, tc := range serializationTestCases() {
 		t.Run(tc.name, func(t *testing.T) {
 			msg := tc.datatype.ProtoReflect().Type().New().Interface()
 			err := proto.Unmarshal(tc.data, msg)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("Unmarshal error = %v, want %q\n", err, tc.wantErr)
+				}
+				return
+			}
 			if err != nil {
 				t.Fatalf("Unmarshal error: %v\n", err)
 			}
@@ -495,3 +968,19 @@ func TestSerialization(t *testing.T) {
 		})
 	}
 }
+
+// recursiveGroup returns example2.RecursiveGroup nested n times through its
+// group field. Each level consumes two levels of recursion in protobuf-go:
+// one for the group and one for the message inside it.
+func recursiveGroup(n int) []byte {
+	var data []byte
+	for i := 0; i < n; i++ {
+		var level []byte
+		level = protowire.AppendTag(level, 1, protowire.StartGroupType)
+		level = protowire.AppendTag(level, 2, protowire.BytesType)
+		level = protowire.AppendBytes(level, data)
+		level = protowire.AppendTag(level, 1, protowire.EndGroupType)
+		data = level
+	}
+	return data
+}
@@ -0,0 +1,43 @@
+package baseline_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/qnighy/bqpb/baseline/bqjson"
+	"github.com/qnighy/bqpb/baseline/bqpb"
+)
+
+// TestBigQueryDifferences records the serialization cases where bqpb and
+// protojson still differ once their outputs are stored as BigQuery JSON,
+// that is, the differences users can see. Differences in key order and in
+// number formatting disappear; omitted and null members do not.
+func TestBigQueryDifferences(t *testing.T) {
+	var buf bytes.Buffer
+	for _, tc := range serializationTestCases() {
+		if tc.wantErr != "" {
+			continue
+		}
+		md := tc.datatype.ProtoReflect().Descriptor()
+		got, err := bqpb.Parse(tc.data, string(md.FullName()), *corpusTypedefs(md))
+		if err != nil {
+			continue
+		}
+		want, err := bqjson.Canonicalize(tc.want, bqjson.Round)
+		if err != nil {
+			want = "error: " + err.Error()
+		}
+		gotCanonical, err := bqjson.Canonicalize(string(got), bqjson.Round)
+		if err != nil {
+			gotCanonical = "error: " + err.Error()
+		}
+		if want == gotCanonical {
+			continue
+		}
+		fmt.Fprintf(&buf, "=== %s\n", tc.name)
+		fmt.Fprintf(&buf, "protojson: %s\n", abbreviate(want))
+		fmt.Fprintf(&buf, "bqpb:      %s\n", abbreviate(gotCanonical))
+	}
+	checkGolden(t, "testdata/bigquery.golden", buf.Bytes())
+}
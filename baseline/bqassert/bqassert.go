@@ -0,0 +1,143 @@
+// Package bqassert generates BigQuery scripts asserting the outputs of
+// parseProtobuf.
+//
+// The script defines the UDF, then checks each case with ASSERT:
+//
+//	ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x01', 'Main', typedefs_1)) = TO_JSON_STRING(JSON '{"field1":1}') AS 'case name';
+//
+// BigQuery does not define equality on JSON, so both sides are compared
+// after TO_JSON_STRING, which normalizes them in the same way. Cases
+// expected to fail catch the error in an exception handler instead. The
+// typedefs are declared as variables once, as they are usually shared
+// among the cases.
+package bqassert
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Case is a call to parseProtobuf and its expected result.
+type Case struct {
+	// Name describes the case in the ASSERT statement.
+	Name        string
+	Input       []byte
+	MessageType string
+	// Typedefs is the typedefs in JSON.
+	Typedefs string
+	// Want is the expected output in JSON. It is ignored if WantErr is set.
+	Want string
+	// WantErr is the expected error message, which is only recorded in a
+	// comment: BigQuery decorates the errors thrown by UDFs.
+	WantErr string
+}
+
+// Write writes the script running the cases to w. udf is the definition of
+// parseProtobuf, as in dist/bqpb.sql.
+func Write(w io.Writer, udf string, cases []*Case) error {
+	var sb strings.Builder
+	sb.WriteString("-- Generated by the baseline tests; DO NOT EDIT.\n")
+
+	// DECLARE must come before any other statements.
+	typedefsVars := map[string]string{}
+	for _, c := range cases {
+		if _, ok := typedefsVars[c.Typedefs]; ok {
+			continue
+		}
+		name := fmt.Sprintf("typedefs_%d", len(typedefsVars)+1)
+		typedefsVars[c.Typedefs] = name
+		fmt.Fprintf(&sb, "DECLARE %s JSON DEFAULT JSON %s;\n", name, StringLiteral(c.Typedefs))
+	}
+	sb.WriteString("DECLARE result JSON;\n")
+	sb.WriteString("DECLARE failed BOOL;\n\n")
+
+	sb.WriteString(strings.TrimSpace(udf))
+	sb.WriteString("\n")
+
+	for _, c := range cases {
+		call := fmt.Sprintf("parseProtobuf(%s, %s, %s)", BytesLiteral(c.Input), StringLiteral(c.MessageType), typedefsVars[c.Typedefs])
+		sb.WriteString("\n")
+		if c.WantErr != "" {
+			fmt.Fprintf(&sb, "-- want error: %s\n", oneLine(c.WantErr))
+			sb.WriteString("BEGIN\n")
+			fmt.Fprintf(&sb, "  SET result = %s;\n", call)
+			sb.WriteString("  SET failed = FALSE;\n")
+			sb.WriteString("EXCEPTION WHEN ERROR THEN\n")
+			sb.WriteString("  SET failed = TRUE;\n")
+			sb.WriteString("END;\n")
+			fmt.Fprintf(&sb, "ASSERT failed AS %s;\n", StringLiteral(c.Name))
+			continue
+		}
+		fmt.Fprintf(&sb, "ASSERT TO_JSON_STRING(%s) = TO_JSON_STRING(JSON %s) AS %s;\n", call, StringLiteral(c.Want), StringLiteral(c.Name))
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// BytesLiteral returns the BYTES literal for b. Printable ASCII characters
+// are kept as is, and other bytes are escaped.
+func BytesLiteral(b []byte) string {
+	var sb strings.Builder
+	sb.WriteString("b'")
+	for _, c := range b {
+		switch {
+		case c == '\'' || c == '\\':
+			sb.WriteByte('\\')
+			sb.WriteByte(c)
+		case c == '\n':
+			sb.WriteString(`\n`)
+		case c == '\r':
+			sb.WriteString(`\r`)
+		case c == '\t':
+			sb.WriteString(`\t`)
+		case c >= 0x20 && c < 0x7f:
+			sb.WriteByte(c)
+		default:
+			fmt.Fprintf(&sb, `\x%02x`, c)
+		}
+	}
+	sb.WriteString("'")
+	return sb.String()
+}
+
+// StringLiteral returns the STRING literal for s. Printable characters are
+// kept as is, and others are escaped. Since STRING literals must be valid
+// UTF-8, s must be too.
+func StringLiteral(s string) string {
+	var sb strings.Builder
+	sb.WriteString("'")
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			// Invalid; let BigQuery reject it.
+			fmt.Fprintf(&sb, `\x%02x`, s[i])
+		case r == '\'' || r == '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case r == '\n':
+			sb.WriteString(`\n`)
+		case r == '\r':
+			sb.WriteString(`\r`)
+		case r == '\t':
+			sb.WriteString(`\t`)
+		case unicode.IsPrint(r):
+			sb.WriteRune(r)
+		case r <= 0xffff:
+			fmt.Fprintf(&sb, `\u%04x`, r)
+		default:
+			fmt.Fprintf(&sb, `\U%08x`, r)
+		}
+		i += size
+	}
+	sb.WriteString("'")
+	return sb.String()
+}
+
+// oneLine makes s fit in a comment.
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,166 @@
+package bqassert_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/qnighy/bqpb/baseline/bqassert"
+)
+
+func TestBytesLiteral(t *testing.T) {
+	testcases := []struct {
+		name  string
+		input []byte
+		want  string
+	}{
+		{
+			name:  "empty",
+			input: nil,
+			want:  `b''`,
+		},
+		{
+			name:  "printable",
+			input: []byte("abc XYZ 09?"),
+			want:  `b'abc XYZ 09?'`,
+		},
+		{
+			name:  "quotes and backslashes",
+			input: []byte(`'"\`),
+			want:  `b'\'"\\'`,
+		},
+		{
+			name:  "control characters",
+			input: []byte("\n\r\t\x00\x1f\x7f"),
+			want:  `b'\n\r\t\x00\x1f\x7f'`,
+		},
+		{
+			name:  "high bytes",
+			input: []byte("\x80\xff\xe3\x81\x82"),
+			want:  `b'\x80\xff\xe3\x81\x82'`,
+		},
+		{
+			name:  "varint",
+			input: []byte("\x08\x96\x01"),
+			want:  `b'\x08\x96\x01'`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := bqassert.BytesLiteral(tc.input)
+			if got != tc.want {
+				t.Errorf("BytesLiteral(%q) = %s, want %s", tc.input, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStringLiteral(t *testing.T) {
+	testcases := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "empty",
+			input: "",
+			want:  `''`,
+		},
+		{
+			name:  "JSON",
+			input: `{"a":"it's"}`,
+			want:  `'{"a":"it\'s"}'`,
+		},
+		{
+			name:  "escaped JSON",
+			input: `{"a":"\n\u0000"}`,
+			want:  `'{"a":"\\n\\u0000"}'`,
+		},
+		{
+			name:  "control characters",
+			input: "\n\r\t\x00\x7f",
+			want:  `'\n\r\t\u0000\u007f'`,
+		},
+		{
+			name:  "non-ASCII",
+			input: "あ😀",
+			want:  `'あ😀'`,
+		},
+		{
+			name:  "non-printable",
+			input: "\u2028\U000e0001",
+			want:  `'\u2028\U000e0001'`,
+		},
+		{
+			name:  "invalid UTF-8",
+			input: "\xff",
+			want:  `'\xff'`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := bqassert.StringLiteral(tc.input)
+			if got != tc.want {
+				t.Errorf("StringLiteral(%q) = %s, want %s", tc.input, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	typedefs := `{"message Main":{"field1":{"type":"uint32","id":1}}}`
+	cases := []*bqassert.Case{
+		{
+			Name:        "field1",
+			Input:       []byte("\x08\x01"),
+			MessageType: "Main",
+			Typedefs:    typedefs,
+			Want:        `{"field1":1}`,
+		},
+		{
+			Name:        "truncated",
+			Input:       []byte("\x08"),
+			MessageType: "Main",
+			Typedefs:    typedefs,
+			WantErr:     "Unexpected EOF",
+		},
+		{
+			Name:        "schemaless",
+			Input:       []byte("\x08\x01"),
+			MessageType: "Main",
+			Typedefs:    `{}`,
+			Want:        `{"#1":"unknown:int32:1"}`,
+		},
+	}
+	udf := "CREATE TEMP FUNCTION parseProtobuf(input BYTES, messageType STRING, typedefs JSON)\nRETURNS JSON;\n"
+	var sb strings.Builder
+	if err := bqassert.Write(&sb, udf, cases); err != nil {
+		t.Fatalf("Write error: %v\n", err)
+	}
+	want := `-- Generated by the baseline tests; DO NOT EDIT.
+DECLARE typedefs_1 JSON DEFAULT JSON '{"message Main":{"field1":{"type":"uint32","id":1}}}';
+DECLARE typedefs_2 JSON DEFAULT JSON '{}';
+DECLARE result JSON;
+DECLARE failed BOOL;
+
+CREATE TEMP FUNCTION parseProtobuf(input BYTES, messageType STRING, typedefs JSON)
+RETURNS JSON;
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x01', 'Main', typedefs_1)) = TO_JSON_STRING(JSON '{"field1":1}') AS 'field1';
+
+-- want error: Unexpected EOF
+BEGIN
+  SET result = parseProtobuf(b'\x08', 'Main', typedefs_1);
+  SET failed = FALSE;
+EXCEPTION WHEN ERROR THEN
+  SET failed = TRUE;
+END;
+ASSERT failed AS 'truncated';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x01', 'Main', typedefs_2)) = TO_JSON_STRING(JSON '{"#1":"unknown:int32:1"}') AS 'schemaless';
+`
+	if diff := cmp.Diff(want, sb.String()); diff != "" {
+		t.Errorf("Write() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,249 @@
+// Package bqjson models how BigQuery stores values of the JSON type, so that
+// tests can tell what users see once the output of parseProtobuf lands in a
+// table.
+//
+// The JavaScript value returned by the UDF, or the text given to PARSE_JSON,
+// is not kept as is:
+//
+//   - Numbers are stored as INT64 if they are integers in its range, then as
+//     UINT64, and otherwise as FLOAT64. What happens to numbers that FLOAT64
+//     cannot hold exactly depends on WideNumberMode.
+//   - Of duplicated keys in an object, only the first is kept.
+//   - Object keys are sorted.
+//
+// Canonicalize applies these rules and formats the result as TO_JSON_STRING
+// does: FLOAT64 numbers keep a fractional part or an exponent, as in 1.0 or
+// 1e+16, and -0.0 stays negative while the integer -0 does not.
+package bqjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// WideNumberMode is the wide_number_mode argument of PARSE_JSON.
+type WideNumberMode int
+
+const (
+	// Exact rejects numbers FLOAT64 cannot hold without loss of precision.
+	// This is the default of PARSE_JSON.
+	Exact WideNumberMode = iota
+	// Round rounds such numbers to the nearest FLOAT64. Numbers out of the
+	// range of FLOAT64 are still rejected.
+	Round
+)
+
+// MaxDepth is the deepest nesting of arrays and objects BigQuery accepts.
+const MaxDepth = 500
+
+// Canonicalize returns the JSON text as BigQuery stores and prints it.
+func Canonicalize(s string, mode WideNumberMode) (string, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	c := &canonicalizer{dec: dec, mode: mode}
+	var buf bytes.Buffer
+	if err := c.value(&buf, 0); err != nil {
+		return "", err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return "", errors.New("trailing data")
+	}
+	return buf.String(), nil
+}
+
+type canonicalizer struct {
+	dec  *json.Decoder
+	mode WideNumberMode
+}
+
+func (c *canonicalizer) value(buf *bytes.Buffer, depth int) error {
+	tok, err := c.dec.Token()
+	if err != nil {
+		return err
+	}
+	switch tok := tok.(type) {
+	case json.Delim:
+		if depth >= MaxDepth {
+			return fmt.Errorf("nesting deeper than %d", MaxDepth)
+		}
+		if tok == '[' {
+			buf.WriteByte('[')
+			for i := 0; c.dec.More(); i++ {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+				if err := c.value(buf, depth+1); err != nil {
+					return err
+				}
+			}
+			buf.WriteByte(']')
+			_, err := c.dec.Token()
+			return err
+		}
+		members := map[string][]byte{}
+		var keys []string
+		for c.dec.More() {
+			key, err := c.dec.Token()
+			if err != nil {
+				return err
+			}
+			var member bytes.Buffer
+			if err := c.value(&member, depth+1); err != nil {
+				return err
+			}
+			if _, ok := members[key.(string)]; ok {
+				continue
+			}
+			members[key.(string)] = member.Bytes()
+			keys = append(keys, key.(string))
+		}
+		if _, err := c.dec.Token(); err != nil {
+			return err
+		}
+		sort.Strings(keys)
+		buf.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			writeString(buf, key)
+			buf.WriteByte(':')
+			buf.Write(members[key])
+		}
+		buf.WriteByte('}')
+		return nil
+	case json.Number:
+		n, err := c.number(string(tok))
+		if err != nil {
+			return err
+		}
+		buf.WriteString(n)
+	case string:
+		writeString(buf, tok)
+	case bool:
+		buf.WriteString(strconv.FormatBool(tok))
+	case nil:
+		buf.WriteString("null")
+	}
+	return nil
+}
+
+// number returns the number as TO_JSON_STRING prints it.
+func (c *canonicalizer) number(s string) (string, error) {
+	if !strings.ContainsAny(s, ".eE") {
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return strconv.FormatInt(i, 10), nil
+		}
+		if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+			return strconv.FormatUint(u, 10), nil
+		}
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return "", fmt.Errorf("number out of range: %s", s)
+	}
+	if c.mode == Exact {
+		// Lossless if the shortest representation of f denotes the same
+		// number.
+		want, _ := new(big.Rat).SetString(s)
+		got, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, 64))
+		if want.Cmp(got) != 0 {
+			return "", fmt.Errorf("number cannot be stored without loss of precision: %s", s)
+		}
+	}
+	return FormatFloat(f), nil
+}
+
+// FormatFloat formats a FLOAT64 in JSON as TO_JSON_STRING does. The
+// shortest digits are written in fixed notation for decimal exponents from
+// -4 to 15, with ".0" appended to integers, and in exponential notation with
+// at least two exponent digits otherwise.
+func FormatFloat(f float64) string {
+	if f == 0 {
+		if 1/f < 0 {
+			return "-0.0"
+		}
+		return "0.0"
+	}
+	var sb strings.Builder
+	if f < 0 {
+		sb.WriteByte('-')
+		f = -f
+	}
+	// d.ddde±x
+	e := strconv.FormatFloat(f, 'e', -1, 64)
+	mantissa, exp, _ := strings.Cut(e, "e")
+	digits := strings.Replace(mantissa, ".", "", 1)
+	x, _ := strconv.Atoi(exp)
+	// The value is 0.digits * 10^n.
+	k, n := len(digits), x+1
+	switch {
+	case k <= n && n <= 15:
+		sb.WriteString(digits)
+		sb.WriteString(strings.Repeat("0", n-k))
+		sb.WriteString(".0")
+	case 0 < n && n <= 15:
+		sb.WriteString(digits[:n])
+		sb.WriteByte('.')
+		sb.WriteString(digits[n:])
+	case -4 < n && n <= 0:
+		sb.WriteString("0.")
+		sb.WriteString(strings.Repeat("0", -n))
+		sb.WriteString(digits)
+	default:
+		sb.WriteString(digits[:1])
+		if k > 1 {
+			sb.WriteByte('.')
+			sb.WriteString(digits[1:])
+		}
+		sb.WriteByte('e')
+		if x < 0 {
+			sb.WriteByte('-')
+			x = -x
+		} else {
+			sb.WriteByte('+')
+		}
+		fmt.Fprintf(&sb, "%02d", x)
+	}
+	return sb.String()
+}
+
+// writeString writes s as a JSON string, escaping only what JSON requires.
+func writeString(buf *bytes.Buffer, s string) {
+	buf.WriteByte('"')
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(buf, `\u%04x`, r)
+			} else {
+				buf.WriteString(s[i : i+size])
+			}
+		}
+		i += size
+	}
+	buf.WriteByte('"')
+}
@@ -0,0 +1,139 @@
+package bqjson_test
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/qnighy/bqpb/baseline/bqjson"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// TestCanonicalize records what users see in BigQuery for outputs of bqpb
+// and protojson. bqpb emits 64-bit integers as strings, as protojson does,
+// and formats other numbers with JSON.stringify.
+func TestCanonicalize(t *testing.T) {
+	inputs := []struct {
+		name  string
+		input string
+	}{
+		{"integer", `1`},
+		{"negative zero from protojson", `-0`},
+		{"negative zero as float", `-0.0`},
+		{"negative zero in exponential notation", `-0e0`},
+		{"2^53+1 as number", `9007199254740993`},
+		{"2^53+1 as float", `9007199254740993.0`},
+		{"2^53+1 in exponential notation", `9.007199254740993e15`},
+		{"2^53+1 as string", `"9007199254740993"`},
+		{"uint32 max", `4294967295`},
+		{"uint32 max as float", `4294967295.0`},
+		{"int64 min", `-9223372036854775808`},
+		{"int64 min - 1", `-9223372036854775809`},
+		{"uint64 max", `18446744073709551615`},
+		{"uint64 max + 1", `18446744073709551616`},
+		{"uint64 max after JavaScript", `18446744073709552000`},
+		{"float max", `3.4028234663852886e+38`},
+		{"double max", `1.7976931348623157e308`},
+		{"double overflow", `1e400`},
+		{"double underflow", `1e-400`},
+		{"one tenth", `0.1`},
+		{"one third", `0.3333333333333333`},
+		{"1e15", `1e15`},
+		{"1e16", `1e16`},
+		{"1e21 from JSON.stringify", `1e21`},
+		{"1e-4", `0.0001`},
+		{"1e-5", `0.00001`},
+		{"1e-7 from JSON.stringify", `1e-7`},
+		{"special floats from protojson", `["NaN","Infinity","-Infinity"]`},
+		{"key order", `{"b":1,"a":2,"B":3,"é":4}`},
+		{"duplicate keys", `{"a":1,"b":2,"a":3}`},
+		{"null members", `{"a":null,"b":[null]}`},
+		{"escapes", `"\u0000\u001f\u007f \/\"\\"`},
+		{"nested", `{"x":[{"y":-0,"z":1.50}]}`},
+	}
+	var buf bytes.Buffer
+	for _, in := range inputs {
+		fmt.Fprintf(&buf, "=== %s\n%s\n", in.name, in.input)
+		for _, mode := range []struct {
+			name string
+			mode bqjson.WideNumberMode
+		}{{"exact", bqjson.Exact}, {"round", bqjson.Round}} {
+			got, err := bqjson.Canonicalize(in.input, mode.mode)
+			if err != nil {
+				got = "error: " + err.Error()
+			}
+			fmt.Fprintf(&buf, "%s: %s\n", mode.name, got)
+		}
+	}
+	checkGolden(t, "testdata/canonicalize.golden", buf.Bytes())
+}
+
+func TestCanonicalizeDepth(t *testing.T) {
+	deep := func(n int) string {
+		return string(bytes.Repeat([]byte("["), n)) + string(bytes.Repeat([]byte("]"), n))
+	}
+	if _, err := bqjson.Canonicalize(deep(bqjson.MaxDepth), bqjson.Exact); err != nil {
+		t.Errorf("Canonicalize error at the limit: %v\n", err)
+	}
+	if _, err := bqjson.Canonicalize(deep(bqjson.MaxDepth+1), bqjson.Exact); err == nil {
+		t.Errorf("Canonicalize beyond the limit succeeded")
+	}
+}
+
+func TestCanonicalizeInvalid(t *testing.T) {
+	for _, input := range []string{``, `{`, `{"a" 1}`, `[1,]`, `1 2`} {
+		if got, err := bqjson.Canonicalize(input, bqjson.Round); err == nil {
+			t.Errorf("Canonicalize(%q) = %s, want error", input, got)
+		}
+	}
+}
+
+func TestFormatFloat(t *testing.T) {
+	testcases := []struct {
+		input float64
+		want  string
+	}{
+		{0, "0.0"},
+		{math.Copysign(0, -1), "-0.0"},
+		{1, "1.0"},
+		{-1.5, "-1.5"},
+		{123456789012345, "123456789012345.0"},
+		{1234567890123456, "1.234567890123456e+15"},
+		{1e15, "1e+15"},
+		{0.001, "0.001"},
+		{0.0001, "0.0001"},
+		{0.00001, "1e-05"},
+		{1.25e-10, "1.25e-10"},
+		{1e100, "1e+100"},
+		{math.MaxFloat64, "1.7976931348623157e+308"},
+		{5e-324, "5e-324"},
+	}
+	for _, tc := range testcases {
+		if got := bqjson.FormatFloat(tc.input); got != tc.want {
+			t.Errorf("FormatFloat(%v) = %s, want %s", tc.input, got, tc.want)
+		}
+	}
+}
+
+func checkGolden(t *testing.T, path string, got []byte) {
+	t.Helper()
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("WriteFile error: %v\n", err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile error: %v\n", err)
+	}
+	if diff := cmp.Diff(string(want), string(got)); diff != "" {
+		t.Errorf("%s mismatch (-want +got):\n%s", path, diff)
+	}
+}
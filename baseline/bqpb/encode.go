@@ -0,0 +1,713 @@
+package bqpb
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Encode is the inverse of Parse. It serializes a JSON value of the shape
+// Parse produces, according to the typedefs.
+//
+// Besides the exact output of Parse, it accepts the usual alternatives of the
+// JSON mapping: enum numbers, 64-bit integers as numbers and other integers
+// as strings. Fields with implicit presence are omitted if they have zero
+// values, and repeated scalars are packed. Unknown fields like "#12" are
+// encoded back from their inferred values. Submessages are written as LEN,
+// or as groups if Parse would read the payload back as a string.
+func Encode(input json.RawMessage, messageType string, typedefs Typedefs) ([]byte, error) {
+	value, err := Unmarshal(input)
+	if err != nil {
+		return nil, err
+	}
+	return EncodeValue(value, messageType, typedefs)
+}
+
+// EncodeValue is like Encode, but takes a decoded value.
+func EncodeValue(value Value, messageType string, typedefs Typedefs) ([]byte, error) {
+	e := &encoder{parser{typedefs: &typedefs}}
+	b, err := e.encodeMessage(value, messageType)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		b = []byte{}
+	}
+	return b, nil
+}
+
+type encoder struct {
+	parser
+}
+
+// fieldError adds the field name to the error.
+func fieldError(name string, err error) error {
+	var fe *FieldError
+	if errors.As(err, &fe) {
+		return &FieldError{Path: name + "." + fe.Path, Err: fe.Err}
+	}
+	return &FieldError{Path: name, Err: err}
+}
+
+// FieldError is an error in encoding a field.
+type FieldError struct {
+	// Path is the dot-separated names of the fields leading to the error.
+	Path string
+	Err  error
+}
+
+func (e *FieldError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+func (e *encoder) encodeMessage(value Value, messageType string) ([]byte, error) {
+	if b, ok, err := e.encodeSpecial(value, messageType); ok || err != nil {
+		return b, err
+	}
+	obj, ok := value.(*Object)
+	if !ok {
+		return nil, fmt.Errorf("expected an object for %s, got %s", messageType, describe(value))
+	}
+	return e.encodeFields(nil, obj, messageType, nil)
+}
+
+// encodeFields encodes the members of the object, except for the ignored
+// ones, as fields of the message.
+func (e *encoder) encodeFields(b []byte, obj *Object, messageType string, ignored map[string]bool) ([]byte, error) {
+	consumed := map[string]bool{}
+	for key := range ignored {
+		consumed[key] = true
+	}
+	if msgDesc := e.message(messageType); msgDesc != nil {
+		for _, fieldDesc := range fieldEntries(msgDesc) {
+			if consumed[fieldDesc.Name] {
+				continue
+			}
+			consumed[fieldDesc.Name] = true
+			value, ok := obj.Get(fieldDesc.Name)
+			if !ok || value == nil {
+				continue
+			}
+			var err error
+			b, err = e.encodeField(b, fieldDesc, value)
+			if err != nil {
+				return nil, fieldError(fieldDesc.Name, err)
+			}
+		}
+	}
+
+	for _, key := range obj.Keys() {
+		if consumed[key] {
+			continue
+		}
+		number, err := strconv.ParseUint(strings.TrimPrefix(key, "#"), 10, 64)
+		if !strings.HasPrefix(key, "#") || err != nil || number < uint64(protowire.MinValidNumber) || number > uint64(protowire.MaxValidNumber) {
+			return nil, fmt.Errorf("unknown field %q in %s", key, messageType)
+		}
+		value, _ := obj.Get(key)
+		occurrences, ok := value.([]Value)
+		if !ok {
+			occurrences = []Value{value}
+		}
+		for _, occurrence := range occurrences {
+			b, err = e.encodeUnknown(b, protowire.Number(number), occurrence)
+			if err != nil {
+				return nil, fieldError(key, err)
+			}
+		}
+	}
+	return b, nil
+}
+
+func (e *encoder) encodeField(b []byte, fieldDesc *FieldDef, value Value) ([]byte, error) {
+	num := protowire.Number(fieldDesc.ID)
+	typeDesc := e.getType(fieldDesc.Type, fieldDesc.MessageEncoding)
+	switch {
+	case typeDesc == typeMap:
+		return e.encodeMap(b, num, fieldDesc.Type, value)
+	case fieldDesc.Repeated:
+		values, ok := value.([]Value)
+		if !ok {
+			return nil, fmt.Errorf("expected an array, got %s", describe(value))
+		}
+		if typeDesc < thresholdI64 {
+			if len(values) == 0 {
+				return b, nil
+			}
+			var packed []byte
+			for _, elem := range values {
+				bits, err := e.scalarBits(elem, typeDesc, fieldDesc.Type)
+				if err != nil {
+					return nil, err
+				}
+				packed = appendScalar(packed, typeDesc, bits)
+			}
+			b = protowire.AppendTag(b, num, protowire.BytesType)
+			return protowire.AppendBytes(b, packed), nil
+		}
+		for _, elem := range values {
+			var err error
+			b, err = e.encodeSingle(b, num, typeDesc, fieldDesc.Type, elem)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	}
+	implicit := fieldDesc.OneofGroup == "" && fieldDesc.FieldPresence == FieldPresenceImplicit
+	if implicit && typeDesc < thresholdLen {
+		if zero, err := e.isZero(value, typeDesc, fieldDesc.Type); err != nil || zero {
+			return b, err
+		}
+	}
+	return e.encodeSingle(b, num, typeDesc, fieldDesc.Type, value)
+}
+
+// isZero reports whether the value is the zero value of a scalar type.
+func (e *encoder) isZero(value Value, typeDesc int, typeName string) (bool, error) {
+	if typeDesc < thresholdI64 {
+		bits, err := e.scalarBits(value, typeDesc, typeName)
+		return bits == 0, err
+	}
+	s, ok := value.(string)
+	return ok && s == "", nil
+}
+
+func (e *encoder) encodeMap(b []byte, num protowire.Number, typeName string, value Value) ([]byte, error) {
+	obj, ok := value.(*Object)
+	if !ok {
+		return nil, fmt.Errorf("expected an object, got %s", describe(value))
+	}
+	comma := strings.Index(typeName, ",")
+	gt := strings.LastIndex(typeName, ">")
+	keyType := strings.TrimSpace(jsSlice(typeName, 4, comma))
+	valueType := strings.TrimSpace(jsSlice(typeName, comma+1, gt))
+	keyTypeDesc := e.getType(keyType, "")
+	valueTypeDesc := e.getType(valueType, "")
+	if !isValidMapKey(keyTypeDesc) || valueTypeDesc == typeMap {
+		return nil, ErrInvalidMapType
+	}
+	for _, key := range obj.Keys() {
+		var keyValue Value = key
+		if keyTypeDesc == typeBool {
+			switch key {
+			case "true":
+				keyValue = true
+			case "false":
+				keyValue = false
+			}
+		}
+		entry, err := e.encodeSingle(nil, 1, keyTypeDesc, keyType, keyValue)
+		if err != nil {
+			return nil, fieldError(key, err)
+		}
+		// A null value is the missing value, as Parse renders it.
+		if v, _ := obj.Get(key); v != nil {
+			entry, err = e.encodeSingle(entry, 2, valueTypeDesc, valueType, v)
+			if err != nil {
+				return nil, fieldError(key, err)
+			}
+		}
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		b = protowire.AppendBytes(b, entry)
+	}
+	return b, nil
+}
+
+func (e *encoder) encodeSingle(b []byte, num protowire.Number, typeDesc int, typeName string, value Value) ([]byte, error) {
+	switch {
+	case typeDesc < thresholdI64:
+		bits, err := e.scalarBits(value, typeDesc, typeName)
+		if err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, num, scalarWireType(typeDesc))
+		return appendScalar(b, typeDesc, bits), nil
+	case typeDesc == typeBytes:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a base64 string, got %s", describe(value))
+		}
+		data, err := decodeBase64(s)
+		if err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		return protowire.AppendBytes(b, data), nil
+	case typeDesc == typeString:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string, got %s", describe(value))
+		}
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		return protowire.AppendString(b, s), nil
+	case typeDesc == typeGroup:
+		data, err := e.encodeMessage(value, typeName)
+		if err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, num, protowire.StartGroupType)
+		b = append(b, data...)
+		return protowire.AppendTag(b, num, protowire.EndGroupType), nil
+	default:
+		data, err := e.encodeMessage(value, typeName)
+		if err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		return protowire.AppendBytes(b, data), nil
+	}
+}
+
+func scalarWireType(typeDesc int) protowire.Type {
+	switch {
+	case typeDesc < thresholdVarint:
+		return protowire.VarintType
+	case typeDesc < thresholdI32:
+		return protowire.Fixed32Type
+	default:
+		return protowire.Fixed64Type
+	}
+}
+
+func appendScalar(b []byte, typeDesc int, bits uint64) []byte {
+	switch scalarWireType(typeDesc) {
+	case protowire.VarintType:
+		return protowire.AppendVarint(b, bits)
+	case protowire.Fixed32Type:
+		return protowire.AppendFixed32(b, uint32(bits))
+	default:
+		return protowire.AppendFixed64(b, bits)
+	}
+}
+
+// scalarBits converts the value to the payload of a VARINT, I32 or I64
+// field.
+func (e *encoder) scalarBits(value Value, typeDesc int, typeName string) (uint64, error) {
+	switch typeDesc {
+	case typeBool:
+		v, ok := value.(bool)
+		if !ok {
+			return 0, fmt.Errorf("expected a boolean, got %s", describe(value))
+		}
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	case typeEnum:
+		if name, ok := value.(string); ok {
+			for _, entry := range enumEntries(e.enum(typeName)) {
+				if entry.Name == name {
+					return uint64(int64(entry.Number)), nil
+				}
+			}
+			if _, err := parseInteger(value, true, 32); err != nil {
+				return 0, fmt.Errorf("unknown enum value %q of %s", name, typeName)
+			}
+		}
+		n, err := parseInteger(value, true, 32)
+		return uint64(n), err
+	case typeFloat:
+		f, err := parseFloat(value)
+		return uint64(math.Float32bits(float32(f))), err
+	case typeDouble:
+		f, err := parseFloat(value)
+		return math.Float64bits(f), err
+	}
+	bits := 32
+	if is64BitType(typeDesc) {
+		bits = 64
+	}
+	signed := isSigned(typeDesc)
+	n, err := parseInteger(value, signed, bits)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case isZigZagType(typeDesc):
+		return protowire.EncodeZigZag(int64(n)), nil
+	case signed && typeDesc >= thresholdVarint && bits == 32:
+		// sfixed32
+		return uint64(uint32(n)), nil
+	default:
+		// Negative int32 values are sign-extended, as protobuf does.
+		return n, nil
+	}
+}
+
+// parseInteger parses an integer given as a number or a string. Signed
+// values are returned as their two's complement.
+func parseInteger(value Value, signed bool, bits int) (uint64, error) {
+	var s string
+	switch v := value.(type) {
+	case json.Number:
+		s = string(v)
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		s = v
+	default:
+		return 0, fmt.Errorf("expected an integer, got %s", describe(value))
+	}
+	if signed {
+		n, err := strconv.ParseInt(s, 10, bits)
+		if err != nil {
+			f, ferr := strconv.ParseFloat(s, 64)
+			if ferr != nil || f != math.Trunc(f) || f < -math.Ldexp(1, bits-1) || f >= math.Ldexp(1, bits-1) {
+				return 0, fmt.Errorf("invalid %d-bit integer %q", bits, s)
+			}
+			n = int64(f)
+		}
+		return uint64(n), nil
+	}
+	n, err := strconv.ParseUint(s, 10, bits)
+	if err != nil {
+		f, ferr := strconv.ParseFloat(s, 64)
+		if ferr != nil || f != math.Trunc(f) || f < 0 || f >= math.Ldexp(1, bits) {
+			return 0, fmt.Errorf("invalid unsigned %d-bit integer %q", bits, s)
+		}
+		n = uint64(f)
+	}
+	return n, nil
+}
+
+// parseFloat parses a number, or one of the names Parse uses for the
+// special values.
+func parseFloat(value Value) (float64, error) {
+	switch v := value.(type) {
+	case json.Number:
+		return strconv.ParseFloat(string(v), 64)
+	case float64:
+		return v, nil
+	case string:
+		switch v {
+		case "NaN":
+			// The NaN of JavaScript, rather than math.NaN()
+			return math.Float64frombits(0x7ff8000000000000), nil
+		case "Infinity":
+			return math.Inf(1), nil
+		case "-Infinity":
+			return math.Inf(-1), nil
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid number %q", v)
+		}
+		return f, nil
+	}
+	return 0, fmt.Errorf("expected a number, got %s", describe(value))
+}
+
+func decodeBase64(s string) ([]byte, error) {
+	// Accept both the standard and the URL-safe alphabets, with or without
+	// padding.
+	s = strings.NewReplacer("-", "+", "_", "/").Replace(strings.TrimRight(s, "="))
+	return base64.RawStdEncoding.DecodeString(s)
+}
+
+// encodeUnknown encodes a value inferred by UnknownField back.
+func (e *encoder) encodeUnknown(b []byte, num protowire.Number, value Value) ([]byte, error) {
+	if obj, ok := value.(*Object); ok {
+		// Unknown submessages are looked up by the empty name, as in Parse.
+		data, err := e.encodeMessage(obj, "")
+		if err != nil {
+			return nil, err
+		}
+		// Parse reads a LEN payload that looks like text as a string, so
+		// such a submessage can only have come from a group.
+		if utf8.Valid(data) && !controlChars.Match(data) {
+			b = protowire.AppendTag(b, num, protowire.StartGroupType)
+			b = append(b, data...)
+			return protowire.AppendTag(b, num, protowire.EndGroupType), nil
+		}
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		return protowire.AppendBytes(b, data), nil
+	}
+	s, ok := value.(string)
+	if !ok || !strings.HasPrefix(s, "unknown:") {
+		return nil, fmt.Errorf("expected an unknown value like \"unknown:int32:1\", got %s", describe(value))
+	}
+	kind := strings.TrimPrefix(s, "unknown:")
+	i := strings.IndexByte(kind, ':')
+	if i < 0 {
+		return nil, fmt.Errorf("invalid unknown value %q", s)
+	}
+	kind, repr := kind[:i], kind[i+1:]
+	switch kind {
+	case "int32":
+		n, err := parseInteger(repr, true, 32)
+		if err != nil {
+			return nil, err
+		}
+		// Parse renders VARINTs below 2^32 as int32; keep them there.
+		b = protowire.AppendTag(b, num, protowire.VarintType)
+		return protowire.AppendVarint(b, uint64(uint32(n))), nil
+	case "int64":
+		n, err := parseInteger(repr, true, 64)
+		if err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, num, protowire.VarintType)
+		return protowire.AppendVarint(b, n), nil
+	case "double":
+		f, err := parseFloat(repr)
+		if err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, num, protowire.Fixed64Type)
+		return protowire.AppendFixed64(b, math.Float64bits(f)), nil
+	case "float":
+		f, err := parseFloat(repr)
+		if err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, num, protowire.Fixed32Type)
+		return protowire.AppendFixed32(b, math.Float32bits(float32(f))), nil
+	case "string":
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		return protowire.AppendString(b, repr), nil
+	case "bytes":
+		data, err := decodeBase64(repr)
+		if err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		return protowire.AppendBytes(b, data), nil
+	}
+	return nil, fmt.Errorf("invalid unknown value %q", s)
+}
+
+// timestampPattern is RFC 3339 extended with the six-digit years of
+// toISOString.
+var timestampPattern = regexp.MustCompile(`^([+-][0-9]{6}|[0-9]{4})-([0-9]{2})-([0-9]{2})T([0-9]{2}):([0-9]{2}):([0-9]{2})(?:\.([0-9]{1,9}))?(Z|[+-][0-9]{2}:[0-9]{2})$`)
+
+func parseTimestamp(s string) (int64, int32, error) {
+	m := timestampPattern.FindStringSubmatch(s)
+	if m == nil {
+		return 0, 0, fmt.Errorf("invalid timestamp %q", s)
+	}
+	var parts [6]int
+	for i := range parts {
+		parts[i], _ = strconv.Atoi(strings.TrimPrefix(m[i+1], "+"))
+	}
+	var nanos int
+	if m[7] != "" {
+		nanos, _ = strconv.Atoi((m[7] + "00000000")[:9])
+	}
+	t := time.Date(parts[0], time.Month(parts[1]), parts[2], parts[3], parts[4], parts[5], nanos, time.UTC)
+	if m[8] != "Z" {
+		offset, err := time.Parse("-07:00", m[8])
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid timestamp %q", s)
+		}
+		_, off := offset.Zone()
+		t = t.Add(-time.Duration(off) * time.Second)
+	}
+	return t.Unix(), int32(t.Nanosecond()), nil
+}
+
+var durationPattern = regexp.MustCompile(`^(-)?([0-9]+)(?:\.([0-9]{1,9}))?s$`)
+
+// encodeSpecial encodes the well-known types that have special JSON
+// representations. It returns false if the message is not one of them.
+// This is the inverse of interpretSpecialWire.
+func (e *encoder) encodeSpecial(value Value, messageType string) ([]byte, bool, error) {
+	if !IsSpecialType(messageType) {
+		return nil, false, nil
+	}
+	shortType := strings.TrimPrefix(messageType, "google.protobuf.")
+	if wrapperType.MatchString(shortType) {
+		baseType := strings.ToLower(strings.TrimSuffix(shortType, "Value"))
+		fieldDesc := &FieldDef{Type: baseType, ID: 1, FieldPresence: FieldPresenceImplicit}
+		b, err := e.encodeField(nil, fieldDesc, value)
+		return b, true, err
+	}
+	switch shortType {
+	case "Any":
+		obj, ok := value.(*Object)
+		if !ok {
+			return nil, true, fmt.Errorf("expected an object for %s, got %s", messageType, describe(value))
+		}
+		typeURLValue, _ := obj.Get("@type")
+		typeURL, _ := typeURLValue.(string)
+		if !strings.HasPrefix(typeURL, "type.googleapis.com/") {
+			// Parse falls back to the typedefs too.
+			return nil, false, nil
+		}
+		messageType := strings.TrimPrefix(typeURL, "type.googleapis.com/")
+		var payload []byte
+		var err error
+		if IsSpecialType(messageType) {
+			inner, _ := obj.Get("value")
+			payload, err = e.encodeMessage(inner, messageType)
+		} else {
+			payload, err = e.encodeFields(nil, obj, messageType, map[string]bool{"@type": true})
+		}
+		if err != nil {
+			return nil, true, err
+		}
+		b := protowire.AppendTag(nil, 1, protowire.BytesType)
+		b = protowire.AppendString(b, typeURL)
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		return protowire.AppendBytes(b, payload), true, nil
+	case "Value":
+		var b []byte
+		var err error
+		switch v := value.(type) {
+		case nil:
+			b = protowire.AppendTag(b, 1, protowire.VarintType)
+			b = protowire.AppendVarint(b, 0)
+		case json.Number, float64:
+			b, err = e.encodeSingle(b, 2, typeDouble, "double", v)
+		case string:
+			b, err = e.encodeSingle(b, 3, typeString, "string", v)
+		case bool:
+			b, err = e.encodeSingle(b, 4, typeBool, "bool", v)
+		case *Object:
+			b, err = e.encodeSingle(b, 5, typeMessage, "google.protobuf.Struct", v)
+		case []Value:
+			b, err = e.encodeSingle(b, 6, typeMessage, "google.protobuf.ListValue", v)
+		default:
+			err = fmt.Errorf("unexpected %s", describe(value))
+		}
+		return b, true, err
+	case "Struct":
+		obj, ok := value.(*Object)
+		if !ok {
+			return nil, true, fmt.Errorf("expected an object for %s, got %s", messageType, describe(value))
+		}
+		var b []byte
+		for _, key := range obj.Keys() {
+			v, _ := obj.Get(key)
+			entry := protowire.AppendTag(nil, 1, protowire.BytesType)
+			entry = protowire.AppendString(entry, key)
+			entry, err := e.encodeSingle(entry, 2, typeMessage, "google.protobuf.Value", v)
+			if err != nil {
+				return nil, true, fieldError(key, err)
+			}
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendBytes(b, entry)
+		}
+		return b, true, nil
+	case "ListValue":
+		values, ok := value.([]Value)
+		if !ok {
+			return nil, true, fmt.Errorf("expected an array for %s, got %s", messageType, describe(value))
+		}
+		var b []byte
+		for _, v := range values {
+			var err error
+			b, err = e.encodeSingle(b, 1, typeMessage, "google.protobuf.Value", v)
+			if err != nil {
+				return nil, true, err
+			}
+		}
+		return b, true, nil
+	case "FieldMask":
+		s, ok := value.(string)
+		if !ok {
+			return nil, true, fmt.Errorf("expected a string for %s, got %s", messageType, describe(value))
+		}
+		var b []byte
+		if s == "" {
+			return b, true, nil
+		}
+		for _, path := range strings.Split(s, ",") {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, snakeCase(path))
+		}
+		return b, true, nil
+	case "Timestamp":
+		s, ok := value.(string)
+		if !ok {
+			return nil, true, fmt.Errorf("expected a string for %s, got %s", messageType, describe(value))
+		}
+		seconds, nanos, err := parseTimestamp(s)
+		if err != nil {
+			return nil, true, err
+		}
+		return appendSecondsNanos(nil, seconds, nanos), true, nil
+	case "Duration":
+		s, ok := value.(string)
+		if !ok {
+			return nil, true, fmt.Errorf("expected a string for %s, got %s", messageType, describe(value))
+		}
+		m := durationPattern.FindStringSubmatch(s)
+		if m == nil {
+			return nil, true, fmt.Errorf("invalid duration %q", s)
+		}
+		seconds, err := strconv.ParseInt(m[2], 10, 64)
+		if err != nil {
+			return nil, true, fmt.Errorf("invalid duration %q", s)
+		}
+		var nanos int32
+		if m[3] != "" {
+			n, _ := strconv.Atoi((m[3] + "00000000")[:9])
+			nanos = int32(n)
+		}
+		if m[1] == "-" {
+			seconds, nanos = -seconds, -nanos
+		}
+		return appendSecondsNanos(nil, seconds, nanos), true, nil
+	}
+	return nil, false, nil
+}
+
+func appendSecondsNanos(b []byte, seconds int64, nanos int32) []byte {
+	if seconds != 0 {
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(seconds))
+	}
+	if nanos != 0 {
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(int64(nanos)))
+	}
+	return b
+}
+
+// snakeCase is the inverse of the lowerCamelCase conversion of FieldMask
+// paths.
+func snakeCase(path string) string {
+	var sb strings.Builder
+	for _, r := range path {
+		if 'A' <= r && r <= 'Z' {
+			sb.WriteByte('_')
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// describe names the kind of the value for error messages.
+func describe(value Value) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "a boolean"
+	case float64, json.Number:
+		return "a number"
+	case string:
+		return "a string"
+	case []Value:
+		return "an array"
+	case *Object:
+		return "an object"
+	}
+	return fmt.Sprintf("%T", value)
+}
@@ -0,0 +1,260 @@
+package bqpb_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+)
+
+func TestEncode(t *testing.T) {
+	testcases := []struct {
+		name        string
+		input       string
+		messageType string
+		typedefs    string
+		want        []byte
+		wantErr     string
+	}{
+		{
+			name:        "implicit presence skips zero",
+			input:       `{"a":0,"b":"","c":false,"d":"0"}`,
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"uint32","id":1,"fieldPresence":"implicit"},"b":{"type":"string","id":2,"fieldPresence":"implicit"},"c":{"type":"bool","id":3,"fieldPresence":"implicit"},"d":{"type":"int64","id":4,"fieldPresence":"implicit"}}}`,
+			want:        []byte(""),
+		},
+		{
+			name:        "explicit presence keeps zero",
+			input:       `{"a":0,"b":""}`,
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"uint32","id":1,"fieldPresence":"explicit"},"b":{"type":"string","id":2,"fieldPresence":"explicit"}}}`,
+			want:        []byte("\x08\x00\x12\x00"),
+		},
+		{
+			name:        "oneof member keeps zero",
+			input:       `{"a":0}`,
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"uint32","id":1,"fieldPresence":"implicit","oneofGroup":"o"}}}`,
+			want:        []byte("\x08\x00"),
+		},
+		{
+			name:        "null and missing fields",
+			input:       `{"a":null}`,
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"uint32","id":1,"fieldPresence":"explicit"},"b":{"type":"string","id":2}}}`,
+			want:        []byte(""),
+		},
+		{
+			name:        "integers as numbers or strings",
+			input:       `{"a":"-1","b":-1,"c":1e2,"d":"18446744073709551615"}`,
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"int32","id":1},"b":{"type":"int64","id":2},"c":{"type":"sint32","id":3},"d":{"type":"fixed64","id":4}}}`,
+			want:        []byte("\x08\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01\x10\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01\x18\xc8\x01\x21\xff\xff\xff\xff\xff\xff\xff\xff"),
+		},
+		{
+			name:        "integer out of range",
+			input:       `{"a":4294967296}`,
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"uint32","id":1}}}`,
+			wantErr:     `a: invalid unsigned 32-bit integer "4294967296"`,
+		},
+		{
+			name:        "fractional integer",
+			input:       `{"a":1.5}`,
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"int32","id":1}}}`,
+			wantErr:     `a: invalid 32-bit integer "1.5"`,
+		},
+		{
+			name:        "special floats",
+			input:       `{"a":["NaN","Infinity","-Infinity",-0]}`,
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"float","id":1,"repeated":true}}}`,
+			want:        []byte("\x0a\x10\x00\x00\xc0\x7f\x00\x00\x80\x7f\x00\x00\x80\xff\x00\x00\x00\x80"),
+		},
+		{
+			name:        "packed and expanded repeated fields",
+			input:       `{"a":[1,2],"b":["x","y"],"c":[]}`,
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"uint32","id":1,"repeated":true},"b":{"type":"string","id":2,"repeated":true},"c":{"type":"uint32","id":3,"repeated":true}}}`,
+			want:        []byte("\x0a\x02\x01\x02\x12\x01x\x12\x01y"),
+		},
+		{
+			name:        "enum names and numbers",
+			input:       `{"a":["B",0,"-1",7]}`,
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"E","id":1,"repeated":true}},"enum E":{"A":0,"B":1}}`,
+			want:        []byte("\x0a\x0d\x01\x00\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01\x07"),
+		},
+		{
+			name:        "unknown enum name",
+			input:       `{"a":"C"}`,
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"E","id":1}},"enum E":{"A":0,"B":1}}`,
+			wantErr:     `a: unknown enum value "C" of E`,
+		},
+		{
+			name:        "bytes",
+			input:       `{"a":"AP8=","b":"AP-_"}`,
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"bytes","id":1},"b":{"type":"bytes","id":2}}}`,
+			want:        []byte("\x0a\x02\x00\xff\x12\x03\x00\xff\xbf"),
+		},
+		{
+			name:        "submessage and group",
+			input:       `{"a":{"x":1},"b":{"x":2}}`,
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"Sub","id":1},"b":{"type":"Sub","id":2,"messageEncoding":"delimited"}},"message Sub":{"x":{"type":"int32","id":1}}}`,
+			want:        []byte("\x0a\x02\x08\x01\x13\x08\x02\x14"),
+		},
+		{
+			name:        "nested error path",
+			input:       `{"a":{"x":true}}`,
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"Sub","id":1}},"message Sub":{"x":{"type":"int32","id":1}}}`,
+			wantErr:     "a.x: expected an integer, got a boolean",
+		},
+		{
+			name:        "map",
+			input:       `{"m":{"1":"a","-2":null},"b":{"true":1}}`,
+			messageType: "Main",
+			typedefs:    `{"message Main":{"m":{"type":"map<int32, string>","id":1},"b":{"type":"map<bool,uint32>","id":2}}}`,
+			want:        []byte("\x0a\x05\x08\x01\x12\x01a\x0a\x0b\x08\xfe\xff\xff\xff\xff\xff\xff\xff\xff\x01\x12\x04\x08\x01\x10\x01"),
+		},
+		{
+			name:        "unknown fields",
+			input:       `{"#2":["unknown:int32:-1","unknown:string:x"],"#3":{"#1":"unknown:double:NaN"}}`,
+			messageType: "Main",
+			typedefs:    `{"message Main":{}}`,
+			want:        []byte("\x10\xff\xff\xff\xff\x0f\x12\x01x\x1a\x09\x09\x00\x00\x00\x00\x00\x00\xf8\x7f"),
+		},
+		{
+			name:        "unknown submessage",
+			input:       `{"#1":{"#1":"unknown:int32:42"}}`,
+			messageType: "Main",
+			typedefs:    `{"message Main":{}}`,
+			want:        []byte("\x0a\x02\x08\x2a"),
+		},
+		{
+			// Parse would read a LEN payload back as "unknown:string:".
+			name:        "unknown empty submessage",
+			input:       `{"#1":{}}`,
+			messageType: "Main",
+			typedefs:    `{"message Main":{}}`,
+			want:        []byte("\x0b\x0c"),
+		},
+		{
+			name:        "undefined field",
+			input:       `{"b":1}`,
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"int32","id":1}}}`,
+			wantErr:     `unknown field "b" in Main`,
+		},
+		{
+			name:        "wrapper",
+			input:       `"42"`,
+			messageType: "google.protobuf.Int64Value",
+			want:        []byte("\x08\x2a"),
+		},
+		{
+			name:        "timestamp",
+			input:       `"2023-11-14T22:13:20.123456789Z"`,
+			messageType: "google.protobuf.Timestamp",
+			want:        []byte("\x08\x80\xe2\xcf\xaa\x06\x10\x95\x9a\xef\x3a"),
+		},
+		{
+			name:        "timestamp beyond year 9999",
+			input:       `"+010000-01-01T00:00:00.000001000Z"`,
+			messageType: "google.protobuf.Timestamp",
+			want:        []byte("\x08\x80\x83\xd1\xff\xaf\x07\x10\xe8\x07"),
+		},
+		{
+			name:        "timestamp with offset",
+			input:       `"1970-01-01T09:00:00+09:00"`,
+			messageType: "google.protobuf.Timestamp",
+			want:        []byte(""),
+		},
+		{
+			name:        "duration",
+			input:       `"-1.5s"`,
+			messageType: "google.protobuf.Duration",
+			want:        []byte("\x08\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01\x10\x80\xb6\xca\x91\xfe\xff\xff\xff\xff\x01"),
+		},
+		{
+			name:        "invalid duration",
+			input:       `"1m"`,
+			messageType: "google.protobuf.Duration",
+			wantErr:     `invalid duration "1m"`,
+		},
+		{
+			name:        "field mask",
+			input:       `"fooBar,baz"`,
+			messageType: "google.protobuf.FieldMask",
+			want:        []byte("\x0a\x07foo_bar\x0a\x03baz"),
+		},
+		{
+			name:        "struct",
+			input:       `{"a":[null,true,1,"x",{}]}`,
+			messageType: "google.protobuf.Struct",
+			want:        []byte("\x0a\x23\x0a\x01a\x12\x1e\x32\x1c\x0a\x02\x08\x00\x0a\x02\x20\x01\x0a\x09\x11\x00\x00\x00\x00\x00\x00\xf0\x3f\x0a\x03\x1a\x01x\x0a\x02\x2a\x00"),
+		},
+		{
+			name:        "any with a special type",
+			input:       `{"@type":"type.googleapis.com/google.protobuf.Duration","value":"1.000000000s"}`,
+			messageType: "google.protobuf.Any",
+			want:        []byte("\x0a\x2ctype.googleapis.com/google.protobuf.Duration\x12\x02\x08\x01"),
+		},
+		{
+			name:        "any with a message type",
+			input:       `{"@type":"type.googleapis.com/Sub","x":1}`,
+			messageType: "google.protobuf.Any",
+			typedefs:    `{"message Sub":{"x":{"type":"int32","id":1}}}`,
+			want:        []byte("\x0a\x17type.googleapis.com/Sub\x12\x02\x08\x01"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var typedefs bqpb.Typedefs
+			if tc.typedefs != "" {
+				if err := json.Unmarshal([]byte(tc.typedefs), &typedefs); err != nil {
+					t.Fatalf("Unmarshal error: %v\n", err)
+				}
+			}
+			got, err := bqpb.Encode(json.RawMessage(tc.input), tc.messageType, typedefs)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Errorf("Encode() error = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Encode error: %v\n", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Encode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	got, err := bqpb.Unmarshal([]byte(` {"b":[1.50,"x",null,true],"1":{},"b":18446744073709551615} `))
+	if err != nil {
+		t.Fatalf("Unmarshal error: %v\n", err)
+	}
+	text, err := bqpb.Marshal(got)
+	if err != nil {
+		t.Fatalf("Marshal error: %v\n", err)
+	}
+	if diff := cmp.Diff(`{"1":{},"b":18446744073709551615}`, string(text)); diff != "" {
+		t.Errorf("Unmarshal() mismatch (-want +got):\n%s", diff)
+	}
+
+	for _, input := range []string{``, `{`, `[1,]`, `{"a" 1}`, `1 2`, `{1:2}`} {
+		if _, err := bqpb.Unmarshal([]byte(input)); err == nil {
+			t.Errorf("Unmarshal(%q) succeeded, want error", input)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package bqpb_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+)
+
+func ExampleParse() {
+	var typedefs bqpb.Typedefs
+	err := json.Unmarshal([]byte(`{
+		"message Main": {
+			"field1": { "type": "uint32", "id": 1 },
+			"field2": { "type": "string", "id": 2, "repeated": true }
+		}
+	}`), &typedefs)
+	if err != nil {
+		panic(err)
+	}
+
+	output, err := bqpb.Parse([]byte("\x08\x01\x12\x03foo\x18\x2a"), "Main", typedefs)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(output))
+	// Output: {"field1":1,"field2":["foo"],"#3":"unknown:int32:42"}
+}
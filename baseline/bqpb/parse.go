@@ -0,0 +1,736 @@
+package bqpb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// More errors reported by bqpb.ts, with the same messages.
+var (
+	ErrInvalidMapType   = errors.New("Invalid map type")
+	ErrInvalidJSONValue = errors.New("Invalid JSON Value")
+	ErrInvalidTimeValue = errors.New("Invalid time value")
+	// ErrNullStructEntry is reported for a google.protobuf.Struct entry
+	// without a key, where bqpb.ts fails in Object.fromEntries.
+	ErrNullStructEntry = errors.New("Iterator value null is not an entry object")
+	// ErrEmptyEnum is reported when the zero value of an enum without values
+	// is needed, where bqpb.ts fails with a TypeError.
+	ErrEmptyEnum = errors.New("Cannot read properties of undefined (reading '0')")
+)
+
+// WireTypeError is reported when a field is encoded with a wire type other
+// than the one its type requires.
+type WireTypeError struct {
+	Expected protowire.Type
+	Got      protowire.Type
+}
+
+func (e *WireTypeError) Error() string {
+	return fmt.Sprintf("Expected wire type %d, got %d", e.Expected, e.Got)
+}
+
+// Parse decodes the message in the same way as parseProtobuf in bqpb.ts and
+// returns the resulting JSON. Errors have the same messages as the
+// exceptions thrown by bqpb.ts.
+func Parse(data []byte, messageType string, typedefs Typedefs) (json.RawMessage, error) {
+	value, err := Decode(data, messageType, typedefs)
+	if err != nil {
+		return nil, err
+	}
+	return Marshal(value)
+}
+
+// Decode is like Parse, but returns the decoded value before encoding it.
+func Decode(data []byte, messageType string, typedefs Typedefs) (Value, error) {
+	p := &parser{typedefs: &typedefs}
+	return p.parseBytes(data, messageType)
+}
+
+// Type codes, in the same layout as bqpb.ts:
+//
+//	|    |   0      |   1      |   2      |   3      |   4      |   5      |   6      |   7      |
+//	|----|----------|----------|----------|----------|----------|----------|----------|----------|
+//	|  0 | enum     | bool     |          |          |          |          |          |          |
+//	|  8 | uint32   | int32    |          | sint32   | uint64   | int64    |          | sint64   |
+//	| 16 | fixed32  | sfixed32 |          | float    | fixed64  | sfixed64 |          | double   |
+//	| 24 | message  | map      | bytes    | string   | group    |          |          |          |
+const (
+	// VARINT
+	typeEnum   = 0
+	typeBool   = 1
+	typeUint32 = 8
+	typeInt32  = 9
+	typeSint32 = 11
+	typeUint64 = 12
+	typeInt64  = 13
+	typeSint64 = 15
+	// I32
+	typeFixed32  = 16
+	typeSfixed32 = 17
+	typeFloat    = 19
+	// I64
+	typeFixed64  = 20
+	typeSfixed64 = 21
+	typeDouble   = 23
+	// LEN
+	typeMessage = 24
+	typeMap     = 25
+	typeBytes   = 26
+	typeString  = 27
+	// SGROUP
+	typeGroup = 28
+
+	thresholdVarint = 16
+	thresholdI32    = 20
+	thresholdI64    = 24
+	thresholdLen    = 28
+)
+
+func isZigZagType(typeDesc int) bool { return typeDesc&3 == 3 }
+func isSigned(typeDesc int) bool     { return typeDesc&1 == 1 }
+func is64BitType(typeDesc int) bool  { return typeDesc&4 == 4 }
+
+func isValidMapKey(typeDesc int) bool {
+	return (typeBool <= typeDesc && typeDesc <= typeSfixed64 && typeDesc != typeFloat) ||
+		typeDesc == typeString
+}
+
+var typeNames = map[string]int{
+	"bool":     typeBool,
+	"uint32":   typeUint32,
+	"int32":    typeInt32,
+	"sint32":   typeSint32,
+	"uint64":   typeUint64,
+	"int64":    typeInt64,
+	"sint64":   typeSint64,
+	"fixed32":  typeFixed32,
+	"sfixed32": typeSfixed32,
+	"float":    typeFloat,
+	"fixed64":  typeFixed64,
+	"sfixed64": typeSfixed64,
+	"double":   typeDouble,
+	"bytes":    typeBytes,
+	"string":   typeString,
+}
+
+var zeroValues = map[int]Value{
+	typeBool:     false,
+	typeUint32:   0.0,
+	typeInt32:    0.0,
+	typeSint32:   0.0,
+	typeUint64:   "0",
+	typeInt64:    "0",
+	typeSint64:   "0",
+	typeFixed32:  0.0,
+	typeSfixed32: 0.0,
+	typeFloat:    0.0,
+	typeFixed64:  "0",
+	typeSfixed64: "0",
+	typeDouble:   0.0,
+	typeMessage:  nil,
+	typeBytes:    "",
+	typeString:   "",
+}
+
+type parser struct {
+	typedefs *Typedefs
+	// depth is the nesting of the message being interpreted.
+	depth int
+}
+
+// nested runs f for a group or a submessage one level deeper, failing with
+// ErrTooDeep beyond MaxDepth.
+func (p *parser) nested(f func() (Value, error)) (Value, error) {
+	if p.depth >= MaxDepth {
+		return nil, ErrTooDeep
+	}
+	p.depth++
+	defer func() { p.depth-- }()
+	return f()
+}
+
+func (p *parser) message(name string) *MessageDef {
+	return p.typedefs.Message(name)
+}
+
+func (p *parser) enum(name string) *EnumDef {
+	return p.typedefs.Enum(name)
+}
+
+// fieldEntries returns the field definitions in the order of
+// Object.entries in bqpb.ts.
+func fieldEntries(m *MessageDef) []*FieldDef {
+	names := make([]string, len(m.Fields))
+	byName := map[string]*FieldDef{}
+	for i, f := range m.Fields {
+		names[i] = f.Name
+		byName[f.Name] = f
+	}
+	var entries []*FieldDef
+	for _, name := range propertyOrder(uniqueKeys(names)) {
+		entries = append(entries, byName[name])
+	}
+	return entries
+}
+
+// enumEntries returns the enum values in the order of Object.entries in
+// bqpb.ts.
+func enumEntries(e *EnumDef) []*EnumValueDef {
+	names := make([]string, len(e.Values))
+	byName := map[string]*EnumValueDef{}
+	for i, v := range e.Values {
+		names[i] = v.Name
+		byName[v.Name] = v
+	}
+	var entries []*EnumValueDef
+	for _, name := range propertyOrder(uniqueKeys(names)) {
+		entries = append(entries, byName[name])
+	}
+	return entries
+}
+
+func uniqueKeys(keys []string) []string {
+	seen := map[string]bool{}
+	var unique []string
+	for _, key := range keys {
+		if !seen[key] {
+			seen[key] = true
+			unique = append(unique, key)
+		}
+	}
+	return unique
+}
+
+func (p *parser) getType(typeName string, messageEncoding string) int {
+	if typeDesc, ok := typeNames[typeName]; ok {
+		return typeDesc
+	}
+	if strings.HasPrefix(typeName, "map<") {
+		return typeMap
+	}
+	if p.enum(typeName) != nil {
+		return typeEnum
+	}
+	if messageEncoding == MessageEncodingDelimited {
+		return typeGroup
+	}
+	return typeMessage
+}
+
+// getZeroValue returns the zero value of the type. It returns false for
+// groups, whose zero value is undefined in bqpb.ts.
+func (p *parser) getZeroValue(typeDesc int, typeName string) (Value, bool, error) {
+	if typeDesc == typeEnum {
+		entries := enumEntries(p.enum(typeName))
+		if len(entries) == 0 {
+			return nil, false, ErrEmptyEnum
+		}
+		return entries[0].Name, true, nil
+	}
+	value, ok := zeroValues[typeDesc]
+	return value, ok, nil
+}
+
+func (p *parser) parseBytes(input []byte, messageType string) (Value, error) {
+	fields, err := parseWire(input, p.depth)
+	if err != nil {
+		return nil, err
+	}
+	return p.interpretWire(fields, messageType)
+}
+
+func (p *parser) interpretWire(fields []WireField, messageType string) (Value, error) {
+	result, ok, err := p.interpretSpecialWire(fields, messageType)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return result, nil
+	}
+	return p.interpretGenericWire(fields, messageType)
+}
+
+func (p *parser) interpretGenericWire(fields []WireField, messageType string) (*Object, error) {
+	fieldsByID := groupFields(fields)
+	result := NewObject()
+
+	if msgDesc := p.message(messageType); msgDesc != nil {
+		for _, fieldDesc := range fieldEntries(msgDesc) {
+			values := fieldsByID.take(strconv.FormatInt(int64(fieldDesc.ID), 10))
+			typeDesc := p.getType(fieldDesc.Type, fieldDesc.MessageEncoding)
+			// As in bqpb.ts, oneofGroup only affects the presence.
+			fieldPresence := fieldDesc.FieldPresence
+			if fieldDesc.OneofGroup != "" || fieldPresence == "" {
+				fieldPresence = FieldPresenceExplicit
+			}
+
+			var interpretedValue Value
+			isPresent := true
+			var err error
+			switch {
+			case typeDesc == typeMap:
+				interpretedValue, err = p.interpretMap(values, fieldDesc.Type)
+			case fieldDesc.Repeated:
+				interpretedValue, err = p.interpretRepeated(values, typeDesc, fieldDesc.Type)
+			case len(values) > 0:
+				interpretedValue, err = p.interpretOne(values[len(values)-1], typeDesc, fieldDesc.Type)
+			case fieldPresence == FieldPresenceExplicit:
+				isPresent = false
+			default:
+				interpretedValue, isPresent, err = p.getZeroValue(typeDesc, fieldDesc.Type)
+			}
+			if err != nil {
+				return nil, err
+			}
+			if isPresent {
+				result.Set(fieldDesc.Name, interpretedValue)
+			}
+		}
+	}
+
+	if err := p.addUnknownFields(result, fieldsByID); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (p *parser) interpretMap(values []WireField, typeName string) (*Object, error) {
+	result := NewObject()
+	for _, value := range values {
+		key, entryValue, ok, err := p.interpretMapEntry(value, typeName)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result.Set(key, entryValue)
+		}
+	}
+	return result, nil
+}
+
+func (p *parser) interpretRepeated(values []WireField, typeDesc int, typeName string) ([]Value, error) {
+	unpackedValues := values
+	if typeDesc < thresholdI64 {
+		unpackedValues = nil
+		for _, packedValue := range values {
+			if packedValue.Type != protowire.BytesType {
+				unpackedValues = append(unpackedValues, packedValue)
+				continue
+			}
+			state := &wireState{b: packedValue.Bytes}
+			for state.p < len(state.b) {
+				field := WireField{Number: packedValue.Number}
+				var err error
+				switch {
+				case typeDesc < thresholdVarint:
+					field.Type = protowire.VarintType
+					field.Value, err = state.readVarint()
+				case typeDesc < thresholdI32:
+					field.Type = protowire.Fixed32Type
+					field.Value, err = state.readLE(4)
+				default:
+					field.Type = protowire.Fixed64Type
+					field.Value, err = state.readLE(8)
+				}
+				if err != nil {
+					return nil, err
+				}
+				unpackedValues = append(unpackedValues, field)
+			}
+		}
+	}
+
+	interpretedValues := make([]Value, len(unpackedValues))
+	for i, value := range unpackedValues {
+		var err error
+		interpretedValues[i], err = p.interpretOne(value, typeDesc, typeName)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return interpretedValues, nil
+}
+
+func (p *parser) interpretOne(fieldData WireField, typeDesc int, typeName string) (Value, error) {
+	switch {
+	case typeDesc < thresholdI64:
+		expectedWireType := protowire.Fixed64Type
+		if typeDesc < thresholdVarint {
+			expectedWireType = protowire.VarintType
+		} else if typeDesc < thresholdI32 {
+			expectedWireType = protowire.Fixed32Type
+		}
+		if fieldData.Type != expectedWireType {
+			return nil, &WireTypeError{Expected: expectedWireType, Got: fieldData.Type}
+		}
+		v := fieldData.Value
+		switch typeDesc {
+		case typeBool:
+			return v != 0, nil
+		case typeEnum:
+			for _, entry := range enumEntries(p.enum(typeName)) {
+				if float64(entry.Number) == float64(v) {
+					return entry.Name, nil
+				}
+			}
+			// Fallback
+			return float64(v), nil
+		case typeFloat:
+			return specialFloat(float64(math.Float32frombits(uint32(v)))), nil
+		case typeDouble:
+			return specialFloat(math.Float64frombits(v)), nil
+		}
+		switch {
+		case isZigZagType(typeDesc):
+			value := int64(v>>1) ^ -int64(v&1)
+			if is64BitType(typeDesc) {
+				return strconv.FormatInt(value, 10), nil
+			}
+			return float64(value), nil
+		case isSigned(typeDesc):
+			if is64BitType(typeDesc) {
+				return strconv.FormatInt(int64(v), 10), nil
+			}
+			return float64(int32(v)), nil
+		default:
+			// Unsigned 32-bit values are not truncated, as in bqpb.ts.
+			if is64BitType(typeDesc) {
+				return strconv.FormatUint(v, 10), nil
+			}
+			return float64(v), nil
+		}
+	case typeDesc < thresholdLen:
+		if fieldData.Type != protowire.BytesType {
+			return nil, &WireTypeError{Expected: protowire.BytesType, Got: fieldData.Type}
+		}
+		switch typeDesc {
+		case typeBytes:
+			return encodeBase64(fieldData.Bytes), nil
+		case typeString:
+			return decodeUTF8(fieldData.Bytes)
+		default:
+			// Map entries are handled in interpretMapEntry.
+			return p.nested(func() (Value, error) {
+				return p.parseBytes(fieldData.Bytes, typeName)
+			})
+		}
+	default:
+		if fieldData.Type != protowire.StartGroupType {
+			return nil, &WireTypeError{Expected: protowire.StartGroupType, Got: fieldData.Type}
+		}
+		return p.nested(func() (Value, error) {
+			return p.interpretWire(fieldData.Group, typeName)
+		})
+	}
+}
+
+// interpretMapEntry interprets a map entry. It returns false if the entry
+// has no key, in which case bqpb.ts skips it.
+func (p *parser) interpretMapEntry(fieldData WireField, typeName string) (string, Value, bool, error) {
+	if fieldData.Type != protowire.BytesType {
+		return "", nil, false, &WireTypeError{Expected: protowire.BytesType, Got: fieldData.Type}
+	}
+	comma := strings.Index(typeName, ",")
+	gt := strings.LastIndex(typeName, ">")
+	// known to start with "map<"
+	keyType := strings.TrimSpace(jsSlice(typeName, 4, comma))
+	valueType := strings.TrimSpace(jsSlice(typeName, comma+1, gt))
+	keyTypeDesc := p.getType(keyType, "")
+	valueTypeDesc := p.getType(valueType, "")
+	if !isValidMapKey(keyTypeDesc) || valueTypeDesc == typeMap {
+		return "", nil, false, ErrInvalidMapType
+	}
+
+	wire, err := parseWire(fieldData.Bytes, p.depth)
+	if err != nil {
+		return "", nil, false, err
+	}
+	keyField := findLast(wire, 1)
+	valueField := findLast(wire, 2)
+
+	if keyField == nil {
+		// Tell the caller to skip this field
+		return "", nil, false, nil
+	}
+	key, err := p.interpretOne(*keyField, keyTypeDesc, keyType)
+	if err != nil {
+		return "", nil, false, err
+	}
+
+	var value Value
+	if valueField == nil {
+		value, _, err = p.getZeroValue(valueTypeDesc, valueType)
+	} else {
+		value, err = p.interpretOne(*valueField, valueTypeDesc, valueType)
+	}
+	if err != nil {
+		return "", nil, false, err
+	}
+	return propertyKey(key), value, true, nil
+}
+
+// propertyKey converts a map key to a property name, as JavaScript does.
+func propertyKey(key Value) string {
+	switch key := key.(type) {
+	case bool:
+		return strconv.FormatBool(key)
+	case float64:
+		return formatSpecialFloat(key)
+	default:
+		return key.(string)
+	}
+}
+
+// jsSlice is String.prototype.slice, for ASCII strings.
+func jsSlice(s string, start, end int) string {
+	clamp := func(i int) int {
+		if i < 0 {
+			i += len(s)
+		}
+		if i < 0 {
+			return 0
+		} else if i > len(s) {
+			return len(s)
+		}
+		return i
+	}
+	start, end = clamp(start), clamp(end)
+	if start >= end {
+		return ""
+	}
+	return s[start:end]
+}
+
+func findLast(fields []WireField, number uint64) *WireField {
+	for i := len(fields) - 1; i >= 0; i-- {
+		if fields[i].Number == number {
+			return &fields[i]
+		}
+	}
+	return nil
+}
+
+func findAll(fields []WireField, number uint64) []WireField {
+	var found []WireField
+	for _, field := range fields {
+		if field.Number == number {
+			found = append(found, field)
+		}
+	}
+	return found
+}
+
+// decodeUTF8 rejects the same sequences as decodeUTF8 in bqpb.ts.
+func decodeUTF8(b []byte) (string, error) {
+	if !utf8.Valid(b) {
+		return "", ErrInvalidUTF8
+	}
+	return string(b), nil
+}
+
+// specialFloat returns the number, or its name if it cannot be represented
+// in JSON.
+func specialFloat(f float64) Value {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return formatSpecialFloat(f)
+	}
+	return f
+}
+
+var wrapperType = regexp.MustCompile(`^(U?Int(32|64)|Double|Float|Bool|String|Bytes)Value$`)
+
+var snakeCaseSegment = regexp.MustCompile(`_([a-z])`)
+
+// interpretSpecialWire decodes the well-known types that have special JSON
+// representations. It returns false if the message is not one of them.
+func (p *parser) interpretSpecialWire(fields []WireField, messageType string) (Value, bool, error) {
+	if !strings.HasPrefix(messageType, "google.protobuf.") {
+		return nil, false, nil
+	}
+	shortType := strings.TrimPrefix(messageType, "google.protobuf.")
+	if wrapperType.MatchString(shortType) {
+		baseType := strings.ToLower(strings.TrimSuffix(shortType, "Value"))
+		baseTypeDesc := p.getType(baseType, "")
+		if field := findLast(fields, 1); field != nil {
+			value, err := p.interpretOne(*field, baseTypeDesc, baseType)
+			return value, err == nil, err
+		}
+		value, _, err := p.getZeroValue(baseTypeDesc, baseType)
+		return value, err == nil, err
+	}
+	switch shortType {
+	case "Any":
+		typeURL := ""
+		if typeURLValue := findLast(fields, 1); typeURLValue != nil {
+			v, err := p.interpretOne(*typeURLValue, typeString, "string")
+			if err != nil {
+				return nil, false, err
+			}
+			typeURL = v.(string)
+		}
+		var value []byte
+		if valueValue := findLast(fields, 2); valueValue != nil {
+			if valueValue.Type != protowire.BytesType {
+				return nil, false, &WireTypeError{Expected: protowire.BytesType, Got: valueValue.Type}
+			}
+			value = valueValue.Bytes
+		}
+		if strings.HasPrefix(typeURL, "type.googleapis.com/") {
+			messageType := strings.TrimPrefix(typeURL, "type.googleapis.com/")
+			anyWireFields, err := parseWire(value, p.depth)
+			if err != nil {
+				return nil, false, err
+			}
+			result, ok, err := p.interpretSpecialWire(anyWireFields, messageType)
+			if err != nil {
+				return nil, false, err
+			}
+			obj := NewObject()
+			obj.Set("@type", typeURL)
+			if ok {
+				obj.Set("value", result)
+				return obj, true, nil
+			}
+			generic, err := p.interpretGenericWire(anyWireFields, messageType)
+			if err != nil {
+				return nil, false, err
+			}
+			for _, key := range generic.Keys() {
+				v, _ := generic.Get(key)
+				obj.Set(key, v)
+			}
+			return obj, true, nil
+		}
+	case "Value":
+		var field *WireField
+		for i := len(fields) - 1; i >= 0; i-- {
+			if 1 <= fields[i].Number && fields[i].Number <= 7 {
+				field = &fields[i]
+				break
+			}
+		}
+		if field == nil {
+			return nil, false, ErrInvalidJSONValue
+		}
+		var value Value
+		var err error
+		switch field.Number {
+		case 1:
+			_, err = p.interpretOne(*field, typeUint32, "uint32")
+		case 2:
+			value, err = p.interpretOne(*field, typeDouble, "bool")
+		case 3:
+			value, err = p.interpretOne(*field, typeString, "string")
+		case 4:
+			value, err = p.interpretOne(*field, typeBool, "bool")
+		case 5:
+			value, err = p.interpretOne(*field, typeMessage, "google.protobuf.Struct")
+		case 6:
+			value, err = p.interpretOne(*field, typeMessage, "google.protobuf.ListValue")
+		default:
+			return nil, false, nil
+		}
+		return value, err == nil, err
+	case "Struct":
+		result := NewObject()
+		for _, field := range findAll(fields, 1) {
+			key, value, ok, err := p.interpretMapEntry(field, "map<string,google.protobuf.Value>")
+			if err != nil {
+				return nil, false, err
+			}
+			if !ok {
+				return nil, false, ErrNullStructEntry
+			}
+			result.Set(key, value)
+		}
+		return result, true, nil
+	case "ListValue":
+		values := []Value{}
+		for _, field := range findAll(fields, 1) {
+			value, err := p.interpretOne(field, typeMessage, "google.protobuf.Value")
+			if err != nil {
+				return nil, false, err
+			}
+			values = append(values, value)
+		}
+		return values, true, nil
+	case "FieldMask":
+		paths := []string{}
+		for _, field := range findAll(fields, 1) {
+			path, err := p.interpretOne(field, typeString, "string")
+			if err != nil {
+				return nil, false, err
+			}
+			paths = append(paths, snakeCaseSegment.ReplaceAllStringFunc(path.(string), func(text string) string {
+				return strings.ToUpper(text[1:])
+			}))
+		}
+		return strings.Join(paths, ","), true, nil
+	case "Timestamp", "Duration":
+		var seconds float64
+		var nanos int32
+		if secondsValue := findLast(fields, 1); secondsValue != nil {
+			v, err := p.interpretOne(*secondsValue, typeInt64, "")
+			if err != nil {
+				return nil, false, err
+			}
+			// Number() of the decimal string
+			n, _ := strconv.ParseInt(v.(string), 10, 64)
+			seconds = float64(n)
+		}
+		if nanosValue := findLast(fields, 2); nanosValue != nil {
+			v, err := p.interpretOne(*nanosValue, typeInt32, "")
+			if err != nil {
+				return nil, false, err
+			}
+			nanos = int32(v.(float64))
+		}
+		if shortType == "Timestamp" {
+			iso, err := toISOString(seconds*1000 + float64(nanos)/1e6)
+			if err != nil {
+				return nil, false, err
+			}
+			return strings.TrimSuffix(iso, "Z") + padStart(FormatNumber(float64(nanos%1e6)), 6) + "Z", true, nil
+		}
+		if seconds < 0 {
+			return "-" + FormatNumber(-seconds) + "." + padStart(FormatNumber(-float64(nanos)), 9) + "s", true, nil
+		}
+		return FormatNumber(seconds) + "." + padStart(FormatNumber(float64(nanos)), 9) + "s", true, nil
+	}
+	return nil, false, nil
+}
+
+// toISOString is new Date(t).toISOString() in JavaScript.
+func toISOString(t float64) (string, error) {
+	// TimeClip
+	if math.IsNaN(t) || math.Abs(t) > 8.64e15 {
+		return "", ErrInvalidTimeValue
+	}
+	tm := time.UnixMilli(int64(t)).UTC()
+	var year string
+	if y := tm.Year(); 0 <= y && y <= 9999 {
+		year = fmt.Sprintf("%04d", y)
+	} else if y < 0 {
+		year = fmt.Sprintf("-%06d", -y)
+	} else {
+		year = fmt.Sprintf("+%06d", y)
+	}
+	return year + tm.Format("-01-02T15:04:05.000Z"), nil
+}
+
+// padStart is String.prototype.padStart with "0".
+func padStart(s string, n int) string {
+	if len(s) >= n {
+		return s
+	}
+	return strings.Repeat("0", n-len(s)) + s
+}
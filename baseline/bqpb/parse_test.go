@@ -0,0 +1,594 @@
+package bqpb_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+)
+
+// The expectations are the outputs of bqpb.ts.
+func TestParse(t *testing.T) {
+	testcases := []struct {
+		name        string
+		data        []byte
+		messageType string
+		typedefs    string
+		want        string
+		wantErr     string
+	}{
+		{
+			name:        "timestamp",
+			data:        []byte("\x08\x80\xe2\xcf\xaa\x06\x10\x95\x9a\xef\x3a"),
+			messageType: "google.protobuf.Timestamp",
+			want:        `"2023-11-14T22:13:20.123456789Z"`,
+		},
+		{
+			name:        "empty timestamp",
+			data:        []byte(""),
+			messageType: "google.protobuf.Timestamp",
+			want:        `"1970-01-01T00:00:00.000000000Z"`,
+		},
+		{
+			name:        "negative timestamp",
+			data:        []byte("\x08\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01\x10\x05"),
+			messageType: "google.protobuf.Timestamp",
+			want:        `"1969-12-31T23:59:59.001000005Z"`,
+		},
+		{
+			name:        "timestamp in year 0",
+			data:        []byte("\x08\x80\xef\xb2\xc3\x98\xfe\xff\xff\xff\x01"),
+			messageType: "google.protobuf.Timestamp",
+			want:        `"0000-12-31T00:00:00.000000000Z"`,
+		},
+		{
+			name:        "timestamp beyond year 9999",
+			data:        []byte("\x08\x80\x83\xd1\xff\xaf\x07\x10\xe8\x07"),
+			messageType: "google.protobuf.Timestamp",
+			want:        `"+010000-01-01T00:00:00.000001000Z"`,
+		},
+		{
+			name:        "timestamp out of range",
+			data:        []byte("\x08\x80\x80\x9a\xa6\xea\xaf\xe3\x01"),
+			messageType: "google.protobuf.Timestamp",
+			wantErr:     "Invalid time value",
+		},
+		{
+			name:        "timestamp with negative nanos",
+			data:        []byte("\x10\xa0\xb9\xa4\xff\xff\xff\xff\xff\xff\x01"),
+			messageType: "google.protobuf.Timestamp",
+			want:        `"1969-12-31T23:59:59.999-500000Z"`,
+		},
+		{
+			name:        "timestamp with wrong wire type",
+			data:        []byte("\x0a\x00"),
+			messageType: "google.protobuf.Timestamp",
+			wantErr:     "Expected wire type 0, got 2",
+		},
+		{
+			name:        "duration",
+			data:        []byte("\x08\x03"),
+			messageType: "google.protobuf.Duration",
+			want:        `"3.000000000s"`,
+		},
+		{
+			name:        "negative duration",
+			data:        []byte("\x08\xfb\xff\xff\xff\xff\xff\xff\xff\xff\x01\x10\x8c\xfc\xff\xff\xff\xff\xff\xff\xff\x01"),
+			messageType: "google.protobuf.Duration",
+			want:        `"-5.000000500s"`,
+		},
+		{
+			name:        "duration with inconsistent signs",
+			data:        []byte("\x08\x05\x10\x8c\xfc\xff\xff\xff\xff\xff\xff\xff\x01"),
+			messageType: "google.protobuf.Duration",
+			want:        `"5.00000-500s"`,
+		},
+		{
+			name:        "duration with nanos only",
+			data:        []byte("\x10\x01"),
+			messageType: "google.protobuf.Duration",
+			want:        `"0.000000001s"`,
+		},
+		{
+			name:        "long duration",
+			data:        []byte("\x08\x80\x80\x90\xbb\xba\xd6\xad\xf0\x0d"),
+			messageType: "google.protobuf.Duration",
+			want:        `"1000000000000000000.000000000s"`,
+		},
+		{
+			name:        "empty uint32 wrapper",
+			data:        []byte(""),
+			messageType: "google.protobuf.UInt32Value",
+			want:        `0`,
+		},
+		{
+			name:        "empty uint64 wrapper",
+			data:        []byte(""),
+			messageType: "google.protobuf.UInt64Value",
+			want:        `"0"`,
+		},
+		{
+			name:        "int64 wrapper",
+			data:        []byte("\x08\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01"),
+			messageType: "google.protobuf.Int64Value",
+			want:        `"-1"`,
+		},
+		{
+			name:        "int32 wrapper",
+			data:        []byte("\x08\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01"),
+			messageType: "google.protobuf.Int32Value",
+			want:        `-1`,
+		},
+		{
+			name:        "bool wrapper",
+			data:        []byte("\x08\x02"),
+			messageType: "google.protobuf.BoolValue",
+			want:        `true`,
+		},
+		{
+			name:        "string wrapper with duplicates",
+			data:        []byte("\x0a\x03\x66\x6f\x6f\x0a\x03\x62\x61\x72"),
+			messageType: "google.protobuf.StringValue",
+			want:        `"bar"`,
+		},
+		{
+			name:        "bytes wrapper",
+			data:        []byte("\x0a\x03\x00\x01\xff"),
+			messageType: "google.protobuf.BytesValue",
+			want:        `"AAH/"`,
+		},
+		{
+			name:        "float wrapper with NaN",
+			data:        []byte("\x0d\x00\x00\xc0\x7f"),
+			messageType: "google.protobuf.FloatValue",
+			want:        `"NaN"`,
+		},
+		{
+			name:        "float wrapper",
+			data:        []byte("\x0d\xcd\xcc\xcc\x3d"),
+			messageType: "google.protobuf.FloatValue",
+			want:        `0.10000000149011612`,
+		},
+		{
+			name:        "double wrapper with infinity",
+			data:        []byte("\x09\x00\x00\x00\x00\x00\x00\xf0\xff"),
+			messageType: "google.protobuf.DoubleValue",
+			want:        `"-Infinity"`,
+		},
+		{
+			name:        "double wrapper with negative zero",
+			data:        []byte("\x09\x00\x00\x00\x00\x00\x00\x00\x80"),
+			messageType: "google.protobuf.DoubleValue",
+			want:        `0`,
+		},
+		{
+			name:        "double wrapper with wrong wire type",
+			data:        []byte("\x08\x01"),
+			messageType: "google.protobuf.DoubleValue",
+			wantErr:     "Expected wire type 1, got 0",
+		},
+		{
+			name:        "null value",
+			data:        []byte("\x08\x00"),
+			messageType: "google.protobuf.Value",
+			want:        `null`,
+		},
+		{
+			name:        "number value",
+			data:        []byte("\x11\x00\x00\x00\x00\x00\x00\xf8\x3f"),
+			messageType: "google.protobuf.Value",
+			want:        `1.5`,
+		},
+		{
+			name:        "string value",
+			data:        []byte("\x1a\x01\x78"),
+			messageType: "google.protobuf.Value",
+			want:        `"x"`,
+		},
+		{
+			name:        "bool value",
+			data:        []byte("\x20\x01"),
+			messageType: "google.protobuf.Value",
+			want:        `true`,
+		},
+		{
+			name:        "struct value",
+			data:        []byte("\x2a\x0e\x0a\x07\x0a\x01\x61\x12\x02\x20\x00\x0a\x03\x0a\x01\x62"),
+			messageType: "google.protobuf.Value",
+			want:        `{"a":false,"b":null}`,
+		},
+		{
+			name:        "list value",
+			data:        []byte("\x32\x09\x0a\x02\x08\x00\x0a\x03\x1a\x01\x79"),
+			messageType: "google.protobuf.Value",
+			want:        `[null,"y"]`,
+		},
+		{
+			name:        "empty value",
+			data:        []byte(""),
+			messageType: "google.protobuf.Value",
+			wantErr:     "Invalid JSON Value",
+		},
+		{
+			name:        "value with unknown kind",
+			data:        []byte("\x38\x01"),
+			messageType: "google.protobuf.Value",
+			want:        `{"#7":"unknown:int32:1"}`,
+		},
+		{
+			name:        "value with only unknown fields",
+			data:        []byte("\x40\x01"),
+			messageType: "google.protobuf.Value",
+			wantErr:     "Invalid JSON Value",
+		},
+		{
+			name:        "value with wrong wire type",
+			data:        []byte("\x18\x01"),
+			messageType: "google.protobuf.Value",
+			wantErr:     "Expected wire type 2, got 0",
+		},
+		{
+			name:        "struct entry without key",
+			data:        []byte("\x0a\x04\x12\x02\x20\x01"),
+			messageType: "google.protobuf.Struct",
+			wantErr:     "Iterator value null is not an entry object",
+		},
+		{
+			name:        "struct entries",
+			data:        []byte("\x0a\x07\x0a\x01\x31\x12\x02\x20\x01\x0a\x07\x0a\x01\x61\x12\x02\x20\x00\x0a\x03\x0a\x01\x31"),
+			messageType: "google.protobuf.Struct",
+			want:        `{"1":null,"a":false}`,
+		},
+		{
+			name:        "empty list value",
+			data:        []byte(""),
+			messageType: "google.protobuf.ListValue",
+			want:        `[]`,
+		},
+		{
+			name:        "field mask",
+			data:        []byte("\x0a\x0f\x66\x6f\x6f\x5f\x62\x61\x72\x2e\x62\x61\x7a\x5f\x71\x75\x78\x0a\x05\x61\x5f\x62\x5f\x63\x0a\x03\x78\x5f\x31"),
+			messageType: "google.protobuf.FieldMask",
+			want:        `"fooBar.bazQux,aBC,x_1"`,
+		},
+		{
+			name:        "empty field mask",
+			data:        []byte(""),
+			messageType: "google.protobuf.FieldMask",
+			want:        `""`,
+		},
+		{
+			name:        "any with well-known type",
+			data:        []byte("\x0a\x2c\x74\x79\x70\x65\x2e\x67\x6f\x6f\x67\x6c\x65\x61\x70\x69\x73\x2e\x63\x6f\x6d\x2f\x67\x6f\x6f\x67\x6c\x65\x2e\x70\x72\x6f\x74\x6f\x62\x75\x66\x2e\x44\x75\x72\x61\x74\x69\x6f\x6e\x12\x02\x08\x01"),
+			messageType: "google.protobuf.Any",
+			want:        `{"@type":"type.googleapis.com/google.protobuf.Duration","value":"1.000000000s"}`,
+		},
+		{
+			name:        "any with typedefs",
+			data:        []byte("\x0a\x18\x74\x79\x70\x65\x2e\x67\x6f\x6f\x67\x6c\x65\x61\x70\x69\x73\x2e\x63\x6f\x6d\x2f\x4d\x61\x69\x6e\x12\x04\x08\x01\x10\x02"),
+			messageType: "google.protobuf.Any",
+			typedefs:    `{"message Main":{"a":{"type":"uint32","id":1},"@type":{"type":"string","id":3}}}`,
+			want:        `{"@type":"type.googleapis.com/Main","a":1,"#2":"unknown:int32:2"}`,
+		},
+		{
+			name:        "any with unknown type URL prefix",
+			data:        []byte("\x0a\x10\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x4d\x61\x69\x6e\x12\x02\x08\x01"),
+			messageType: "google.protobuf.Any",
+			want:        `{"#1":"unknown:string:example.com/Main","#2":{"#1":"unknown:int32:1"}}`,
+		},
+		{
+			name:        "empty any",
+			data:        []byte(""),
+			messageType: "google.protobuf.Any",
+			want:        `{}`,
+		},
+		{
+			name:        "any with wrong wire type",
+			data:        []byte("\x0a\x18\x74\x79\x70\x65\x2e\x67\x6f\x6f\x67\x6c\x65\x61\x70\x69\x73\x2e\x63\x6f\x6d\x2f\x4d\x61\x69\x6e\x10\x01"),
+			messageType: "google.protobuf.Any",
+			wantErr:     "Expected wire type 2, got 0",
+		},
+		{
+			name:        "any with invalid value",
+			data:        []byte("\x0a\x29\x74\x79\x70\x65\x2e\x67\x6f\x6f\x67\x6c\x65\x61\x70\x69\x73\x2e\x63\x6f\x6d\x2f\x67\x6f\x6f\x67\x6c\x65\x2e\x70\x72\x6f\x74\x6f\x62\x75\x66\x2e\x56\x61\x6c\x75\x65"),
+			messageType: "google.protobuf.Any",
+			wantErr:     "Invalid JSON Value",
+		},
+		{
+			name:        "other well-known types",
+			data:        []byte("\x08\x01"),
+			messageType: "google.protobuf.Empty",
+			want:        `{"#1":"unknown:int32:1"}`,
+		},
+		{
+			name:        "map with negative int32 key",
+			data:        []byte("\x0a\x0e\x08\xfd\xff\xff\xff\xff\xff\xff\xff\xff\x01\x12\x01\x78"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"m":{"type":"map<int32, string>","id":1}}}`,
+			want:        `{"m":{"-3":"x"}}`,
+		},
+		{
+			name:        "map with bool key",
+			data:        []byte("\x0a\x02\x08\x01\x0a\x05\x08\x00\x12\x01\x66"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"m":{"type":"map<bool,string>","id":1}}}`,
+			want:        `{"m":{"true":"","false":"f"}}`,
+		},
+		{
+			name:        "map with missing enum value",
+			data:        []byte("\x0a\x02\x08\x05"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"m":{"type":"map<uint64,E>","id":1}},"enum E":{"B":1,"A":0}}`,
+			want:        `{"m":{"5":"B"}}`,
+		},
+		{
+			name:        "map with float key",
+			data:        []byte("\x0a\x02\x08\x05"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"m":{"type":"map<float,string>","id":1}}}`,
+			wantErr:     "Invalid map type",
+		},
+		{
+			name:        "map without value type",
+			data:        []byte("\x0a\x02\x08\x05"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"m":{"type":"map<string>","id":1}}}`,
+			wantErr:     "Invalid map type",
+		},
+		{
+			name:        "map with wrong key wire type",
+			data:        []byte("\x0a\x05\x0d\x00\x00\xc0\x3f"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"m":{"type":"map<fixed32,string>","id":1}}}`,
+			want:        `{"m":{"1069547520":""}}`,
+		},
+		{
+			name:        "empty map",
+			data:        []byte(""),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"m":{"type":"map<string,uint32>","id":1}}}`,
+			want:        `{"m":{}}`,
+		},
+		{
+			name:        "unknown enum values",
+			data:        []byte("\x08\x07\x10\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"E","id":1},"b":{"type":"E","id":2}},"enum E":{"A":0,"B":1}}`,
+			want:        `{"a":7,"b":18446744073709552000}`,
+		},
+		{
+			name:        "implicit enum zero value",
+			data:        []byte(""),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"E","id":1,"fieldPresence":"implicit"}},"enum E":{"B":1,"A":0}}`,
+			want:        `{"a":"B"}`,
+		},
+		{
+			name:        "implicit enum zero value without values",
+			data:        []byte(""),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"E","id":1,"fieldPresence":"implicit"}},"enum E":{}}`,
+			wantErr:     "Cannot read properties of undefined (reading '0')",
+		},
+		{
+			name:        "implicit submessage zero values",
+			data:        []byte(""),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"Sub","id":1,"fieldPresence":"implicit","messageEncoding":"delimited"},"b":{"type":"Sub","id":2,"fieldPresence":"implicit"}}}`,
+			want:        `{"b":null}`,
+		},
+		{
+			name:        "unsigned 32-bit value beyond 32 bits",
+			data:        []byte("\x08\x80\x80\x80\x80\x20\x10\x80\x80\x80\x80\x20\x18\x81\x80\x80\x80\x20"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"uint32","id":1},"b":{"type":"int32","id":2},"c":{"type":"sint32","id":3}}}`,
+			want:        `{"a":8589934592,"b":0,"c":-4294967297}`,
+		},
+		{
+			name:        "field definition order",
+			data:        []byte("\x08\x01"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"2":{"type":"uint32","id":1},"b":{"type":"uint32","id":1},"a":{"type":"uint32","id":1}}}`,
+			want:        `{"2":1}`,
+		},
+		{
+			name:        "unknown fields with empty message typedefs",
+			data:        []byte("\x2a\x02\xff\x01\x33\x08\x01\x34"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{},"message ":{"x":{"type":"string","id":1}}}`,
+			wantErr:     "Expected wire type 2, got 0",
+		},
+		{
+			name:        "unknown bytes with empty message typedefs",
+			data:        []byte("\x2a\x02\x08\x01"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{},"message ":{"x":{"type":"string","id":1}}}`,
+			want:        `{"#5":"unknown:bytes:CAE="}`,
+		},
+		{
+			name:        "unknown field ordering",
+			data:        []byte("\xf8\xff\xff\xff\x7f\x01\x18\x01\xf0\xff\xff\xff\x7f\x01"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{}}`,
+			want:        `{"#3":"unknown:int32:1","#4294967294":"unknown:int32:1","#4294967295":"unknown:int32:1"}`,
+		},
+		{
+			name:        "well-known type in submessage",
+			data:        []byte("\x0a\x02\x08\x01"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"Sub","id":1}},"message Sub":{"x":{"type":"google.protobuf.Timestamp","id":1}}}`,
+			wantErr:     "Expected wire type 2, got 0",
+		},
+		{
+			name:        "repeated wrapper",
+			data:        []byte("\x0a\x02\x08\x01"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"google.protobuf.Int32Value","id":1,"repeated":true}}}`,
+			want:        `{"a":[1]}`,
+		},
+		{
+			name:        "invalid UTF-8",
+			data:        []byte("\x0a\x01\xff"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"string","id":1}}}`,
+			wantErr:     "Invalid UTF-8 sequence",
+		},
+		{
+			name:        "surrogate in UTF-8",
+			data:        []byte("\x0a\x03\xed\xa0\x80"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"string","id":1}}}`,
+			wantErr:     "Invalid UTF-8 sequence",
+		},
+		{
+			name:        "overlong UTF-8",
+			data:        []byte("\x0a\x02\xc0\x80"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"string","id":1}}}`,
+			wantErr:     "Invalid UTF-8 sequence",
+		},
+		{
+			name:        "UTF-8 beyond U+10FFFF",
+			data:        []byte("\x0a\x04\xf4\x90\x80\x80"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"string","id":1}}}`,
+			wantErr:     "Invalid UTF-8 sequence",
+		},
+		{
+			name:        "UTF-8 astral plane",
+			data:        []byte("\x0a\x04\xf0\x9f\x98\x80"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"string","id":1}}}`,
+			want:        `{"a":"😀"}`,
+		},
+		{
+			name:        "oneof ignores implicit presence",
+			data:        []byte("\x08\x01"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"uint32","id":1,"oneofGroup":"g","fieldPresence":"implicit"},"b":{"type":"uint32","id":2,"oneofGroup":"g","fieldPresence":"implicit"}}}`,
+			want:        `{"a":1}`,
+		},
+		{
+			name:        "group with wrong wire type",
+			data:        []byte("\x08\x01"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"Sub","id":1,"messageEncoding":"delimited"}}}`,
+			wantErr:     "Expected wire type 3, got 0",
+		},
+		{
+			name:        "truncated packed double",
+			data:        []byte("\x0a\x03\x01\x02\x03"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"double","id":1,"repeated":true}}}`,
+			wantErr:     "Unexpected EOF",
+		},
+		{
+			name:        "repeated string is not unpacked",
+			data:        []byte("\x0a\x03\x01\x02\x03"),
+			messageType: "Main",
+			typedefs:    `{"message Main":{"a":{"type":"string","id":1,"repeated":true}}}`,
+			want:        `{"a":["\u0001\u0002\u0003"]}`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var typedefs bqpb.Typedefs
+			if tc.typedefs != "" {
+				if err := json.Unmarshal([]byte(tc.typedefs), &typedefs); err != nil {
+					t.Fatalf("Unmarshal error: %v\n", err)
+				}
+			}
+			got, err := bqpb.Parse(tc.data, tc.messageType, typedefs)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Errorf("Parse() error = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse error: %v\n", err)
+			}
+			if diff := cmp.Diff(tc.want, string(got)); diff != "" {
+				t.Errorf("Parse() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseDepth(t *testing.T) {
+	// nested returns depth levels of field 1, as LEN submessages or as groups.
+	nested := func(depth int, group bool) []byte {
+		if group {
+			return []byte(strings.Repeat("\x0b", depth) + strings.Repeat("\x0c", depth))
+		}
+		var b []byte
+		for i := 0; i < depth; i++ {
+			b = protowire.AppendBytes(protowire.AppendTag(nil, 1, protowire.BytesType), b)
+		}
+		return b
+	}
+	var typedefs bqpb.Typedefs
+	if err := json.Unmarshal([]byte(`{
+		"message Main":{"sub":{"type":"Main","id":1}},
+		"message Group":{"sub":{"type":"Group","id":1,"messageEncoding":"delimited"}}
+	}`), &typedefs); err != nil {
+		t.Fatalf("Unmarshal error: %v\n", err)
+	}
+	testcases := []struct {
+		name        string
+		data        []byte
+		messageType string
+		wantErr     error
+	}{
+		{
+			name:        "submessages at MaxDepth",
+			data:        nested(bqpb.MaxDepth, false),
+			messageType: "Main",
+		},
+		{
+			name:        "submessages beyond MaxDepth",
+			data:        nested(bqpb.MaxDepth+1, false),
+			messageType: "Main",
+			wantErr:     bqpb.ErrTooDeep,
+		},
+		{
+			name:        "groups at MaxDepth",
+			data:        nested(bqpb.MaxDepth, true),
+			messageType: "Group",
+		},
+		{
+			name:        "groups beyond MaxDepth",
+			data:        nested(bqpb.MaxDepth+1, true),
+			messageType: "Group",
+			wantErr:     bqpb.ErrTooDeep,
+		},
+		{
+			name:        "unknown groups beyond MaxDepth",
+			data:        nested(bqpb.MaxDepth+1, true),
+			messageType: "Unknown",
+			wantErr:     bqpb.ErrTooDeep,
+		},
+		{
+			// The innermost levels fall back to bytes, as in bqpb.ts.
+			name:        "unknown submessages beyond MaxDepth",
+			data:        nested(bqpb.MaxDepth+1, false),
+			messageType: "Unknown",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := bqpb.Parse(tc.data, tc.messageType, typedefs)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("Parse error = %v, want %v\n", err, tc.wantErr)
+			}
+			if err == nil && !json.Valid(got) {
+				t.Errorf("Parse() = %s, not valid JSON", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,293 @@
+// Package bqpb is a Go port of bqpb.ts.
+//
+// Parse produces the same JSON as parseProtobuf does on BigQuery, given the
+// same typedefs. The other declarations are Go counterparts of the data
+// structures and rules of bqpb.ts, so that the baseline tests can talk about
+// them precisely.
+//
+// The port is checked against protojson on the baseline serialization cases;
+// see TestParse in the baseline package for the known deviations.
+//
+// The port itself deviates from bqpb.ts on malformed input:
+//
+//   - Varints wider than 64 bits fail with ErrVarintOverflow. bqpb.ts reads
+//     them with BigInt and keeps the excess bits, which then show in field
+//     numbers and in unsigned, zigzag, bool and enum values.
+//   - Nesting fails with ErrTooDeep beyond MaxDepth, which is a fixed limit.
+//     bqpb.ts fails when V8 runs out of stack, earlier or later depending on
+//     the frames on the way, so the port decodes some messages that bqpb.ts
+//     cannot.
+package bqpb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Typedefs is the typedefs document accepted by parseProtobuf.
+//
+// In JSON, each message is stored under the key "message <name>" and each
+// enum under the key "enum <name>". The order of the definitions is
+// preserved on a round trip, except that messages are always emitted before
+// enums.
+type Typedefs struct {
+	Messages []*MessageDef
+	Enums    []*EnumDef
+}
+
+// MessageDef is a message definition in the typedefs document.
+type MessageDef struct {
+	Name   string
+	Fields []*FieldDef
+}
+
+// FieldDef is a field definition in the typedefs document.
+type FieldDef struct {
+	// Name is the key of the field in the JSON output.
+	Name            string `json:"-"`
+	Type            string `json:"type"`
+	ID              int32  `json:"id"`
+	Repeated        bool   `json:"repeated,omitempty"`
+	FieldPresence   string `json:"fieldPresence,omitempty"`
+	MessageEncoding string `json:"messageEncoding,omitempty"`
+	OneofGroup      string `json:"oneofGroup,omitempty"`
+	// Comment is ignored by bqpb.ts; tools use it to annotate generated
+	// typedefs.
+	Comment string `json:"comment,omitempty"`
+}
+
+// EnumDef is an enum definition in the typedefs document.
+type EnumDef struct {
+	Name   string
+	Values []*EnumValueDef
+}
+
+// EnumValueDef is a single enum value in an enum definition.
+type EnumValueDef struct {
+	Name   string
+	Number int32
+}
+
+// Values of FieldDef.FieldPresence.
+const (
+	FieldPresenceExplicit = "explicit"
+	FieldPresenceImplicit = "implicit"
+)
+
+// Values of FieldDef.MessageEncoding.
+const (
+	MessageEncodingLengthPrefixed = "length_prefixed"
+	MessageEncodingDelimited      = "delimited"
+)
+
+// Message returns the message definition of the given name, or nil. As in
+// JSON.parse, the last one wins if the name is duplicated.
+func (t *Typedefs) Message(name string) *MessageDef {
+	var found *MessageDef
+	for _, m := range t.Messages {
+		if m.Name == name {
+			found = m
+		}
+	}
+	return found
+}
+
+// Enum returns the enum definition of the given name, or nil, in the same
+// way as Message.
+func (t *Typedefs) Enum(name string) *EnumDef {
+	var found *EnumDef
+	for _, e := range t.Enums {
+		if e.Name == name {
+			found = e
+		}
+	}
+	return found
+}
+
+// Field returns the field definition of the given name, or nil, in the same
+// way as Message.
+func (m *MessageDef) Field(name string) *FieldDef {
+	var found *FieldDef
+	for _, f := range m.Fields {
+		if f.Name == name {
+			found = f
+		}
+	}
+	return found
+}
+
+// Entries returns the field definitions in the order Parse visits them,
+// which is the order of Object.entries in bqpb.ts. Of duplicated names, the
+// last definition wins.
+func (m *MessageDef) Entries() []*FieldDef {
+	return fieldEntries(m)
+}
+
+// Entries returns the enum values in the order Parse looks them up, which is
+// the order of Object.entries in bqpb.ts. A number is printed as the name of
+// its first entry.
+func (e *EnumDef) Entries() []*EnumValueDef {
+	return enumEntries(e)
+}
+
+func (t *Typedefs) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	first := true
+	writeKey := func(key string) {
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		k, _ := json.Marshal(key)
+		buf.Write(k)
+		buf.WriteByte(':')
+	}
+	for _, m := range t.Messages {
+		writeKey("message " + m.Name)
+		v, err := m.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(v)
+	}
+	for _, e := range t.Enums {
+		writeKey("enum " + e.Name)
+		v, err := e.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func (t *Typedefs) UnmarshalJSON(data []byte) error {
+	*t = Typedefs{}
+	return decodeObject(data, func(key string, value json.RawMessage) error {
+		switch {
+		case strings.HasPrefix(key, "message "):
+			m := &MessageDef{Name: strings.TrimPrefix(key, "message ")}
+			if err := m.UnmarshalJSON(value); err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+			t.Messages = append(t.Messages, m)
+		case strings.HasPrefix(key, "enum "):
+			e := &EnumDef{Name: strings.TrimPrefix(key, "enum ")}
+			if err := e.UnmarshalJSON(value); err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+			t.Enums = append(t.Enums, e)
+		}
+		// Other keys are never looked up by bqpb; ignore them.
+		return nil
+	})
+}
+
+func (m *MessageDef) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, f := range m.Fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, _ := json.Marshal(f.Name)
+		buf.Write(k)
+		buf.WriteByte(':')
+		// Avoid escaping the angle brackets in map types.
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(f); err != nil {
+			return nil, err
+		}
+		buf.Truncate(buf.Len() - 1) // trailing newline
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func (m *MessageDef) UnmarshalJSON(data []byte) error {
+	m.Fields = nil
+	return decodeObject(data, func(key string, value json.RawMessage) error {
+		f := &FieldDef{}
+		if err := json.Unmarshal(value, f); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		f.Name = key
+		m.Fields = append(m.Fields, f)
+		return nil
+	})
+}
+
+func (e *EnumDef) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, v := range e.Values {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, _ := json.Marshal(v.Name)
+		buf.Write(k)
+		fmt.Fprintf(&buf, ":%d", v.Number)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func (e *EnumDef) UnmarshalJSON(data []byte) error {
+	e.Values = nil
+	return decodeObject(data, func(key string, value json.RawMessage) error {
+		v := &EnumValueDef{Name: key}
+		if err := json.Unmarshal(value, &v.Number); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		e.Values = append(e.Values, v)
+		return nil
+	})
+}
+
+// decodeObject calls fn for each member of a JSON object, in order.
+func decodeObject(data []byte, fn func(key string, value json.RawMessage) error) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != json.Delim('{') {
+		return fmt.Errorf("expected an object, got %v", tok)
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		if err := fn(tok.(string), value); err != nil {
+			return err
+		}
+	}
+	_, err = dec.Token()
+	return err
+}
+
+// IsSpecialType reports whether bqpb decodes the message type on its own,
+// without looking at the typedefs.
+// This corresponds to interpretSpecialWire in bqpb.ts.
+func IsSpecialType(messageType string) bool {
+	if !strings.HasPrefix(messageType, "google.protobuf.") {
+		return false
+	}
+	switch strings.TrimPrefix(messageType, "google.protobuf.") {
+	case "UInt32Value", "Int32Value", "UInt64Value", "Int64Value",
+		"DoubleValue", "FloatValue", "BoolValue", "StringValue", "BytesValue",
+		"Any", "Value", "Struct", "ListValue", "FieldMask", "Timestamp", "Duration":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package bqpb_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+)
+
+func TestTypedefsJSON(t *testing.T) {
+	input := `{` +
+		`"message Foo":{` +
+		`"myField2":{"type":"map<string,uint32>","id":2},` +
+		`"myField1":{"type":"MyEnum","id":1,"repeated":true}` +
+		`},` +
+		`"comment":"ignored",` +
+		`"enum MyEnum":{"MY_ENUM_UNSPECIFIED":0,"MY_ENUM_VALUE1":1}` +
+		`}`
+	want := &bqpb.Typedefs{
+		Messages: []*bqpb.MessageDef{
+			{
+				Name: "Foo",
+				Fields: []*bqpb.FieldDef{
+					{Name: "myField2", Type: "map<string,uint32>", ID: 2},
+					{Name: "myField1", Type: "MyEnum", ID: 1, Repeated: true},
+				},
+			},
+		},
+		Enums: []*bqpb.EnumDef{
+			{
+				Name: "MyEnum",
+				Values: []*bqpb.EnumValueDef{
+					{Name: "MY_ENUM_UNSPECIFIED", Number: 0},
+					{Name: "MY_ENUM_VALUE1", Number: 1},
+				},
+			},
+		},
+	}
+
+	got := &bqpb.Typedefs{}
+	if err := json.Unmarshal([]byte(input), got); err != nil {
+		t.Fatalf("Unmarshal error: %v\n", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("json.Unmarshal() mismatch (-want +got):\n%s", diff)
+	}
+
+	var output bytes.Buffer
+	enc := json.NewEncoder(&output)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(got); err != nil {
+		t.Fatalf("Encode error: %v\n", err)
+	}
+	wantOutput := `{` +
+		`"message Foo":{` +
+		`"myField2":{"type":"map<string,uint32>","id":2},` +
+		`"myField1":{"type":"MyEnum","id":1,"repeated":true}` +
+		`},` +
+		`"enum MyEnum":{"MY_ENUM_UNSPECIFIED":0,"MY_ENUM_VALUE1":1}` +
+		`}` + "\n"
+	if diff := cmp.Diff(wantOutput, output.String()); diff != "" {
+		t.Errorf("Encode() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// TestTypedefsShadowing checks that duplicated names resolve to the last
+// definition, as JSON.parse in bqpb.ts keeps the last duplicated key.
+func TestTypedefsShadowing(t *testing.T) {
+	input := `{` +
+		`"message Foo":{"a":{"type":"uint32","id":1},"a":{"type":"E","id":2}},` +
+		`"enum E":{"X":1},` +
+		`"message Foo":{"b":{"type":"uint32","id":1},"c":{"type":"E","id":2},"c":{"type":"string","id":3}},` +
+		`"enum E":{"Y":1}` +
+		`}`
+	var typedefs bqpb.Typedefs
+	if err := json.Unmarshal([]byte(input), &typedefs); err != nil {
+		t.Fatalf("Unmarshal error: %v\n", err)
+	}
+
+	foo := typedefs.Message("Foo")
+	if foo == nil || foo.Field("b") == nil {
+		t.Fatalf("Message(%q) = %+v, want the second definition", "Foo", foo)
+	}
+	if diff := cmp.Diff(&bqpb.FieldDef{Name: "c", Type: "string", ID: 3}, foo.Field("c")); diff != "" {
+		t.Errorf("Field(%q) mismatch (-want +got):\n%s", "c", diff)
+	}
+	wantEnum := &bqpb.EnumDef{Name: "E", Values: []*bqpb.EnumValueDef{{Name: "Y", Number: 1}}}
+	if diff := cmp.Diff(wantEnum, typedefs.Enum("E")); diff != "" {
+		t.Errorf("Enum(%q) mismatch (-want +got):\n%s", "E", diff)
+	}
+
+	// Parse follows the same definitions.
+	got, err := bqpb.Parse([]byte("\x08\x01\x10\x01\x1a\x01x"), "Foo", typedefs)
+	if err != nil {
+		t.Fatalf("Parse error: %v\n", err)
+	}
+	if diff := cmp.Diff(`{"b":1,"c":"x","#2":"unknown:int32:1"}`, string(got)); diff != "" {
+		t.Errorf("Parse() mismatch (-want +got):\n%s", diff)
+	}
+}
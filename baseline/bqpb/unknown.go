@@ -0,0 +1,169 @@
+package bqpb
+
+import (
+	"encoding/base64"
+	"math"
+	"regexp"
+	"strconv"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Infer decodes the message without a schema, as parseProtobuf does when the
+// message type is not found in the typedefs. Every field is rendered as an
+// unknown field (see UnknownFields).
+func Infer(input []byte) (*Object, error) {
+	fields, err := ParseWire(input)
+	if err != nil {
+		return nil, err
+	}
+	return UnknownFields(fields), nil
+}
+
+// UnknownFields renders fields the way bqpb does when they are not found in
+// the typedefs. Each field number becomes a key like "#12345", holding the
+// inferred value, or an array of them if the field occurs more than once.
+//
+// The keys are ordered as bqpb.ts orders them: field numbers below 2^32 - 1
+// in ascending order, then the rest in order of appearance.
+func UnknownFields(fields []WireField) *Object {
+	p := &parser{typedefs: &Typedefs{}}
+	result := NewObject()
+	// Without typedefs, nothing can fail but groups nested deeper than
+	// MaxDepth, which ParseWire does not return.
+	_ = p.addUnknownFields(result, groupFields(fields))
+	return result
+}
+
+// UnknownField renders the occurrences of a single unknown field.
+//
+// The value of each occurrence is inferred as follows:
+//
+//   - VARINT is int32 if it fits in 32 bits, and int64 otherwise.
+//   - I64 is double and I32 is float.
+//   - LEN is a string if it is valid UTF-8 without control characters
+//     other than tab and newline. Otherwise it is a submessage if it parses
+//     as such, and bytes if not.
+//   - SGROUP is a submessage.
+//
+// Scalars are rendered as strings like "unknown:int32:42".
+func UnknownField(occurrences []WireField) Value {
+	p := &parser{typedefs: &Typedefs{}}
+	repr, _ := p.unknownField(occurrences)
+	return repr
+}
+
+// fieldGroups is the fields grouped by their numbers, like fieldsById in
+// bqpb.ts.
+type fieldGroups struct {
+	// keys are the decimal field numbers in order of appearance.
+	keys   []string
+	fields map[string][]WireField
+}
+
+func groupFields(fields []WireField) *fieldGroups {
+	g := &fieldGroups{fields: map[string][]WireField{}}
+	for _, field := range fields {
+		key := strconv.FormatUint(field.Number, 10)
+		if _, ok := g.fields[key]; !ok {
+			g.keys = append(g.keys, key)
+		}
+		g.fields[key] = append(g.fields[key], field)
+	}
+	return g
+}
+
+// take removes and returns the occurrences of the key.
+func (g *fieldGroups) take(key string) []WireField {
+	fields, ok := g.fields[key]
+	if !ok {
+		return nil
+	}
+	delete(g.fields, key)
+	for i, k := range g.keys {
+		if k == key {
+			g.keys = append(g.keys[:i:i], g.keys[i+1:]...)
+			break
+		}
+	}
+	return fields
+}
+
+func (p *parser) addUnknownFields(result *Object, g *fieldGroups) error {
+	for _, key := range propertyOrder(g.keys) {
+		repr, err := p.unknownField(g.fields[key])
+		if err != nil {
+			return err
+		}
+		result.Set("#"+key, repr)
+	}
+	return nil
+}
+
+func (p *parser) unknownField(occurrences []WireField) (Value, error) {
+	repr := make([]Value, len(occurrences))
+	for i, field := range occurrences {
+		var err error
+		repr[i], err = p.unknownValue(field)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(repr) == 1 {
+		return repr[0], nil
+	}
+	return repr, nil
+}
+
+// controlChars is the same as the regexp used in bqpb.ts.
+var controlChars = regexp.MustCompile("[\x00-\x08\x0b-\x1f\x7f]")
+
+func (p *parser) unknownValue(field WireField) (Value, error) {
+	switch field.Type {
+	case protowire.BytesType:
+		if utf8.Valid(field.Bytes) && !controlChars.Match(field.Bytes) {
+			return "unknown:string:" + string(field.Bytes), nil
+		}
+		// Unknown submessages are looked up by the empty name. As in
+		// bqpb.ts, running out of depth falls back to bytes too.
+		value, err := p.nested(func() (Value, error) {
+			return p.parseBytes(field.Bytes, "")
+		})
+		if err == nil {
+			return value, nil
+		}
+		return "unknown:bytes:" + encodeBase64(field.Bytes), nil
+	case protowire.StartGroupType:
+		return p.nested(func() (Value, error) {
+			return p.interpretWire(field.Group, "")
+		})
+	case protowire.Fixed64Type:
+		return "unknown:double:" + formatSpecialFloat(math.Float64frombits(field.Value)), nil
+	case protowire.Fixed32Type:
+		return "unknown:float:" + formatSpecialFloat(float64(math.Float32frombits(uint32(field.Value)))), nil
+	default:
+		if field.Value < 1<<32 {
+			return "unknown:int32:" + strconv.FormatInt(int64(int32(field.Value)), 10), nil
+		}
+		return "unknown:int64:" + strconv.FormatInt(int64(field.Value), 10), nil
+	}
+}
+
+// formatSpecialFloat formats a number in the same way as JavaScript's
+// String(), including NaN and infinities.
+func formatSpecialFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	}
+	return FormatNumber(f)
+}
+
+func encodeBase64(b []byte) string {
+	return base64.StdEncoding.EncodeToString(b)
+}
@@ -0,0 +1,178 @@
+package bqpb_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+)
+
+func TestUnknownFields(t *testing.T) {
+	testcases := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{
+			name: "varint",
+			data: []byte("\x08\x2a"),
+			want: `{"#1":"unknown:int32:42"}`,
+		},
+		{
+			name: "varint of 2^31",
+			data: []byte("\x08\x80\x80\x80\x80\x08"),
+			want: `{"#1":"unknown:int32:-2147483648"}`,
+		},
+		{
+			name: "varint of 2^32-1",
+			data: []byte("\x08\xff\xff\xff\xff\x0f"),
+			want: `{"#1":"unknown:int32:-1"}`,
+		},
+		{
+			name: "varint of 2^32",
+			data: []byte("\x08\x80\x80\x80\x80\x10"),
+			want: `{"#1":"unknown:int64:4294967296"}`,
+		},
+		{
+			name: "varint of 2^64-1",
+			data: []byte("\x08\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01"),
+			want: `{"#1":"unknown:int64:-1"}`,
+		},
+		{
+			name: "I64",
+			data: []byte("\x09\x00\x00\x00\x00\x00\x00\xf8\x3f"),
+			want: `{"#1":"unknown:double:1.5"}`,
+		},
+		{
+			name: "I64 of -0",
+			data: []byte("\x09\x00\x00\x00\x00\x00\x00\x00\x80"),
+			want: `{"#1":"unknown:double:0"}`,
+		},
+		{
+			name: "I64 of NaN",
+			data: []byte("\x09\x00\x00\x00\x00\x00\x00\xf8\x7f"),
+			want: `{"#1":"unknown:double:NaN"}`,
+		},
+		{
+			name: "I64 of tiny number",
+			data: []byte("\x09\x01\x00\x00\x00\x00\x00\x00\x00"),
+			want: `{"#1":"unknown:double:5e-324"}`,
+		},
+		{
+			name: "I32",
+			data: []byte("\x0d\x00\x00\xc0\x3f"),
+			want: `{"#1":"unknown:float:1.5"}`,
+		},
+		{
+			name: "I32 of 0.1",
+			data: []byte("\x0d\xcd\xcc\xcc\x3d"),
+			want: `{"#1":"unknown:float:0.10000000149011612"}`,
+		},
+		{
+			name: "I32 of -Infinity",
+			data: []byte("\x0d\x00\x00\x80\xff"),
+			want: `{"#1":"unknown:float:-Infinity"}`,
+		},
+		{
+			name: "LEN of string",
+			data: []byte("\x0a\x06\x61\x62\x63\xe3\x81\x82"),
+			want: `{"#1":"unknown:string:abcあ"}`,
+		},
+		{
+			name: "LEN of string with tab and newline",
+			data: []byte("\x0a\x03a\x09\x0a"),
+			want: `{"#1":"unknown:string:a\t\n"}`,
+		},
+		{
+			name: "LEN of string with carriage return",
+			data: []byte("\x0a\x01\x0d"),
+			want: `{"#1":"unknown:bytes:DQ=="}`,
+		},
+		{
+			name: "LEN of string that is also a message",
+			data: []byte("\x0a\x02HI"),
+			want: `{"#1":"unknown:string:HI"}`,
+		},
+		{
+			name: "LEN of empty",
+			data: []byte("\x0a\x00"),
+			want: `{"#1":"unknown:string:"}`,
+		},
+		{
+			name: "LEN of message with control characters",
+			data: []byte("\x0a\x02\x00\x01"),
+			want: `{"#1":{"#0":"unknown:int32:1"}}`,
+		},
+		{
+			name: "LEN of message with invalid UTF-8",
+			data: []byte("\x0a\x03\x08\x96\x01"),
+			want: `{"#1":{"#1":"unknown:int32:150"}}`,
+		},
+		{
+			name: "LEN of nested string",
+			data: []byte("\x0a\x04\x0a\x02HI"),
+			want: `{"#1":{"#1":"unknown:string:HI"}}`,
+		},
+		{
+			name: "LEN of bytes",
+			data: []byte("\x0a\x03\x0a\x0b\x0c"),
+			want: `{"#1":"unknown:bytes:CgsM"}`,
+		},
+		{
+			name: "LEN of invalid UTF-8",
+			data: []byte("\x0a\x01\xff"),
+			want: `{"#1":"unknown:bytes:/w=="}`,
+		},
+		{
+			name: "LEN of message with stray END_GROUP",
+			data: []byte("\x0a\x01\x0c"),
+			want: `{"#1":"unknown:bytes:DA=="}`,
+		},
+		{
+			name: "LEN of surrogate",
+			data: []byte("\x0a\x03\xed\xa0\x80"),
+			want: `{"#1":"unknown:bytes:7aCA"}`,
+		},
+		{
+			name: "group",
+			data: []byte("\x0b\x08\x01\x0c"),
+			want: `{"#1":{"#1":"unknown:int32:1"}}`,
+		},
+		{
+			name: "empty group",
+			data: []byte("\x0b\x0c"),
+			want: `{"#1":{}}`,
+		},
+		{
+			name: "repeated with mixed wire types",
+			data: []byte("\x08\x01\x0d\x00\x00\xc0\x3f\x0a\x01a\x0b\x0c"),
+			want: `{"#1":["unknown:int32:1","unknown:float:1.5","unknown:string:a",{}]}`,
+		},
+		{
+			name: "field ordering",
+			data: []byte("\x10\x01\x08\x02\x18\x03\x08\x04"),
+			want: `{"#1":["unknown:int32:2","unknown:int32:4"],"#2":"unknown:int32:1","#3":"unknown:int32:3"}`,
+		},
+		{
+			name: "large field number ordering",
+			data: []byte("\x80\x80\x80\x80\x80\x04\x01\x08\x01\x80\x80\x80\x80\x70\x01"),
+			want: `{"#1":"unknown:int32:1","#3758096384":"unknown:int32:1","#17179869184":"unknown:int32:1"}`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			fields, err := bqpb.ParseWire(tc.data)
+			if err != nil {
+				t.Fatalf("ParseWire error: %v\n", err)
+			}
+			got, err := bqpb.Marshal(bqpb.UnknownFields(fields))
+			if err != nil {
+				t.Fatalf("Marshal error: %v\n", err)
+			}
+			if diff := cmp.Diff(tc.want, string(got)); diff != "" {
+				t.Errorf("UnknownFields() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
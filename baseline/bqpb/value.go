@@ -0,0 +1,337 @@
+package bqpb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Value is a JSON value as produced by bqpb.ts. It is one of:
+//
+//   - nil
+//   - bool
+//   - float64, for JavaScript numbers
+//   - json.Number, for numbers read by Unmarshal
+//   - string
+//   - []Value
+//   - *Object
+type Value interface{}
+
+// Object is a JSON object that orders its keys as JavaScript objects do:
+// array-index-like keys first in ascending numeric order, then the other
+// keys in insertion order.
+type Object struct {
+	keys   []string
+	values map[string]Value
+}
+
+// NewObject returns an empty Object.
+func NewObject() *Object {
+	return &Object{values: map[string]Value{}}
+}
+
+// Set sets the value for the key. Overwriting a key keeps its position.
+func (o *Object) Set(key string, value Value) {
+	if _, ok := o.values[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+	o.values[key] = value
+}
+
+// Get returns the value for the key.
+func (o *Object) Get(key string) (Value, bool) {
+	value, ok := o.values[key]
+	return value, ok
+}
+
+// Delete removes the key.
+func (o *Object) Delete(key string) {
+	if _, ok := o.values[key]; !ok {
+		return
+	}
+	delete(o.values, key)
+	for i, k := range o.keys {
+		if k == key {
+			o.keys = append(o.keys[:i:i], o.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+// Len returns the number of keys.
+func (o *Object) Len() int {
+	return len(o.keys)
+}
+
+// Keys returns the keys in the JavaScript property order.
+func (o *Object) Keys() []string {
+	return propertyOrder(o.keys)
+}
+
+func (o *Object) MarshalJSON() ([]byte, error) {
+	return Marshal(o)
+}
+
+// propertyOrder reorders keys given in insertion order to the order of
+// properties of a JavaScript object.
+func propertyOrder(keys []string) []string {
+	var indices []uint32
+	var others []string
+	for _, key := range keys {
+		if index, ok := arrayIndex(key); ok {
+			indices = append(indices, index)
+		} else {
+			others = append(others, key)
+		}
+	}
+	sortUint32s(indices)
+	ordered := make([]string, 0, len(keys))
+	for _, index := range indices {
+		ordered = append(ordered, strconv.FormatUint(uint64(index), 10))
+	}
+	return append(ordered, others...)
+}
+
+// arrayIndex reports whether the key is an array index in the sense of
+// ECMAScript, i.e. a canonical decimal integer below 2^32 - 1.
+func arrayIndex(key string) (uint32, bool) {
+	if key == "" || len(key) > 10 || (len(key) > 1 && key[0] == '0') {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(key, 10, 32)
+	if err != nil || n == math.MaxUint32 {
+		return 0, false
+	}
+	return uint32(n), true
+}
+
+func sortUint32s(a []uint32) {
+	// Insertion sort; objects are small.
+	for i := 1; i < len(a); i++ {
+		for j := i; j > 0 && a[j-1] > a[j]; j-- {
+			a[j-1], a[j] = a[j], a[j-1]
+		}
+	}
+}
+
+// Marshal encodes the value in the same way as JSON.stringify.
+func Marshal(v Value) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := marshalValue(&buf, v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func marshalValue(buf *bytes.Buffer, v Value) error {
+	switch v := v.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			// Same as JSON.stringify
+			buf.WriteString("null")
+		} else {
+			buf.WriteString(FormatNumber(v))
+		}
+	case json.Number:
+		buf.WriteString(string(v))
+	case string:
+		marshalString(buf, v)
+	case []Value:
+		buf.WriteByte('[')
+		for i, elem := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := marshalValue(buf, elem); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case *Object:
+		buf.WriteByte('{')
+		for i, key := range v.Keys() {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			marshalString(buf, key)
+			buf.WriteByte(':')
+			if err := marshalValue(buf, v.values[key]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	default:
+		return fmt.Errorf("bqpb: cannot marshal %T", v)
+	}
+	return nil
+}
+
+// Unmarshal decodes a JSON text. Numbers are kept as json.Number so that
+// 64-bit integers do not lose precision. As with JSON.parse, the last one
+// of duplicate keys wins. Unlike encoding/json, it has no limit on nesting,
+// so that it can read back anything bqpb produces.
+func Unmarshal(data []byte) (Value, error) {
+	d := &jsonDecoder{b: data}
+	v, err := d.value()
+	if err != nil {
+		return nil, err
+	}
+	if d.skipSpace(); d.p < len(d.b) {
+		return nil, fmt.Errorf("bqpb: invalid character %q after JSON value", d.b[d.p])
+	}
+	return v, nil
+}
+
+type jsonDecoder struct {
+	b []byte
+	p int
+}
+
+func (d *jsonDecoder) skipSpace() {
+	for d.p < len(d.b) && bytes.IndexByte([]byte(" \t\r\n"), d.b[d.p]) >= 0 {
+		d.p++
+	}
+}
+
+// consume skips spaces and then c, if it comes next.
+func (d *jsonDecoder) consume(c byte) bool {
+	if d.skipSpace(); d.p < len(d.b) && d.b[d.p] == c {
+		d.p++
+		return true
+	}
+	return false
+}
+
+func (d *jsonDecoder) expect(c byte) error {
+	if !d.consume(c) {
+		return fmt.Errorf("bqpb: expected %q at offset %d", c, d.p)
+	}
+	return nil
+}
+
+func (d *jsonDecoder) value() (Value, error) {
+	switch {
+	case d.consume('{'):
+		obj := NewObject()
+		for i := 0; !d.consume('}'); i++ {
+			if i > 0 {
+				if err := d.expect(','); err != nil {
+					return nil, err
+				}
+			}
+			d.skipSpace()
+			key, err := d.scalar()
+			if err != nil {
+				return nil, err
+			}
+			k, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("bqpb: expected a string key at offset %d", d.p)
+			}
+			if err := d.expect(':'); err != nil {
+				return nil, err
+			}
+			member, err := d.value()
+			if err != nil {
+				return nil, err
+			}
+			obj.Set(k, member)
+		}
+		return obj, nil
+	case d.consume('['):
+		arr := []Value{}
+		for i := 0; !d.consume(']'); i++ {
+			if i > 0 {
+				if err := d.expect(','); err != nil {
+					return nil, err
+				}
+			}
+			elem, err := d.value()
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, elem)
+		}
+		return arr, nil
+	}
+	d.skipSpace()
+	return d.scalar()
+}
+
+// scalar decodes a JSON value that is not an array or an object.
+func (d *jsonDecoder) scalar() (Value, error) {
+	dec := json.NewDecoder(bytes.NewReader(d.b[d.p:]))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	switch v.(type) {
+	case nil, bool, json.Number, string:
+	default:
+		return nil, fmt.Errorf("bqpb: unexpected value at offset %d", d.p)
+	}
+	d.p += int(dec.InputOffset())
+	return v, nil
+}
+
+func marshalString(buf *bytes.Buffer, s string) {
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(buf, `\u%04x`, r)
+			} else {
+				var b [utf8.UTFMax]byte
+				buf.Write(b[:utf8.EncodeRune(b[:], r)])
+			}
+		}
+	}
+	buf.WriteByte('"')
+}
+
+// FormatNumber formats a finite number in the same way as JavaScript's
+// Number.prototype.toString, which is also used by JSON.stringify.
+// In particular, negative zero is formatted as "0".
+func FormatNumber(f float64) string {
+	if f == 0 {
+		return "0"
+	}
+	abs := math.Abs(f)
+	format := byte('f')
+	if abs < 1e-6 || abs >= 1e21 {
+		format = 'e'
+	}
+	b := strconv.AppendFloat(nil, f, format, -1, 64)
+	if format == 'e' {
+		// clean up e-09 to e-9
+		n := len(b)
+		if n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	return string(b)
+}
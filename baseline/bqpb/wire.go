@@ -0,0 +1,153 @@
+package bqpb
+
+import (
+	"errors"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Errors reported by bqpb.ts, with the same messages.
+var (
+	ErrUnexpectedEOF      = errors.New("Unexpected EOF")
+	ErrInvalidGroup       = errors.New("Invalid group")
+	ErrUnexpectedWireType = errors.New("Unexpected wire type")
+	ErrInvalidUTF8        = errors.New("Invalid UTF-8 sequence")
+	// ErrVarintOverflow is reported for varints wider than 64 bits.
+	// bqpb.ts accepts them, keeping the excess bits.
+	ErrVarintOverflow = errors.New("Varint overflow")
+	// ErrTooDeep is reported for groups and submessages nested deeper than
+	// MaxDepth. bqpb.ts throws a RangeError with this message when it runs
+	// out of stack, at a depth that depends on the JavaScript engine.
+	ErrTooDeep = errors.New("Maximum call stack size exceeded")
+)
+
+// MaxDepth is the deepest nesting of groups and submessages that is
+// decoded. bqpb.ts has no explicit limit, but runs out of stack in V8 with
+// the default stack size after 964 nested known submessages, 1090 known
+// groups, 1150 unknown groups, or 512 levels of a recursive group, which
+// nest 1024 times. The limit is above all of them, so that the port does not
+// reject what bqpb.ts decodes.
+const MaxDepth = 1200
+
+// WireField is a single field occurrence on the wire.
+// This corresponds to WireField in bqpb.ts.
+type WireField struct {
+	Number uint64
+	Type   protowire.Type
+	// Value is the payload of VARINT, I64 and I32 fields.
+	Value uint64
+	// Bytes is the payload of LEN fields.
+	Bytes []byte
+	// Group is the payload of SGROUP fields.
+	Group []WireField
+}
+
+type wireState struct {
+	b []byte
+	p int
+	// depth is the nesting of the group being read, counting the enclosing
+	// messages.
+	depth int
+}
+
+func (s *wireState) readByte() (byte, error) {
+	if s.p >= len(s.b) {
+		return 0, ErrUnexpectedEOF
+	}
+	s.p++
+	return s.b[s.p-1], nil
+}
+
+func (s *wireState) readVarint() (uint64, error) {
+	var result uint64
+	for shift := 0; ; shift += 7 {
+		current, err := s.readByte()
+		if err != nil {
+			return 0, err
+		}
+		if shift == 63 && current > 1 {
+			return 0, ErrVarintOverflow
+		}
+		result |= uint64(current&127) << shift
+		if current < 128 {
+			return result, nil
+		}
+	}
+}
+
+func (s *wireState) readLE(bytelen int) (uint64, error) {
+	var value uint64
+	for i := 0; i < bytelen; i++ {
+		b, err := s.readByte()
+		if err != nil {
+			return 0, err
+		}
+		value |= uint64(b) << (i * 8)
+	}
+	return value, nil
+}
+
+// readFields reads fields until EOF, or until the END_GROUP tag of endGroup
+// if inGroup is set.
+func (s *wireState) readFields(inGroup bool, endGroup uint64) ([]WireField, error) {
+	fields := []WireField{}
+	for {
+		if !inGroup && s.p >= len(s.b) {
+			return fields, nil
+		}
+		tag, err := s.readVarint()
+		if err != nil {
+			return nil, err
+		}
+		field := WireField{Number: tag >> 3, Type: protowire.Type(tag & 7)}
+		switch field.Type {
+		case protowire.VarintType:
+			field.Value, err = s.readVarint()
+		case protowire.Fixed64Type:
+			field.Value, err = s.readLE(8)
+		case protowire.Fixed32Type:
+			field.Value, err = s.readLE(4)
+		case protowire.BytesType:
+			var length uint64
+			length, err = s.readVarint()
+			if err == nil && length > uint64(len(s.b)-s.p) {
+				err = ErrUnexpectedEOF
+			}
+			if err == nil {
+				field.Bytes = s.b[s.p : s.p+int(length)]
+				s.p += int(length)
+			}
+		case protowire.StartGroupType:
+			if s.depth >= MaxDepth {
+				return nil, ErrTooDeep
+			}
+			s.depth++
+			field.Group, err = s.readFields(true, field.Number)
+			s.depth--
+		case protowire.EndGroupType:
+			if inGroup && field.Number == endGroup {
+				return fields, nil
+			}
+			err = ErrInvalidGroup
+		default:
+			err = ErrUnexpectedWireType
+		}
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+}
+
+// ParseWire splits the input into fields without interpreting them.
+// This corresponds to parseWire in bqpb.ts. Groups nested deeper than
+// MaxDepth are rejected with ErrTooDeep.
+func ParseWire(input []byte) ([]WireField, error) {
+	return parseWire(input, 0)
+}
+
+// parseWire is ParseWire for a message nested at the given depth.
+func parseWire(input []byte, depth int) ([]WireField, error) {
+	s := &wireState{b: input, depth: depth}
+	return s.readFields(false, 0)
+}
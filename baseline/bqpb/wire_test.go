@@ -0,0 +1,102 @@
+package bqpb_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+)
+
+func TestParseWire(t *testing.T) {
+	testcases := []struct {
+		name    string
+		data    []byte
+		want    []bqpb.WireField
+		wantErr error
+	}{
+		{
+			name: "empty",
+			data: []byte(""),
+			want: []bqpb.WireField{},
+		},
+		{
+			name: "all wire types",
+			data: []byte("\xf0\x23\xdd\x90\x07\x31\x11\x12\x13\x14\x15\x16\x17\x18\x35\x11\x12\x13\x14\x0a\x02\x08\x2a\x0b\x08\x2a\x0c"),
+			want: []bqpb.WireField{
+				{Number: 574, Type: protowire.VarintType, Value: 116829},
+				{Number: 6, Type: protowire.Fixed64Type, Value: 0x1817161514131211},
+				{Number: 6, Type: protowire.Fixed32Type, Value: 0x14131211},
+				{Number: 1, Type: protowire.BytesType, Bytes: []byte("\x08\x2a")},
+				{Number: 1, Type: protowire.StartGroupType, Group: []bqpb.WireField{
+					{Number: 1, Type: protowire.VarintType, Value: 42},
+				}},
+			},
+		},
+		{
+			name:    "truncated varint",
+			data:    []byte("\x08\x80"),
+			wantErr: bqpb.ErrUnexpectedEOF,
+		},
+		{
+			name:    "truncated LEN",
+			data:    []byte("\x0a\x03\x00\x00"),
+			wantErr: bqpb.ErrUnexpectedEOF,
+		},
+		{
+			name:    "END_GROUP with wrong field number",
+			data:    []byte("\x0b\x14"),
+			wantErr: bqpb.ErrInvalidGroup,
+		},
+		{
+			name:    "stray END_GROUP",
+			data:    []byte("\x0c"),
+			wantErr: bqpb.ErrInvalidGroup,
+		},
+		{
+			name:    "wire type 6",
+			data:    []byte("\x0e"),
+			wantErr: bqpb.ErrUnexpectedWireType,
+		},
+		{
+			name:    "varint wider than 64 bits",
+			data:    []byte("\x08\xff\xff\xff\xff\xff\xff\xff\xff\xff\x02"),
+			wantErr: bqpb.ErrVarintOverflow,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := bqpb.ParseWire(tc.data)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("ParseWire error = %v, want %v\n", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ParseWire() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseWireDepth(t *testing.T) {
+	nested := func(depth int) []byte {
+		return []byte(strings.Repeat("\x0b", depth) + strings.Repeat("\x0c", depth))
+	}
+	got, err := bqpb.ParseWire(nested(bqpb.MaxDepth))
+	if err != nil {
+		t.Fatalf("ParseWire error at MaxDepth: %v\n", err)
+	}
+	depth := 0
+	for len(got) == 1 {
+		got = got[0].Group
+		depth++
+	}
+	if depth != bqpb.MaxDepth {
+		t.Errorf("ParseWire() depth = %d, want %d", depth, bqpb.MaxDepth)
+	}
+	if _, err := bqpb.ParseWire(nested(bqpb.MaxDepth + 1)); !errors.Is(err, bqpb.ErrTooDeep) {
+		t.Errorf("ParseWire error beyond MaxDepth = %v, want %v", err, bqpb.ErrTooDeep)
+	}
+}
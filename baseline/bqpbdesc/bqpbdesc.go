@@ -0,0 +1,298 @@
+// Package bqpbdesc derives bqpb typedefs from protobuf descriptors.
+package bqpbdesc
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+)
+
+// FromMessage returns the typedefs needed to decode md with bqpb.
+//
+// The result contains md itself and every message and enum reachable from
+// it, under their fully qualified names. Types that bqpb decodes without
+// typedefs (see bqpb.IsSpecialType) are omitted. Fields are named after
+// their JSON names. See Convert for the other naming strategies.
+func FromMessage(md protoreflect.MessageDescriptor) *bqpb.Typedefs {
+	c := newConverter(&Options{})
+	c.addMessage(md)
+	return c.typedefs
+}
+
+// FieldNaming selects the names of the fields in typedefs.
+type FieldNaming int
+
+const (
+	// FieldNamesJSON names the fields after json_name, or the camelCase
+	// form of the field name if unspecified, as protojson prints them.
+	FieldNamesJSON FieldNaming = iota
+	// FieldNamesProto names the fields as declared in the .proto file,
+	// which protojson prints with UseProtoNames.
+	FieldNamesProto
+	// FieldNamesBoth names the fields after their JSON names, and reports
+	// the proto names in Result.FieldNames to rename them downstream.
+	FieldNamesBoth
+)
+
+// TypeNaming selects the names of the messages and enums in typedefs.
+type TypeNaming int
+
+const (
+	// TypeNamesFull uses the fully qualified names.
+	TypeNamesFull TypeNaming = iota
+	// TypeNamesPackageRelative strips the package from the names, as in
+	// "Outer.Inner" for "pkg.Outer.Inner". The special types keep their
+	// full names, which bqpb relies on. Messages packed in
+	// google.protobuf.Any must have full names, so do not use this for them.
+	TypeNamesPackageRelative
+)
+
+// Options specifies the naming strategies of Convert.
+type Options struct {
+	FieldNames FieldNaming
+	TypeNames  TypeNaming
+}
+
+// FieldName relates the two names of a field.
+type FieldName struct {
+	// Message is the name of the message in the typedefs.
+	Message string `json:"message"`
+	// JSON is the JSON name of the field, as used in the typedefs.
+	JSON string `json:"json"`
+	// Proto is the name declared in the .proto file.
+	Proto string `json:"proto"`
+}
+
+// Result is the outcome of Convert.
+type Result struct {
+	Typedefs *bqpb.Typedefs
+	// FieldNames lists every field of the typedefs in order, if the
+	// fields are named with FieldNamesBoth.
+	FieldNames []*FieldName
+}
+
+// Convert is FromMessage with the given naming strategies. It fails if
+// two types end up with the same name, which may happen with
+// TypeNamesPackageRelative for types from different packages.
+func Convert(md protoreflect.MessageDescriptor, opts *Options) (*Result, error) {
+	c := newConverter(opts)
+	c.addMessage(md)
+	if c.err != nil {
+		return nil, c.err
+	}
+	result := &Result{Typedefs: c.typedefs}
+	if opts.FieldNames == FieldNamesBoth {
+		for _, msgDef := range c.typedefs.Messages {
+			for _, fieldDef := range msgDef.Fields {
+				result.FieldNames = append(result.FieldNames, &FieldName{
+					Message: msgDef.Name,
+					JSON:    fieldDef.Name,
+					Proto:   c.protoNames[fieldDef],
+				})
+			}
+		}
+	}
+	return result, nil
+}
+
+// FromFiles returns the typedefs of every message and enum declared in the
+// files, including nested ones, along with the types they refer to from
+// other files. As with FromMessage, special types are omitted.
+func FromFiles(files ...protoreflect.FileDescriptor) *bqpb.Typedefs {
+	c := newConverter(&Options{})
+	var addDecls func(messages protoreflect.MessageDescriptors, enums protoreflect.EnumDescriptors)
+	addDecls = func(messages protoreflect.MessageDescriptors, enums protoreflect.EnumDescriptors) {
+		for i := 0; i < enums.Len(); i++ {
+			c.addEnum(enums.Get(i))
+		}
+		for i := 0; i < messages.Len(); i++ {
+			md := messages.Get(i)
+			if md.IsMapEntry() {
+				continue
+			}
+			c.addMessage(md)
+			addDecls(md.Messages(), md.Enums())
+		}
+	}
+	for _, fd := range files {
+		addDecls(fd.Messages(), fd.Enums())
+	}
+	return c.typedefs
+}
+
+// LoadDescriptorSet returns the files in a serialized FileDescriptorSet, as
+// written by protoc --descriptor_set_out. The set must include the imported
+// files, as with --include_imports.
+func LoadDescriptorSet(data []byte) (*protoregistry.Files, error) {
+	fds := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(data, fds); err != nil {
+		return nil, err
+	}
+	return protodesc.NewFiles(fds)
+}
+
+// FindMessage looks up the message of the given fully qualified name.
+func FindMessage(files *protoregistry.Files, name string) (protoreflect.MessageDescriptor, error) {
+	desc, err := files.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", name)
+	}
+	return md, nil
+}
+
+type converter struct {
+	opts     *Options
+	typedefs *bqpb.Typedefs
+	visited  map[protoreflect.FullName]bool
+	// names maps the names in the typedefs back to the types, to detect
+	// collisions.
+	names      map[string]protoreflect.FullName
+	protoNames map[*bqpb.FieldDef]string
+	err        error
+}
+
+func newConverter(opts *Options) *converter {
+	return &converter{
+		opts:       opts,
+		typedefs:   &bqpb.Typedefs{},
+		visited:    map[protoreflect.FullName]bool{},
+		names:      map[string]protoreflect.FullName{},
+		protoNames: map[*bqpb.FieldDef]string{},
+	}
+}
+
+// name returns the name of a message or enum in the typedefs.
+func (c *converter) name(desc protoreflect.Descriptor) string {
+	fullName := desc.FullName()
+	name := string(fullName)
+	if c.opts.TypeNames == TypeNamesPackageRelative && !bqpb.IsSpecialType(name) {
+		if pkg := desc.ParentFile().Package(); pkg != "" {
+			name = strings.TrimPrefix(name, string(pkg)+".")
+		}
+	}
+	if other, ok := c.names[name]; ok && other != fullName {
+		if c.err == nil {
+			c.err = fmt.Errorf("%s and %s are both named %s", other, fullName, name)
+		}
+	} else {
+		c.names[name] = fullName
+	}
+	return name
+}
+
+func (c *converter) addMessage(md protoreflect.MessageDescriptor) {
+	if c.visited[md.FullName()] || bqpb.IsSpecialType(string(md.FullName())) {
+		return
+	}
+	c.visited[md.FullName()] = true
+
+	msgDef := &bqpb.MessageDef{Name: c.name(md)}
+	c.typedefs.Messages = append(c.typedefs.Messages, msgDef)
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		fieldDef := c.convertField(fd)
+		msgDef.Fields = append(msgDef.Fields, fieldDef)
+		c.protoNames[fieldDef] = string(fd.Name())
+	}
+}
+
+func (c *converter) addEnum(ed protoreflect.EnumDescriptor) {
+	if c.visited[ed.FullName()] {
+		return
+	}
+	c.visited[ed.FullName()] = true
+
+	enumDef := &bqpb.EnumDef{Name: c.name(ed)}
+	values := ed.Values()
+	for i := 0; i < values.Len(); i++ {
+		value := values.Get(i)
+		enumDef.Values = append(enumDef.Values, &bqpb.EnumValueDef{
+			Name:   string(value.Name()),
+			Number: int32(value.Number()),
+		})
+	}
+	c.typedefs.Enums = append(c.typedefs.Enums, enumDef)
+}
+
+func (c *converter) convertField(fd protoreflect.FieldDescriptor) *bqpb.FieldDef {
+	fieldDef := &bqpb.FieldDef{
+		Name: fd.JSONName(),
+		ID:   int32(fd.Number()),
+	}
+	if c.opts.FieldNames == FieldNamesProto {
+		fieldDef.Name = string(fd.Name())
+	}
+	if fd.IsMap() {
+		// The synthesized map entry message is not part of the typedefs.
+		fieldDef.Type = fmt.Sprintf("map<%s,%s>", c.typeName(fd.MapKey()), c.typeName(fd.MapValue()))
+		return fieldDef
+	}
+	fieldDef.Type = c.typeName(fd)
+	if fd.Kind() == protoreflect.GroupKind {
+		fieldDef.MessageEncoding = bqpb.MessageEncodingDelimited
+	}
+	if fd.IsList() {
+		fieldDef.Repeated = true
+		return fieldDef
+	}
+
+	// Proto3 optional fields belong to synthetic oneofs, which protojson
+	// does not know about; they are plain fields with explicit presence.
+	// Only real oneofs are reported, named after the JSON convention.
+	if od := fd.ContainingOneof(); od != nil && !od.IsSynthetic() {
+		fieldDef.OneofGroup = jsonCamelCase(string(od.Name()))
+		if c.opts.FieldNames == FieldNamesProto {
+			fieldDef.OneofGroup = string(od.Name())
+		}
+	} else if fd.HasPresence() {
+		fieldDef.FieldPresence = bqpb.FieldPresenceExplicit
+	} else {
+		fieldDef.FieldPresence = bqpb.FieldPresenceImplicit
+	}
+	return fieldDef
+}
+
+// typeName returns the name of the field's type as written in typedefs,
+// registering the referenced message or enum as needed.
+func (c *converter) typeName(fd protoreflect.FieldDescriptor) string {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		c.addEnum(fd.Enum())
+		return c.name(fd.Enum())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		c.addMessage(fd.Message())
+		return c.name(fd.Message())
+	default:
+		return fd.Kind().String()
+	}
+}
+
+// jsonCamelCase converts a snake_case identifier to camelCase in the same
+// way as protoc does for json_name.
+func jsonCamelCase(s string) string {
+	var b []byte
+	wasUnderscore := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '_' {
+			if wasUnderscore && 'a' <= c && c <= 'z' {
+				c -= 'a' - 'A'
+			}
+			b = append(b, c)
+		}
+		wasUnderscore = c == '_'
+	}
+	return string(b)
+}
@@ -0,0 +1,178 @@
+package bqpbdesc_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+	"github.com/qnighy/bqpb/baseline/bqpbdesc"
+	"github.com/qnighy/bqpb/baseline/example2pb"
+	"github.com/qnighy/bqpb/baseline/example3pb"
+	"github.com/qnighy/bqpb/baseline/examplepb"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestFromMessage(t *testing.T) {
+	files := []protoreflect.FileDescriptor{
+		examplepb.File_example_proto,
+		example2pb.File_example2_proto,
+		example3pb.File_example3_proto,
+	}
+	for _, fd := range files {
+		messages := fd.Messages()
+		for i := 0; i < messages.Len(); i++ {
+			md := messages.Get(i)
+			t.Run(string(md.FullName()), func(t *testing.T) {
+				var buf bytes.Buffer
+				enc := json.NewEncoder(&buf)
+				enc.SetEscapeHTML(false)
+				enc.SetIndent("", "  ")
+				if err := enc.Encode(bqpbdesc.FromMessage(md)); err != nil {
+					t.Fatalf("Encode error: %v\n", err)
+				}
+				checkGolden(t, filepath.Join("testdata", string(md.FullName())+".json"), buf.Bytes())
+			})
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	testcases := []struct {
+		name string
+		md   protoreflect.MessageDescriptor
+		opts bqpbdesc.Options
+	}{
+		{
+			name: "json",
+			md:   (&example3pb.Document{}).ProtoReflect().Descriptor(),
+		},
+		{
+			name: "proto",
+			md:   (&example3pb.Document{}).ProtoReflect().Descriptor(),
+			opts: bqpbdesc.Options{FieldNames: bqpbdesc.FieldNamesProto},
+		},
+		{
+			name: "both",
+			md:   (&example3pb.Document{}).ProtoReflect().Descriptor(),
+			opts: bqpbdesc.Options{FieldNames: bqpbdesc.FieldNamesBoth},
+		},
+		{
+			name: "relative",
+			md:   (&example3pb.Document{}).ProtoReflect().Descriptor(),
+			opts: bqpbdesc.Options{TypeNames: bqpbdesc.TypeNamesPackageRelative},
+		},
+		{
+			name: "relative_special",
+			md:   (&examplepb.MapStringStruct{}).ProtoReflect().Descriptor(),
+			opts: bqpbdesc.Options{TypeNames: bqpbdesc.TypeNamesPackageRelative},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := bqpbdesc.Convert(tc.md, &tc.opts)
+			if err != nil {
+				t.Fatalf("Convert error: %v\n", err)
+			}
+			var buf bytes.Buffer
+			enc := json.NewEncoder(&buf)
+			enc.SetEscapeHTML(false)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(result.Typedefs); err != nil {
+				t.Fatalf("Encode error: %v\n", err)
+			}
+			if result.FieldNames != nil {
+				if err := enc.Encode(result.FieldNames); err != nil {
+					t.Fatalf("Encode error: %v\n", err)
+				}
+			}
+			checkGolden(t, filepath.Join("testdata", "convert", tc.name+".json"), buf.Bytes())
+		})
+	}
+}
+
+// TestConvertFieldNames checks that bqpb prints the field names as
+// protojson does, with and without UseProtoNames.
+func TestConvertFieldNames(t *testing.T) {
+	m := &example3pb.CustomJsonName{
+		PlainField:   1,
+		RenamedField: 2,
+		SnakeJson:    3,
+		MyChoice:     &example3pb.CustomJsonName_ChoiceB{ChoiceB: 5},
+		CountsByKey:  map[string]uint32{"k": 6},
+	}
+	data, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal error: %v\n", err)
+	}
+	md := m.ProtoReflect().Descriptor()
+	for _, useProtoNames := range []bool{false, true} {
+		opts := &bqpbdesc.Options{}
+		if useProtoNames {
+			opts.FieldNames = bqpbdesc.FieldNamesProto
+		}
+		result, err := bqpbdesc.Convert(md, opts)
+		if err != nil {
+			t.Fatalf("Convert error: %v\n", err)
+		}
+		got, err := bqpb.Parse(data, string(md.FullName()), *result.Typedefs)
+		if err != nil {
+			t.Fatalf("Parse error: %v\n", err)
+		}
+		wantJSON, err := protojson.MarshalOptions{UseProtoNames: useProtoNames}.Marshal(m)
+		if err != nil {
+			t.Fatalf("protojson error: %v\n", err)
+		}
+		var gotValue, wantValue interface{}
+		if err := json.Unmarshal(got, &gotValue); err != nil {
+			t.Fatalf("Unmarshal error: %v\n", err)
+		}
+		if err := json.Unmarshal(wantJSON, &wantValue); err != nil {
+			t.Fatalf("Unmarshal error: %v\n", err)
+		}
+		if diff := cmp.Diff(wantValue, gotValue); diff != "" {
+			t.Errorf("UseProtoNames=%v: Parse() mismatch (-protojson +bqpb):\n%s", useProtoNames, diff)
+		}
+	}
+}
+
+func TestConvertCollision(t *testing.T) {
+	md := (&example3pb.EmptyConflict{}).ProtoReflect().Descriptor()
+	if _, err := bqpbdesc.Convert(md, &bqpbdesc.Options{}); err != nil {
+		t.Errorf("Convert error with full names: %v", err)
+	}
+	_, err := bqpbdesc.Convert(md, &bqpbdesc.Options{TypeNames: bqpbdesc.TypeNamesPackageRelative})
+	want := "example3.Empty and google.protobuf.Empty are both named Empty"
+	if err == nil || err.Error() != want {
+		t.Errorf("Convert() error = %v, want %q", err, want)
+	}
+}
+
+func checkGolden(t *testing.T, path string, got []byte) {
+	t.Helper()
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("MkdirAll error: %v\n", err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("WriteFile error: %v\n", err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile error: %v\n", err)
+	}
+	if diff := cmp.Diff(string(want), string(got)); diff != "" {
+		t.Errorf("%s mismatch (-want +got):\n%s", path, diff)
+	}
+}
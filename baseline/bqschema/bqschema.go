@@ -0,0 +1,210 @@
+// Package bqschema derives BigQuery table schemas from bqpb typedefs.
+//
+// The schema describes the JSON that parseProtobuf produces, so that its
+// output can be loaded into a typed table, as in
+//
+//	bq load --source_format=NEWLINE_DELIMITED_JSON dataset.table out.jsonl schema.json
+//
+// The types follow the shape of the bqpb output rather than the protobuf
+// types:
+//
+//   - Messages and groups are RECORDs, and repeated fields are REPEATED.
+//     Records nested beyond BigQuery's limit of 15 levels, and messages
+//     without fields, are JSON instead.
+//   - 32-bit integers are INT64. So are signed 64-bit integers, which bqpb
+//     emits as decimal strings. Unsigned 64-bit integers may not fit in
+//     INT64 and are STRINGs.
+//   - float and double are FLOAT64. bqpb emits NaN and the infinities as the
+//     strings BigQuery accepts for them.
+//   - Enums are STRINGs. Values without a name are emitted as numbers.
+//   - Maps are JSON, as bqpb emits them as objects.
+//   - google.protobuf.Timestamp is TIMESTAMP; Struct, Value, ListValue and
+//     Any are JSON; Duration and FieldMask are STRINGs; wrappers are their
+//     wrapped types.
+//
+// Every field is NULLABLE unless repeated. Fields with implicit presence are
+// always populated by bqpb, with their zero values if absent from the input.
+// Unknown fields ("#1" and so on) have no columns.
+package bqschema
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+)
+
+// Field is a column in a BigQuery table schema, in the JSON format of the
+// bq command and the REST API.
+type Field struct {
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Mode        string   `json:"mode"`
+	Fields      []*Field `json:"fields,omitempty"`
+	Description string   `json:"description,omitempty"`
+}
+
+// Values of Field.Type.
+const (
+	TypeBool      = "BOOL"
+	TypeInt64     = "INT64"
+	TypeFloat64   = "FLOAT64"
+	TypeString    = "STRING"
+	TypeBytes     = "BYTES"
+	TypeTimestamp = "TIMESTAMP"
+	TypeJSON      = "JSON"
+	TypeRecord    = "RECORD"
+)
+
+// Values of Field.Mode.
+const (
+	ModeNullable = "NULLABLE"
+	ModeRepeated = "REPEATED"
+)
+
+// MaxDepth is the maximum nesting of RECORDs in BigQuery.
+const MaxDepth = 15
+
+// scalarTypes maps the scalar types of typedefs to BigQuery types.
+var scalarTypes = map[string]string{
+	"bool":     TypeBool,
+	"uint32":   TypeInt64,
+	"int32":    TypeInt64,
+	"sint32":   TypeInt64,
+	"uint64":   TypeString,
+	"int64":    TypeInt64,
+	"sint64":   TypeInt64,
+	"fixed32":  TypeInt64,
+	"sfixed32": TypeInt64,
+	"float":    TypeFloat64,
+	"fixed64":  TypeString,
+	"sfixed64": TypeInt64,
+	"double":   TypeFloat64,
+	"bytes":    TypeBytes,
+	"string":   TypeString,
+}
+
+// specialTypes maps the well-known types with special JSON forms to
+// BigQuery types.
+var specialTypes = map[string]string{
+	"google.protobuf.UInt32Value": TypeInt64,
+	"google.protobuf.Int32Value":  TypeInt64,
+	"google.protobuf.UInt64Value": TypeString,
+	"google.protobuf.Int64Value":  TypeInt64,
+	"google.protobuf.DoubleValue": TypeFloat64,
+	"google.protobuf.FloatValue":  TypeFloat64,
+	"google.protobuf.BoolValue":   TypeBool,
+	"google.protobuf.StringValue": TypeString,
+	"google.protobuf.BytesValue":  TypeBytes,
+	"google.protobuf.Any":         TypeJSON,
+	"google.protobuf.Value":       TypeJSON,
+	"google.protobuf.Struct":      TypeJSON,
+	"google.protobuf.ListValue":   TypeJSON,
+	"google.protobuf.FieldMask":   TypeString,
+	"google.protobuf.Timestamp":   TypeTimestamp,
+	"google.protobuf.Duration":    TypeString,
+}
+
+// columnName is the syntax of BigQuery column names, without the flexible
+// column names feature.
+var columnName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,299}$`)
+
+// FromTypedefs returns the schema of the table holding the outputs of
+// parseProtobuf for messageType.
+//
+// It fails if messageType is not a message defined in the typedefs, or if a
+// field name is not a valid BigQuery column name. As BigQuery column names
+// are case-insensitive, names differing only in case are also rejected.
+func FromTypedefs(typedefs *bqpb.Typedefs, messageType string) ([]*Field, error) {
+	if bqpb.IsSpecialType(messageType) || typedefs.Message(messageType) == nil {
+		return nil, fmt.Errorf("message %s is not defined in the typedefs", messageType)
+	}
+	g := &generator{typedefs: typedefs}
+	return g.fields(messageType, 1)
+}
+
+type generator struct {
+	typedefs *bqpb.Typedefs
+}
+
+// fields returns the columns of a RECORD at the given depth.
+func (g *generator) fields(messageType string, depth int) ([]*Field, error) {
+	var fields []*Field
+	seen := map[string]string{}
+	for _, fieldDef := range g.typedefs.Message(messageType).Entries() {
+		if !columnName.MatchString(fieldDef.Name) {
+			return nil, fmt.Errorf("%s.%s: not a valid BigQuery column name", messageType, fieldDef.Name)
+		}
+		folded := strings.ToLower(fieldDef.Name)
+		if other, ok := seen[folded]; ok {
+			return nil, fmt.Errorf("%s.%s: conflicts with %s, as BigQuery column names are case-insensitive", messageType, fieldDef.Name, other)
+		}
+		seen[folded] = fieldDef.Name
+
+		field, err := g.field(fieldDef, depth)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+func (g *generator) field(fieldDef *bqpb.FieldDef, depth int) (*Field, error) {
+	field := &Field{Name: fieldDef.Name, Mode: ModeNullable}
+	if fieldDef.Repeated {
+		field.Mode = ModeRepeated
+	}
+	typeName := fieldDef.Type
+	if strings.HasPrefix(typeName, "map<") {
+		// Maps are JSON objects and never repeated.
+		field.Type = TypeJSON
+		field.Mode = ModeNullable
+		field.Description = typeName
+		return field, nil
+	}
+	if bqType, ok := scalarTypes[typeName]; ok {
+		field.Type = bqType
+		if bqType == TypeString && typeName != "string" {
+			field.Description = typeName
+		}
+		return field, nil
+	}
+	if g.typedefs.Enum(typeName) != nil {
+		field.Type = TypeString
+		field.Description = "enum " + typeName
+		return field, nil
+	}
+	if bqType, ok := specialTypes[typeName]; ok {
+		field.Type = bqType
+		if bqType != TypeTimestamp {
+			field.Description = typeName
+		}
+		return field, nil
+	}
+	if depth >= MaxDepth {
+		field.Type = TypeJSON
+		field.Description = typeName + ", nested too deeply for a RECORD"
+		return field, nil
+	}
+	if g.typedefs.Message(typeName) == nil {
+		// bqpb emits only the unknown fields of undefined messages.
+		field.Type = TypeJSON
+		field.Description = typeName + ", undefined"
+		return field, nil
+	}
+	subfields, err := g.fields(typeName, depth+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(subfields) == 0 {
+		// BigQuery does not allow empty RECORDs.
+		field.Type = TypeJSON
+		field.Description = typeName + ", without fields"
+		return field, nil
+	}
+	field.Type = TypeRecord
+	field.Fields = subfields
+	return field, nil
+}
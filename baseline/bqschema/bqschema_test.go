@@ -0,0 +1,148 @@
+package bqschema_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+	"github.com/qnighy/bqpb/baseline/bqpbdesc"
+	"github.com/qnighy/bqpb/baseline/bqschema"
+	"github.com/qnighy/bqpb/baseline/example2pb"
+	"github.com/qnighy/bqpb/baseline/examplepb"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestFromTypedefsGolden(t *testing.T) {
+	files := []protoreflect.FileDescriptor{
+		examplepb.File_example_proto,
+		example2pb.File_example2_proto,
+	}
+	for _, fd := range files {
+		messages := fd.Messages()
+		for i := 0; i < messages.Len(); i++ {
+			md := messages.Get(i)
+			t.Run(string(md.FullName()), func(t *testing.T) {
+				schema, err := bqschema.FromTypedefs(bqpbdesc.FromMessage(md), string(md.FullName()))
+				if err != nil {
+					t.Fatalf("FromTypedefs error: %v\n", err)
+				}
+				var buf bytes.Buffer
+				enc := json.NewEncoder(&buf)
+				enc.SetEscapeHTML(false)
+				enc.SetIndent("", "  ")
+				if err := enc.Encode(schema); err != nil {
+					t.Fatalf("Encode error: %v\n", err)
+				}
+				checkGolden(t, filepath.Join("testdata", string(md.FullName())+".json"), buf.Bytes())
+			})
+		}
+	}
+}
+
+func TestFromTypedefs(t *testing.T) {
+	testcases := []struct {
+		name     string
+		typedefs string
+		want     string
+		wantErr  string
+	}{
+		{
+			name:     "scalars",
+			typedefs: `{"message Main":{"a":{"type":"int64","id":1},"b":{"type":"fixed64","id":2,"repeated":true},"c":{"type":"E","id":3}},"enum E":{"X":0}}`,
+			want:     `[{"name":"a","type":"INT64","mode":"NULLABLE"},{"name":"b","type":"STRING","mode":"REPEATED","description":"fixed64"},{"name":"c","type":"STRING","mode":"NULLABLE","description":"enum E"}]`,
+		},
+		{
+			name:     "maps and well-known types",
+			typedefs: `{"message Main":{"m":{"type":"map<string,Main>","id":1},"t":{"type":"google.protobuf.Timestamp","id":2},"s":{"type":"google.protobuf.Struct","id":3,"repeated":true}}}`,
+			want:     `[{"name":"m","type":"JSON","mode":"NULLABLE","description":"map<string,Main>"},{"name":"t","type":"TIMESTAMP","mode":"NULLABLE"},{"name":"s","type":"JSON","mode":"REPEATED","description":"google.protobuf.Struct"}]`,
+		},
+		{
+			name:     "duplicate field names",
+			typedefs: `{"message Main":{"a":{"type":"int32","id":1},"a":{"type":"string","id":2}}}`,
+			want:     `[{"name":"a","type":"STRING","mode":"NULLABLE"}]`,
+		},
+		{
+			name:     "empty and undefined messages",
+			typedefs: `{"message Main":{"a":{"type":"Empty","id":1},"b":{"type":"Undefined","id":2}},"message Empty":{}}`,
+			want:     `[{"name":"a","type":"JSON","mode":"NULLABLE","description":"Empty, without fields"},{"name":"b","type":"JSON","mode":"NULLABLE","description":"Undefined, undefined"}]`,
+		},
+		{
+			name:     "recursion",
+			typedefs: `{"message Main":{"next":{"type":"Main","id":1},"value":{"type":"int32","id":2}}}`,
+			want: strings.Repeat(`[{"name":"next","type":"RECORD","mode":"NULLABLE","fields":`, bqschema.MaxDepth-1) +
+				`[{"name":"next","type":"JSON","mode":"NULLABLE","description":"Main, nested too deeply for a RECORD"},{"name":"value","type":"INT64","mode":"NULLABLE"}]` +
+				strings.Repeat(`},{"name":"value","type":"INT64","mode":"NULLABLE"}]`, bqschema.MaxDepth-1),
+		},
+		{
+			name:     "invalid column name",
+			typedefs: `{"message Main":{"@type":{"type":"string","id":1}}}`,
+			wantErr:  "Main.@type: not a valid BigQuery column name",
+		},
+		{
+			name:     "case conflict",
+			typedefs: `{"message Main":{"fooBar":{"type":"string","id":1},"foobar":{"type":"string","id":2}}}`,
+			wantErr:  "Main.foobar: conflicts with fooBar, as BigQuery column names are case-insensitive",
+		},
+		{
+			name:     "undefined message",
+			typedefs: `{}`,
+			wantErr:  "message Main is not defined in the typedefs",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var typedefs bqpb.Typedefs
+			if err := json.Unmarshal([]byte(tc.typedefs), &typedefs); err != nil {
+				t.Fatalf("Unmarshal error: %v\n", err)
+			}
+			schema, err := bqschema.FromTypedefs(&typedefs, "Main")
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Errorf("FromTypedefs() error = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromTypedefs error: %v\n", err)
+			}
+			var buf bytes.Buffer
+			enc := json.NewEncoder(&buf)
+			enc.SetEscapeHTML(false)
+			if err := enc.Encode(schema); err != nil {
+				t.Fatalf("Encode error: %v\n", err)
+			}
+			if diff := cmp.Diff(tc.want+"\n", buf.String()); diff != "" {
+				t.Errorf("FromTypedefs() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func checkGolden(t *testing.T, path string, got []byte) {
+	t.Helper()
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("MkdirAll error: %v\n", err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("WriteFile error: %v\n", err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile error: %v\n", err)
+	}
+	if diff := cmp.Diff(string(want), string(got)); diff != "" {
+		t.Errorf("%s mismatch (-want +got):\n%s", path, diff)
+	}
+}
@@ -0,0 +1,150 @@
+package baseline_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+	"github.com/qnighy/bqpb/baseline/bqschema"
+)
+
+// TestSchemaConformance checks that the output of Parse on every
+// serialization case can be loaded into a table of the schema bqschema
+// generates, including the zero values of absent fields.
+func TestSchemaConformance(t *testing.T) {
+	for _, tc := range serializationTestCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			md := tc.datatype.ProtoReflect().Descriptor()
+			messageType := string(md.FullName())
+			if bqpb.IsSpecialType(messageType) {
+				t.Skip("not a table row")
+			}
+			typedefs := corpusTypedefs(md)
+			got, err := bqpb.Decode(tc.data, messageType, *typedefs)
+			if err != nil {
+				t.Skipf("Parse error: %v", err)
+			}
+			schema, err := bqschema.FromTypedefs(typedefs, messageType)
+			if err != nil {
+				t.Fatalf("FromTypedefs error: %v\n", err)
+			}
+			if err := checkRecord(schema, got); err != nil {
+				t.Errorf("output does not conform to the schema: %v", err)
+			}
+		})
+	}
+}
+
+// checkRecord checks the value against the columns as a load job of
+// newline-delimited JSON does with ignoreUnknownValues, which skips the
+// unknown fields.
+func checkRecord(fields []*bqschema.Field, value bqpb.Value) error {
+	obj, ok := value.(*bqpb.Object)
+	if !ok {
+		return fmt.Errorf("expected an object, got %T", value)
+	}
+	for _, key := range obj.Keys() {
+		if !strings.HasPrefix(key, "#") && findColumn(fields, key) == nil {
+			return fmt.Errorf("%s: no such column", key)
+		}
+	}
+	for _, field := range fields {
+		v, ok := obj.Get(field.Name)
+		if !ok || v == nil {
+			continue
+		}
+		if field.Mode == bqschema.ModeRepeated {
+			elems, ok := v.([]bqpb.Value)
+			if !ok {
+				return fmt.Errorf("%s: expected an array, got %T", field.Name, v)
+			}
+			for i, elem := range elems {
+				if elem == nil {
+					return fmt.Errorf("%s[%d]: null in a repeated field", field.Name, i)
+				}
+				if err := checkColumn(field, elem); err != nil {
+					return fmt.Errorf("%s[%d]: %w", field.Name, i, err)
+				}
+			}
+			continue
+		}
+		if err := checkColumn(field, v); err != nil {
+			return fmt.Errorf("%s: %w", field.Name, err)
+		}
+	}
+	return nil
+}
+
+func findColumn(fields []*bqschema.Field, name string) *bqschema.Field {
+	for _, field := range fields {
+		if field.Name == name {
+			return field
+		}
+	}
+	return nil
+}
+
+var bigQueryTimestamp = regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}:[0-9]{2}(\.[0-9]{1,9})?Z$`)
+
+func checkColumn(field *bqschema.Field, v bqpb.Value) error {
+	switch field.Type {
+	case bqschema.TypeRecord:
+		return checkRecord(field.Fields, v)
+	case bqschema.TypeJSON:
+		return nil
+	case bqschema.TypeBool:
+		if _, ok := v.(bool); ok {
+			return nil
+		}
+	case bqschema.TypeInt64:
+		switch v := v.(type) {
+		case float64:
+			if v == float64(int64(v)) {
+				return nil
+			}
+		case string:
+			if _, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return nil
+			}
+		}
+	case bqschema.TypeFloat64:
+		switch v := v.(type) {
+		case float64:
+			return nil
+		case string:
+			if v == "NaN" || v == "Infinity" || v == "-Infinity" {
+				return nil
+			}
+		}
+	case bqschema.TypeString:
+		switch v.(type) {
+		case string:
+			return nil
+		case float64:
+			// Enum values without names
+			if strings.HasPrefix(field.Description, "enum ") {
+				return nil
+			}
+		}
+	case bqschema.TypeBytes:
+		if s, ok := v.(string); ok {
+			if _, err := base64.StdEncoding.DecodeString(s); err == nil {
+				return nil
+			}
+		}
+	case bqschema.TypeTimestamp:
+		if s, ok := v.(string); ok && bigQueryTimestamp.MatchString(s) {
+			if _, err := time.Parse(time.RFC3339Nano, s); err == nil {
+				return nil
+			}
+		}
+	}
+	text, _ := json.Marshal(v)
+	return fmt.Errorf("%s is not a valid %s", text, field.Type)
+}
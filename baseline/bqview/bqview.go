@@ -0,0 +1,136 @@
+// Package bqview generates BigQuery SQL extracting typed columns from the
+// JSON that parseProtobuf produces.
+//
+// The columns follow the schema of package bqschema, and are extracted as
+// follows:
+//
+//   - INT64 and BOOL columns use LAX_INT64 and LAX_BOOL, which accept the
+//     decimal strings bqpb emits for 64-bit integers.
+//   - STRING columns use JSON_VALUE. Enum values without names become their
+//     decimal numbers.
+//   - FLOAT64 columns cast the result of JSON_VALUE, after rewriting
+//     "Infinity" to "inf" as CAST expects. "NaN" is accepted as is.
+//   - BYTES columns decode the base64 strings with FROM_BASE64.
+//   - TIMESTAMP columns truncate the nanoseconds to microseconds, which is
+//     the precision of BigQuery.
+//   - JSON columns are kept as JSON.
+//   - RECORD columns are STRUCTs, or NULL unless the value is an object.
+//   - REPEATED columns are ARRAYs built from UNNEST(JSON_QUERY_ARRAY(...)),
+//     in the original order.
+package bqview
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+	"github.com/qnighy/bqpb/baseline/bqschema"
+)
+
+// Options specifies the query around the columns.
+type Options struct {
+	// Column is the SQL expression of the JSON to extract from, usually a
+	// column holding the output of parseProtobuf. If empty, "payload" is
+	// used.
+	Column string
+	// From is the name of the table to read from. It is quoted in
+	// backquotes.
+	From string
+	// View is the name of the view to create. If empty, only the SELECT
+	// statement is generated.
+	View string
+}
+
+// SQL returns the query extracting the fields of messageType as columns.
+func SQL(typedefs *bqpb.Typedefs, messageType string, opts *Options) (string, error) {
+	if opts.From == "" {
+		return "", fmt.Errorf("no table to read from")
+	}
+	schema, err := bqschema.FromTypedefs(typedefs, messageType)
+	if err != nil {
+		return "", err
+	}
+	column := opts.Column
+	if column == "" {
+		column = "payload"
+	}
+
+	var sb strings.Builder
+	if opts.View != "" {
+		fmt.Fprintf(&sb, "CREATE OR REPLACE VIEW %s AS\n", quoteIdentifier(opts.View))
+	}
+	sb.WriteString("SELECT\n")
+	for i, field := range schema {
+		sb.WriteString("  ")
+		sb.WriteString(extract(field, column, 1))
+		sb.WriteString(" AS ")
+		sb.WriteString(quoteIdentifier(field.Name))
+		if i < len(schema)-1 {
+			sb.WriteByte(',')
+		}
+		sb.WriteByte('\n')
+	}
+	fmt.Fprintf(&sb, "FROM %s\n", quoteIdentifier(opts.From))
+	return sb.String(), nil
+}
+
+// extract returns the expression extracting the field from the JSON object
+// given by parent. depth numbers the variables of nested UNNESTs.
+func extract(field *bqschema.Field, parent string, depth int) string {
+	value := parent + "[" + quoteString(field.Name) + "]"
+	if field.Mode != bqschema.ModeRepeated {
+		return convert(field, value, depth)
+	}
+	elem := fmt.Sprintf("e%d", depth)
+	offset := fmt.Sprintf("o%d", depth)
+	selectList := convert(field, elem, depth)
+	if field.Type == bqschema.TypeRecord {
+		// The elements of repeated messages are never null.
+		selectList = "AS STRUCT " + strings.Join(members(field.Fields, elem, depth+1), ", ")
+	}
+	return fmt.Sprintf("ARRAY(SELECT %s FROM UNNEST(JSON_QUERY_ARRAY(%s)) AS %s WITH OFFSET AS %s ORDER BY %s)",
+		selectList, value, elem, offset, offset)
+}
+
+// convert returns the expression converting a single JSON value.
+func convert(field *bqschema.Field, value string, depth int) string {
+	switch field.Type {
+	case bqschema.TypeBool:
+		return "LAX_BOOL(" + value + ")"
+	case bqschema.TypeInt64:
+		return "LAX_INT64(" + value + ")"
+	case bqschema.TypeFloat64:
+		return "CAST(REPLACE(JSON_VALUE(" + value + "), 'Infinity', 'inf') AS FLOAT64)"
+	case bqschema.TypeString:
+		return "JSON_VALUE(" + value + ")"
+	case bqschema.TypeBytes:
+		return "FROM_BASE64(JSON_VALUE(" + value + "))"
+	case bqschema.TypeTimestamp:
+		return "TIMESTAMP(REGEXP_REPLACE(JSON_VALUE(" + value + `), r'(\.[0-9]{6})[0-9]*', r'\1'))`
+	case bqschema.TypeRecord:
+		return "IF(JSON_TYPE(" + value + ") = 'object', " + structOf(field.Fields, value, depth+1) + ", NULL)"
+	default:
+		return value
+	}
+}
+
+func structOf(fields []*bqschema.Field, parent string, depth int) string {
+	return "STRUCT(" + strings.Join(members(fields, parent, depth), ", ") + ")"
+}
+
+// members returns the aliased expressions of the fields of a record.
+func members(fields []*bqschema.Field, parent string, depth int) []string {
+	exprs := make([]string, len(fields))
+	for i, field := range fields {
+		exprs[i] = extract(field, parent, depth) + " AS " + quoteIdentifier(field.Name)
+	}
+	return exprs
+}
+
+func quoteIdentifier(name string) string {
+	return "`" + strings.NewReplacer(`\`, `\\`, "`", "\\`").Replace(name) + "`"
+}
+
+func quoteString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, "'", `\'`).Replace(s) + "'"
+}
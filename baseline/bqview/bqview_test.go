@@ -0,0 +1,120 @@
+package bqview_test
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+	"github.com/qnighy/bqpb/baseline/bqpbdesc"
+	"github.com/qnighy/bqpb/baseline/bqview"
+	"github.com/qnighy/bqpb/baseline/example2pb"
+	"github.com/qnighy/bqpb/baseline/examplepb"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestSQLGolden(t *testing.T) {
+	files := []protoreflect.FileDescriptor{
+		examplepb.File_example_proto,
+		example2pb.File_example2_proto,
+	}
+	for _, fd := range files {
+		messages := fd.Messages()
+		for i := 0; i < messages.Len(); i++ {
+			md := messages.Get(i)
+			t.Run(string(md.FullName()), func(t *testing.T) {
+				query, err := bqview.SQL(bqpbdesc.FromMessage(md), string(md.FullName()), &bqview.Options{From: "dataset.table"})
+				if err != nil {
+					t.Fatalf("SQL error: %v\n", err)
+				}
+				checkGolden(t, filepath.Join("testdata", string(md.FullName())+".sql"), []byte(query))
+			})
+		}
+	}
+}
+
+func TestSQL(t *testing.T) {
+	testcases := []struct {
+		name     string
+		typedefs string
+		opts     bqview.Options
+		want     string
+		wantErr  string
+	}{
+		{
+			name:     "scalars",
+			typedefs: `{"message Main":{"i":{"type":"int64","id":1},"u":{"type":"uint64","id":2},"f":{"type":"double","id":3},"b":{"type":"bytes","id":4},"t":{"type":"google.protobuf.Timestamp","id":5},"select":{"type":"bool","id":6}}}`,
+			opts:     bqview.Options{From: "p.d.t"},
+			want: "SELECT\n" +
+				"  LAX_INT64(payload['i']) AS `i`,\n" +
+				"  JSON_VALUE(payload['u']) AS `u`,\n" +
+				"  CAST(REPLACE(JSON_VALUE(payload['f']), 'Infinity', 'inf') AS FLOAT64) AS `f`,\n" +
+				"  FROM_BASE64(JSON_VALUE(payload['b'])) AS `b`,\n" +
+				`  TIMESTAMP(REGEXP_REPLACE(JSON_VALUE(payload['t']), r'(\.[0-9]{6})[0-9]*', r'\1')) AS ` + "`t`,\n" +
+				"  LAX_BOOL(payload['select']) AS `select`\n" +
+				"FROM `p.d.t`\n",
+		},
+		{
+			name:     "nested and repeated",
+			typedefs: `{"message Main":{"s":{"type":"Sub","id":1},"r":{"type":"Sub","id":2,"repeated":true}},"message Sub":{"x":{"type":"int32","id":1,"repeated":true}}}`,
+			opts:     bqview.Options{From: "t", Column: "parsed", View: "d.v"},
+			want: "CREATE OR REPLACE VIEW `d.v` AS\n" +
+				"SELECT\n" +
+				"  IF(JSON_TYPE(parsed['s']) = 'object', STRUCT(ARRAY(SELECT LAX_INT64(e2) FROM UNNEST(JSON_QUERY_ARRAY(parsed['s']['x'])) AS e2 WITH OFFSET AS o2 ORDER BY o2) AS `x`), NULL) AS `s`,\n" +
+				"  ARRAY(SELECT AS STRUCT ARRAY(SELECT LAX_INT64(e2) FROM UNNEST(JSON_QUERY_ARRAY(e1['x'])) AS e2 WITH OFFSET AS o2 ORDER BY o2) AS `x` FROM UNNEST(JSON_QUERY_ARRAY(parsed['r'])) AS e1 WITH OFFSET AS o1 ORDER BY o1) AS `r`\n" +
+				"FROM `t`\n",
+		},
+		{
+			name:     "no table",
+			typedefs: `{"message Main":{}}`,
+			wantErr:  "no table to read from",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var typedefs bqpb.Typedefs
+			if err := json.Unmarshal([]byte(tc.typedefs), &typedefs); err != nil {
+				t.Fatalf("Unmarshal error: %v\n", err)
+			}
+			got, err := bqview.SQL(&typedefs, "Main", &tc.opts)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Errorf("SQL() error = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SQL error: %v\n", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("SQL() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func checkGolden(t *testing.T, path string, got []byte) {
+	t.Helper()
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("MkdirAll error: %v\n", err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("WriteFile error: %v\n", err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile error: %v\n", err)
+	}
+	if diff := cmp.Diff(string(want), string(got)); diff != "" {
+		t.Errorf("%s mismatch (-want +got):\n%s", path, diff)
+	}
+}
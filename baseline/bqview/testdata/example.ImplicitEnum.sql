@@ -0,0 +1,3 @@
+SELECT
+  JSON_VALUE(payload['myField']) AS `myField`
+FROM `dataset.table`
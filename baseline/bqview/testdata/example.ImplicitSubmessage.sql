@@ -0,0 +1,3 @@
+SELECT
+  IF(JSON_TYPE(payload['myField']) = 'object', STRUCT(ARRAY(SELECT LAX_INT64(e2) FROM UNNEST(JSON_QUERY_ARRAY(payload['myField']['submessageField'])) AS e2 WITH OFFSET AS o2 ORDER BY o2) AS `submessageField`), NULL) AS `myField`
+FROM `dataset.table`
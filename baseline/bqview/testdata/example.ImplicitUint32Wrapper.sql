@@ -0,0 +1,3 @@
+SELECT
+  LAX_INT64(payload['myField']) AS `myField`
+FROM `dataset.table`
@@ -0,0 +1,3 @@
+SELECT
+  payload['myField'] AS `myField`
+FROM `dataset.table`
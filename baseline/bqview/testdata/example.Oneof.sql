@@ -0,0 +1,4 @@
+SELECT
+  LAX_INT64(payload['uint32Field']) AS `uint32Field`,
+  JSON_VALUE(payload['stringField']) AS `stringField`
+FROM `dataset.table`
@@ -0,0 +1,3 @@
+SELECT
+  ARRAY(SELECT FROM_BASE64(JSON_VALUE(e1)) FROM UNNEST(JSON_QUERY_ARRAY(payload['myField'])) AS e1 WITH OFFSET AS o1 ORDER BY o1) AS `myField`
+FROM `dataset.table`
@@ -0,0 +1,3 @@
+SELECT
+  ARRAY(SELECT CAST(REPLACE(JSON_VALUE(e1), 'Infinity', 'inf') AS FLOAT64) FROM UNNEST(JSON_QUERY_ARRAY(payload['myField'])) AS e1 WITH OFFSET AS o1 ORDER BY o1) AS `myField`
+FROM `dataset.table`
@@ -0,0 +1,3 @@
+SELECT
+  IF(JSON_TYPE(payload['outerField']) = 'object', STRUCT(IF(JSON_TYPE(payload['outerField']['innerField']) = 'object', STRUCT(LAX_INT64(payload['outerField']['innerField']['submessageField']) AS `submessageField`), NULL) AS `innerField`), NULL) AS `outerField`
+FROM `dataset.table`
@@ -0,0 +1,4 @@
+SELECT
+  LAX_INT64(payload['uint32Field']) AS `uint32Field`,
+  IF(JSON_TYPE(payload['groupField']) = 'object', STRUCT(LAX_INT64(payload['groupField']['submessageField']) AS `submessageField`), NULL) AS `groupField`
+FROM `dataset.table`
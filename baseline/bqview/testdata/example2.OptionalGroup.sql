@@ -0,0 +1,3 @@
+SELECT
+  IF(JSON_TYPE(payload['myField']) = 'object', STRUCT(LAX_INT64(payload['myField']['submessageField']) AS `submessageField`), NULL) AS `myField`
+FROM `dataset.table`
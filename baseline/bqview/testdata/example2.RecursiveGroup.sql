@@ -0,0 +1,3 @@
+SELECT
+  IF(JSON_TYPE(payload['myField']) = 'object', STRUCT(IF(JSON_TYPE(payload['myField']['recursiveField']) = 'object', STRUCT(IF(JSON_TYPE(payload['myField']['recursiveField']['myField']) = 'object', STRUCT(IF(JSON_TYPE(payload['myField']['recursiveField']['myField']['recursiveField']) = 'object', STRUCT(IF(JSON_TYPE(payload['myField']['recursiveField']['myField']['recursiveField']['myField']) = 'object', STRUCT(IF(JSON_TYPE(payload['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']) = 'object', STRUCT(IF(JSON_TYPE(payload['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']) = 'object', STRUCT(IF(JSON_TYPE(payload['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']) = 'object', STRUCT(IF(JSON_TYPE(payload['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']) = 'object', STRUCT(IF(JSON_TYPE(payload['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']) = 'object', STRUCT(IF(JSON_TYPE(payload['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']) = 'object', STRUCT(IF(JSON_TYPE(payload['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']) = 'object', STRUCT(IF(JSON_TYPE(payload['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']) = 'object', STRUCT(IF(JSON_TYPE(payload['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']) = 'object', STRUCT(payload['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField']['recursiveField']['myField'] AS `myField`), NULL) AS `recursiveField`), NULL) AS `myField`), NULL) AS `recursiveField`), NULL) AS `myField`), NULL) AS `recursiveField`), NULL) AS `myField`), NULL) AS `recursiveField`), NULL) AS `myField`), NULL) AS `recursiveField`), NULL) AS `myField`), NULL) AS `recursiveField`), NULL) AS `myField`), NULL) AS `recursiveField`), NULL) AS `myField`
+FROM `dataset.table`
@@ -0,0 +1,3 @@
+SELECT
+  ARRAY(SELECT AS STRUCT ARRAY(SELECT LAX_INT64(e2) FROM UNNEST(JSON_QUERY_ARRAY(e1['submessageField'])) AS e2 WITH OFFSET AS o2 ORDER BY o2) AS `submessageField` FROM UNNEST(JSON_QUERY_ARRAY(payload['myField'])) AS e1 WITH OFFSET AS o1 ORDER BY o1) AS `myField`
+FROM `dataset.table`
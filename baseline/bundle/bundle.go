@@ -0,0 +1,195 @@
+// Package bundle cuts typedefs down to what a query needs.
+//
+// Typedefs are usually pasted inline into every parseProtobuf call, and
+// BigQuery limits the length of query texts. Bundle keeps only the messages
+// and enums reachable from the root message, and optionally only the fields
+// on given paths. The fields pruned away are still decoded, as unknown fields
+// like "#3".
+package bundle
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+)
+
+// Options specifies what to keep besides the root message.
+type Options struct {
+	// Any lists the messages that may appear in google.protobuf.Any fields.
+	// They are kept with all their fields, as Any is resolved by the type
+	// name at run time.
+	Any []string
+	// Paths lists the dot-separated field paths to keep, relative to the root
+	// message, such as "user.address.city". All the fields below the end of
+	// a path are kept. Maps and repeated fields are traversed as if they
+	// were singular. If empty, all the fields are kept.
+	//
+	// As typedefs are per type, a field kept on one path is kept wherever
+	// its message appears.
+	Paths []string
+}
+
+// Bundle returns the subset of the typedefs needed to decode root.
+func Bundle(typedefs *bqpb.Typedefs, root string, opts *Options) (*bqpb.Typedefs, error) {
+	b := &bundler{
+		typedefs:  typedefs,
+		whole:     map[string]bool{},
+		fields:    map[string]map[string]bool{},
+		enums:     map[string]bool{},
+		visited:   map[visit]bool{},
+		usedPaths: map[*pathNode]bool{},
+	}
+	if typedefs.Message(root) == nil {
+		return nil, fmt.Errorf("message %s is not defined in the typedefs", root)
+	}
+	tree := &pathNode{whole: len(opts.Paths) == 0}
+	for _, path := range opts.Paths {
+		tree.add(path)
+	}
+	b.addMessage(root, tree)
+	for _, name := range opts.Any {
+		if typedefs.Message(name) == nil {
+			return nil, fmt.Errorf("message %s is not defined in the typedefs", name)
+		}
+		b.addMessage(name, &pathNode{whole: true})
+	}
+	for _, path := range opts.Paths {
+		if !b.matched(tree, path) {
+			return nil, fmt.Errorf("path %s does not match any field of %s", path, root)
+		}
+	}
+	return b.result(), nil
+}
+
+// pathNode is a node in the tree of field paths.
+type pathNode struct {
+	// whole means that everything below is kept.
+	whole    bool
+	children map[string]*pathNode
+}
+
+func (n *pathNode) add(path string) {
+	for _, name := range strings.Split(path, ".") {
+		if n.children == nil {
+			n.children = map[string]*pathNode{}
+		}
+		child := n.children[name]
+		if child == nil {
+			child = &pathNode{}
+			n.children[name] = child
+		}
+		n = child
+	}
+	n.whole = true
+}
+
+type visit struct {
+	messageType string
+	node        *pathNode
+}
+
+type bundler struct {
+	typedefs *bqpb.Typedefs
+	// whole is the set of messages kept with all their fields.
+	whole map[string]bool
+	// fields is the set of kept fields of the other messages.
+	fields    map[string]map[string]bool
+	enums     map[string]bool
+	visited   map[visit]bool
+	usedPaths map[*pathNode]bool
+}
+
+func (b *bundler) addMessage(messageType string, node *pathNode) {
+	// A message already kept whole is still walked along the paths, so that
+	// they are marked as used.
+	if (node.whole && b.whole[messageType]) || b.visited[visit{messageType, node}] {
+		return
+	}
+	b.visited[visit{messageType, node}] = true
+	msgDef := b.typedefs.Message(messageType)
+	if node.whole {
+		b.whole[messageType] = true
+	} else if b.fields[messageType] == nil {
+		b.fields[messageType] = map[string]bool{}
+	}
+	for _, fieldDef := range msgDef.Entries() {
+		child := node
+		if !node.whole {
+			child = node.children[fieldDef.Name]
+			if child == nil {
+				continue
+			}
+			b.usedPaths[child] = true
+			b.fields[messageType][fieldDef.Name] = true
+		}
+		for _, typeName := range referencedTypes(fieldDef.Type) {
+			if b.typedefs.Enum(typeName) != nil {
+				b.enums[typeName] = true
+			} else if b.typedefs.Message(typeName) != nil {
+				b.addMessage(typeName, child)
+			}
+		}
+	}
+}
+
+// matched reports whether the path reached a field. A path below another
+// one is covered by it.
+func (b *bundler) matched(tree *pathNode, path string) bool {
+	n := tree
+	for _, name := range strings.Split(path, ".") {
+		n = n.children[name]
+		if !b.usedPaths[n] {
+			return false
+		}
+		if n.whole {
+			return true
+		}
+	}
+	return true
+}
+
+// referencedTypes returns the names of the types a field type refers to,
+// which are the key and value types of maps.
+func referencedTypes(typeName string) []string {
+	if strings.HasPrefix(typeName, "map<") && strings.HasSuffix(typeName, ">") {
+		args := strings.SplitN(typeName[len("map<"):len(typeName)-1], ",", 2)
+		for i := range args {
+			args[i] = strings.TrimSpace(args[i])
+		}
+		return args
+	}
+	return []string{typeName}
+}
+
+// result collects the kept definitions in their original order.
+func (b *bundler) result() *bqpb.Typedefs {
+	result := &bqpb.Typedefs{}
+	for _, msgDef := range b.typedefs.Messages {
+		// Skip the definitions shadowed by later ones of the same name.
+		if b.typedefs.Message(msgDef.Name) != msgDef {
+			continue
+		}
+		if b.whole[msgDef.Name] {
+			result.Messages = append(result.Messages, msgDef)
+			continue
+		}
+		kept, ok := b.fields[msgDef.Name]
+		if !ok {
+			continue
+		}
+		pruned := &bqpb.MessageDef{Name: msgDef.Name}
+		for _, fieldDef := range msgDef.Fields {
+			if kept[fieldDef.Name] {
+				pruned.Fields = append(pruned.Fields, fieldDef)
+			}
+		}
+		result.Messages = append(result.Messages, pruned)
+	}
+	for _, enumDef := range b.typedefs.Enums {
+		if b.enums[enumDef.Name] && b.typedefs.Enum(enumDef.Name) == enumDef {
+			result.Enums = append(result.Enums, enumDef)
+		}
+	}
+	return result
+}
@@ -0,0 +1,136 @@
+package bundle_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+	"github.com/qnighy/bqpb/baseline/bundle"
+)
+
+func TestBundle(t *testing.T) {
+	testcases := []struct {
+		name     string
+		typedefs string
+		opts     bundle.Options
+		want     string
+		wantErr  string
+	}{
+		{
+			name:     "reachable types",
+			typedefs: `{"message Main":{"s":{"type":"Sub","id":1},"m":{"type":"map<string, Value>","id":2},"t":{"type":"google.protobuf.Timestamp","id":3}},"message Sub":{"e":{"type":"E","id":1},"self":{"type":"Sub","id":2}},"message Value":{},"enum E":{"A":0},"message Unused":{"e":{"type":"F","id":1}},"enum F":{"B":0}}`,
+			want:     `{"message Main":{"s":{"type":"Sub","id":1},"m":{"type":"map<string, Value>","id":2},"t":{"type":"google.protobuf.Timestamp","id":3}},"message Sub":{"e":{"type":"E","id":1},"self":{"type":"Sub","id":2}},"message Value":{},"enum E":{"A":0}}`,
+		},
+		{
+			name:     "any",
+			typedefs: `{"message Main":{"a":{"type":"google.protobuf.Any","id":1}},"message Packed":{"e":{"type":"E","id":1}},"enum E":{"A":0},"message Unused":{}}`,
+			opts:     bundle.Options{Any: []string{"Packed"}},
+			want:     `{"message Main":{"a":{"type":"google.protobuf.Any","id":1}},"message Packed":{"e":{"type":"E","id":1}},"enum E":{"A":0}}`,
+		},
+		{
+			name:     "paths",
+			typedefs: `{"message Main":{"s":{"type":"Sub","id":1},"r":{"type":"Sub","id":2,"repeated":true},"m":{"type":"map<string, Sub>","id":3},"x":{"type":"int32","id":4}},"message Sub":{"a":{"type":"int32","id":1},"b":{"type":"E","id":2},"c":{"type":"int32","id":3}},"enum E":{"A":0}}`,
+			opts:     bundle.Options{Paths: []string{"s.a", "r.c", "m", "m.a"}},
+			want:     `{"message Main":{"s":{"type":"Sub","id":1},"r":{"type":"Sub","id":2,"repeated":true},"m":{"type":"map<string, Sub>","id":3}},"message Sub":{"a":{"type":"int32","id":1},"b":{"type":"E","id":2},"c":{"type":"int32","id":3}},"enum E":{"A":0}}`,
+		},
+		{
+			name:     "paths union per type",
+			typedefs: `{"message Main":{"s":{"type":"Sub","id":1},"r":{"type":"Sub","id":2,"repeated":true}},"message Sub":{"a":{"type":"int32","id":1},"b":{"type":"E","id":2},"c":{"type":"int32","id":3}},"enum E":{"A":0}}`,
+			opts:     bundle.Options{Paths: []string{"s.a", "r.c"}},
+			want:     `{"message Main":{"s":{"type":"Sub","id":1},"r":{"type":"Sub","id":2,"repeated":true}},"message Sub":{"a":{"type":"int32","id":1},"c":{"type":"int32","id":3}}}`,
+		},
+		{
+			name:     "path into a message kept whole",
+			typedefs: `{"message Main":{"a":{"type":"T","id":1},"b":{"type":"T","id":2},"c":{"type":"int32","id":3}},"message T":{"x":{"type":"int32","id":1},"y":{"type":"int32","id":2}}}`,
+			opts:     bundle.Options{Paths: []string{"a", "b.x"}},
+			want:     `{"message Main":{"a":{"type":"T","id":1},"b":{"type":"T","id":2}},"message T":{"x":{"type":"int32","id":1},"y":{"type":"int32","id":2}}}`,
+		},
+		{
+			name:     "shadowed definitions",
+			typedefs: `{"message Main":{"old":{"type":"int32","id":1}},"message Main":{"new":{"type":"int32","id":1}}}`,
+			want:     `{"message Main":{"new":{"type":"int32","id":1}}}`,
+		},
+		{
+			name:     "undefined root",
+			typedefs: `{}`,
+			wantErr:  "message Main is not defined in the typedefs",
+		},
+		{
+			name:     "undefined any",
+			typedefs: `{"message Main":{}}`,
+			opts:     bundle.Options{Any: []string{"Packed"}},
+			wantErr:  "message Packed is not defined in the typedefs",
+		},
+		{
+			name:     "unknown path",
+			typedefs: `{"message Main":{"s":{"type":"Sub","id":1}},"message Sub":{"a":{"type":"int32","id":1}}}`,
+			opts:     bundle.Options{Paths: []string{"s.b"}},
+			wantErr:  "path s.b does not match any field of Main",
+		},
+		{
+			name:     "unknown path into a message kept whole",
+			typedefs: `{"message Main":{"a":{"type":"T","id":1},"b":{"type":"T","id":2}},"message T":{"x":{"type":"int32","id":1}}}`,
+			opts:     bundle.Options{Paths: []string{"a", "b.z"}},
+			wantErr:  "path b.z does not match any field of Main",
+		},
+		{
+			name:     "path through scalar",
+			typedefs: `{"message Main":{"x":{"type":"int32","id":1}}}`,
+			opts:     bundle.Options{Paths: []string{"x.y"}},
+			wantErr:  "path x.y does not match any field of Main",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var typedefs bqpb.Typedefs
+			if err := json.Unmarshal([]byte(tc.typedefs), &typedefs); err != nil {
+				t.Fatalf("Unmarshal error: %v\n", err)
+			}
+			got, err := bundle.Bundle(&typedefs, "Main", &tc.opts)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Errorf("Bundle() error = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Bundle error: %v\n", err)
+			}
+			var buf bytes.Buffer
+			enc := json.NewEncoder(&buf)
+			enc.SetEscapeHTML(false)
+			if err := enc.Encode(got); err != nil {
+				t.Fatalf("Encode error: %v\n", err)
+			}
+			if diff := cmp.Diff(tc.want+"\n", buf.String()); diff != "" {
+				t.Errorf("Bundle() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBundlePrunedFieldsAreUnknown(t *testing.T) {
+	var typedefs bqpb.Typedefs
+	if err := json.Unmarshal([]byte(`{"message Main":{"s":{"type":"Sub","id":1}},"message Sub":{"a":{"type":"int32","id":1},"b":{"type":"string","id":2}}}`), &typedefs); err != nil {
+		t.Fatalf("Unmarshal error: %v\n", err)
+	}
+	bundled, err := bundle.Bundle(&typedefs, "Main", &bundle.Options{Paths: []string{"s.a"}})
+	if err != nil {
+		t.Fatalf("Bundle error: %v\n", err)
+	}
+	data, err := bqpb.Encode(json.RawMessage(`{"s":{"a":1,"b":"x"}}`), "Main", typedefs)
+	if err != nil {
+		t.Fatalf("Encode error: %v\n", err)
+	}
+	got, err := bqpb.Parse(data, "Main", *bundled)
+	if err != nil {
+		t.Fatalf("Parse error: %v\n", err)
+	}
+	want := `{"s":{"a":1,"#2":"unknown:string:x"}}`
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("Parse() mismatch (-want +got):\n%s", diff)
+	}
+}
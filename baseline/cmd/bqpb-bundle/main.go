@@ -0,0 +1,148 @@
+// Command bqpb-bundle writes the smallest typedefs needed to decode a message.
+//
+// Usage:
+//
+//	bqpb-bundle -message pkg.Msg -descriptor_set descriptors.pb
+//	bqpb-bundle -message pkg.Msg -typedefs typedefs.json
+//	bqpb-bundle -message pkg.Msg -descriptor_set descriptors.pb \
+//		-any pkg.Payload -keep header.id -keep items.name
+//
+// Only the messages and enums reachable from -message are kept. Messages
+// packed in google.protobuf.Any fields are not reachable by the field types;
+// list them with -any. Each -keep restricts the fields to the given path (see
+// package bundle), and the other fields are decoded as unknown fields.
+//
+// The typedefs are written to standard output as compact JSON, ready to be
+// inlined into a query. Their size is reported to standard error.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+	"github.com/qnighy/bqpb/baseline/bqpbdesc"
+	"github.com/qnighy/bqpb/baseline/bundle"
+)
+
+// stringsFlag is a flag that may be given more than once.
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+func main() {
+	message := flag.String("message", "", "fully qualified name of the root message")
+	typedefsPath := flag.String("typedefs", "", "path to the typedefs JSON")
+	descriptorSetPath := flag.String("descriptor_set", "", "path to a serialized FileDescriptorSet")
+	var opts bundle.Options
+	flag.Var((*stringsFlag)(&opts.Any), "any", "fully qualified name of a message packed in Any (repeatable)")
+	flag.Var((*stringsFlag)(&opts.Paths), "keep", "dot-separated field path to keep (repeatable)")
+	flag.Parse()
+
+	if err := run(os.Stdout, os.Stderr, *message, *typedefsPath, *descriptorSetPath, &opts); err != nil {
+		fmt.Fprintf(os.Stderr, "bqpb-bundle: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(w, report io.Writer, message, typedefsPath, descriptorSetPath string, opts *bundle.Options) error {
+	if message == "" {
+		return errors.New("-message is required")
+	}
+	var typedefs *bqpb.Typedefs
+	var err error
+	switch {
+	case typedefsPath != "" && descriptorSetPath != "":
+		return errors.New("-typedefs and -descriptor_set are exclusive")
+	case typedefsPath != "":
+		typedefs, err = readTypedefs(typedefsPath)
+	case descriptorSetPath != "":
+		typedefs, err = readDescriptorSet(descriptorSetPath)
+	default:
+		return errors.New("either -typedefs or -descriptor_set is required")
+	}
+	if err != nil {
+		return err
+	}
+
+	bundled, err := bundle.Bundle(typedefs, message, opts)
+	if err != nil {
+		return err
+	}
+	before, err := marshal(typedefs)
+	if err != nil {
+		return err
+	}
+	after, err := marshal(bundled)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(after); err != nil {
+		return err
+	}
+	fmt.Fprintf(report, "%d messages, %d enums, %d bytes (from %d bytes)\n",
+		len(bundled.Messages), len(bundled.Enums), len(after)-1, len(before)-1)
+	return nil
+}
+
+// marshal returns the compact JSON of the typedefs, followed by a newline.
+func marshal(typedefs *bqpb.Typedefs) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(typedefs); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func readTypedefs(path string) (*bqpb.Typedefs, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	typedefs := &bqpb.Typedefs{}
+	if err := json.Unmarshal(data, typedefs); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return typedefs, nil
+}
+
+// readDescriptorSet returns the typedefs of every type in the set, for
+// package bundle to pick from.
+func readDescriptorSet(path string) (*bqpb.Typedefs, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	files, err := bqpbdesc.LoadDescriptorSet(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	var fds []protoreflect.FileDescriptor
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		fds = append(fds, fd)
+		return true
+	})
+	// Keep the output stable.
+	sort.Slice(fds, func(i, j int) bool {
+		return fds[i].Path() < fds[j].Path()
+	})
+	return bqpbdesc.FromFiles(fds...), nil
+}
@@ -0,0 +1,101 @@
+// Command bqpb-compat reports the changes of typedefs that break the decoding
+// of messages stored with the old version.
+//
+// Usage:
+//
+//	bqpb-compat old.json new.json
+//	bqpb-compat -descriptor_set old.pb new.pb
+//
+// The arguments are typedefs documents, or with -descriptor_set,
+// FileDescriptorSets including their imports. Only descriptor sets tell
+// reserved field numbers; with typedefs, every removed field is reported.
+//
+// Each finding is printed on a line. The exit status is 1 if there are any.
+// See package compat for what is checked.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+	"github.com/qnighy/bqpb/baseline/bqpbdesc"
+	"github.com/qnighy/bqpb/baseline/compat"
+)
+
+func main() {
+	descriptorSet := flag.Bool("descriptor_set", false, "read serialized FileDescriptorSets instead of typedefs")
+	flag.Parse()
+
+	if err := run(os.Stdout, flag.Args(), *descriptorSet); err != nil {
+		fmt.Fprintf(os.Stderr, "bqpb-compat: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(w io.Writer, args []string, descriptorSet bool) error {
+	if len(args) != 2 {
+		return errors.New("expected the old and new files")
+	}
+	read := readTypedefs
+	if descriptorSet {
+		read = readDescriptorSet
+	}
+	old, err := read(args[0])
+	if err != nil {
+		return err
+	}
+	new, err := read(args[1])
+	if err != nil {
+		return err
+	}
+
+	findings := compat.Check(old, new)
+	for _, f := range findings {
+		fmt.Fprintln(w, f)
+	}
+	if len(findings) > 0 {
+		return fmt.Errorf("%d breaking changes", len(findings))
+	}
+	return nil
+}
+
+func readTypedefs(path string) (*compat.Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	typedefs := &bqpb.Typedefs{}
+	if err := json.Unmarshal(data, typedefs); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &compat.Schema{Typedefs: typedefs}, nil
+}
+
+func readDescriptorSet(path string) (*compat.Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	files, err := bqpbdesc.LoadDescriptorSet(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	var fds []protoreflect.FileDescriptor
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		fds = append(fds, fd)
+		return true
+	})
+	// Keep the output stable.
+	sort.Slice(fds, func(i, j int) bool {
+		return fds[i].Path() < fds[j].Path()
+	})
+	return compat.FromFiles(fds...), nil
+}
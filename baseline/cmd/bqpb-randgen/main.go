@@ -0,0 +1,219 @@
+// Command bqpb-randgen generates random serialized messages.
+//
+// Usage:
+//
+//	bqpb-randgen -message pkg.Msg -typedefs typedefs.json -n 1000 > messages.ndjson
+//	bqpb-randgen -message pkg.Msg -descriptor_set descriptors.pb -format delimited > messages.bin
+//	bqpb-randgen -message pkg.Msg -typedefs typedefs.json -format files -o messages/
+//
+// The messages are valid for the typedefs, and shaped by the flags: -depth
+// limits the nesting, -repeated and -length set the mean numbers of elements
+// and bytes, -presence sets the probability of setting singular fields (0
+// leaves them all unset), and -unknown sets the mean number of unknown fields
+// per message. Messages for google.protobuf.Any fields are picked from -any.
+// See package randgen.
+//
+// The output formats are:
+//
+//   - ndjson: one JSON object per line, with the message in base64 under
+//     -column, ready for "bq load --source_format=NEWLINE_DELIMITED_JSON"
+//     into a BYTES column.
+//   - delimited: a stream of messages each preceded by its length as a
+//     varint, which "bqpb-skeleton -delimited" reads.
+//   - files: one file per message in the -o directory.
+//
+// The output is written to -o, or to standard output. The same -seed gives
+// the same messages.
+package main
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+	"github.com/qnighy/bqpb/baseline/bqpbdesc"
+	"github.com/qnighy/bqpb/baseline/randgen"
+)
+
+// stringsFlag is a flag that may be given more than once.
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+type options struct {
+	message           string
+	typedefsPath      string
+	descriptorSetPath string
+	n                 int
+	seed              int64
+	format            string
+	output            string
+	column            string
+	gen               randgen.Options
+}
+
+func main() {
+	opts := options{gen: *randgen.DefaultOptions()}
+	flag.StringVar(&opts.message, "message", "", "fully qualified name of the message")
+	flag.StringVar(&opts.typedefsPath, "typedefs", "", "path to the typedefs JSON")
+	flag.StringVar(&opts.descriptorSetPath, "descriptor_set", "", "path to a serialized FileDescriptorSet")
+	flag.IntVar(&opts.n, "n", 100, "number of messages")
+	flag.Int64Var(&opts.seed, "seed", 1, "random seed")
+	flag.StringVar(&opts.format, "format", "ndjson", "output format: ndjson, delimited or files")
+	flag.StringVar(&opts.output, "o", "", "output file, or directory for -format files")
+	flag.StringVar(&opts.column, "column", "payload", "column name for -format ndjson")
+	flag.IntVar(&opts.gen.MaxDepth, "depth", opts.gen.MaxDepth, "maximum nesting of messages")
+	flag.Float64Var(&opts.gen.MeanRepeated, "repeated", opts.gen.MeanRepeated, "mean number of elements of repeated fields and maps")
+	flag.Float64Var(&opts.gen.MeanLength, "length", opts.gen.MeanLength, "mean length of strings and bytes")
+	flag.Float64Var(&opts.gen.Presence, "presence", opts.gen.Presence, "probability that a singular field is set")
+	flag.Float64Var(&opts.gen.MeanUnknown, "unknown", opts.gen.MeanUnknown, "mean number of unknown fields per message")
+	flag.Var((*stringsFlag)(&opts.gen.Any), "any", "fully qualified name of a message to pack into Any (repeatable)")
+	flag.Parse()
+
+	if err := run(&opts); err != nil {
+		fmt.Fprintf(os.Stderr, "bqpb-randgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(opts *options) error {
+	if opts.message == "" {
+		return errors.New("-message is required")
+	}
+	var typedefs *bqpb.Typedefs
+	var err error
+	switch {
+	case opts.typedefsPath != "" && opts.descriptorSetPath != "":
+		return errors.New("-typedefs and -descriptor_set are exclusive")
+	case opts.typedefsPath != "":
+		typedefs, err = readTypedefs(opts.typedefsPath)
+	case opts.descriptorSetPath != "":
+		typedefs, err = readDescriptorSet(opts.descriptorSetPath)
+	default:
+		return errors.New("either -typedefs or -descriptor_set is required")
+	}
+	if err != nil {
+		return err
+	}
+	gen, err := randgen.New(typedefs, &opts.gen, rand.New(rand.NewSource(opts.seed)))
+	if err != nil {
+		return err
+	}
+
+	if opts.format == "files" {
+		if opts.output == "" {
+			return errors.New("-format files requires -o")
+		}
+		if err := os.MkdirAll(opts.output, 0o755); err != nil {
+			return err
+		}
+		for i := 0; i < opts.n; i++ {
+			data, err := gen.Message(opts.message)
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(filepath.Join(opts.output, fmt.Sprintf("%06d.bin", i)), data, 0o644); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var write func(w io.Writer, data []byte) error
+	switch opts.format {
+	case "ndjson":
+		write = func(w io.Writer, data []byte) error {
+			line, err := json.Marshal(map[string]string{opts.column: base64.StdEncoding.EncodeToString(data)})
+			if err != nil {
+				return err
+			}
+			_, err = w.Write(append(line, '\n'))
+			return err
+		}
+	case "delimited":
+		write = func(w io.Writer, data []byte) error {
+			_, err := w.Write(protowire.AppendBytes(nil, data))
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown -format: %s", opts.format)
+	}
+
+	out := os.Stdout
+	if opts.output != "" {
+		out, err = os.Create(opts.output)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+	}
+	w := bufio.NewWriter(out)
+	for i := 0; i < opts.n; i++ {
+		data, err := gen.Message(opts.message)
+		if err != nil {
+			return err
+		}
+		if err := write(w, data); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return out.Close()
+}
+
+func readTypedefs(path string) (*bqpb.Typedefs, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	typedefs := &bqpb.Typedefs{}
+	if err := json.Unmarshal(data, typedefs); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return typedefs, nil
+}
+
+// readDescriptorSet returns the typedefs of every type in the set, so that
+// any of them can be packed into Any.
+func readDescriptorSet(path string) (*bqpb.Typedefs, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	files, err := bqpbdesc.LoadDescriptorSet(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	var fds []protoreflect.FileDescriptor
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		fds = append(fds, fd)
+		return true
+	})
+	// Keep the output stable.
+	sort.Slice(fds, func(i, j int) bool {
+		return fds[i].Path() < fds[j].Path()
+	})
+	return bqpbdesc.FromFiles(fds...), nil
+}
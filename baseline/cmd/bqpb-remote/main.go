@@ -0,0 +1,45 @@
+// Command bqpb-remote serves parseProtobuf as a BigQuery Remote Function.
+//
+// Usage:
+//
+//	bqpb-remote [-addr :8080] [-max-request-bytes N] [-max-calls N] [-strict]
+//
+// It can be tried locally without GCP:
+//
+//	curl -s localhost:8080 -d '{"calls": [["CAE=", "Main", {"message Main": {"field1": {"type": "uint32", "id": 1}}}]]}'
+//	{"replies":[{"field1":1}]}
+//
+// The PORT environment variable, as set by Cloud Run and Cloud Functions,
+// overrides the default address.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/qnighy/bqpb/baseline/remote"
+)
+
+func main() {
+	addr := ":8080"
+	if port := os.Getenv("PORT"); port != "" {
+		addr = ":" + port
+	}
+	flag.StringVar(&addr, "addr", addr, "address to listen on")
+	maxRequestBytes := flag.Int64("max-request-bytes", remote.DefaultMaxRequestBytes, "maximum size of request bodies")
+	maxCalls := flag.Int("max-calls", 0, "maximum number of calls per request (0 for no limit)")
+	concurrency := flag.Int("concurrency", 0, "number of calls decoded in parallel (0 for GOMAXPROCS)")
+	strict := flag.Bool("strict", false, "fail the whole request if any call fails")
+	flag.Parse()
+
+	handler := &remote.Handler{
+		MaxRequestBytes: *maxRequestBytes,
+		MaxCalls:        *maxCalls,
+		Concurrency:     *concurrency,
+		Strict:          *strict,
+	}
+	log.Printf("listening on %s", addr)
+	log.Fatal(http.ListenAndServe(addr, handler))
+}
@@ -0,0 +1,101 @@
+// Command bqpb-schema generates a BigQuery table schema for the outputs of
+// parseProtobuf.
+//
+// Usage:
+//
+//	bqpb-schema -message pkg.Msg -typedefs typedefs.json
+//	bqpb-schema -message pkg.Msg -descriptor_set descriptors.pb
+//
+// The typedefs are those passed to parseProtobuf. Alternatively, a
+// FileDescriptorSet can be given, as produced by
+//
+//	protoc --include_imports --descriptor_set_out=descriptors.pb file.proto
+//
+// in which case the typedefs are derived from it as bqpbdesc does.
+//
+// The schema is written to standard output in the JSON format accepted by
+// "bq mk --schema" and "bq load". See package bqschema for the mapping.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+	"github.com/qnighy/bqpb/baseline/bqpbdesc"
+	"github.com/qnighy/bqpb/baseline/bqschema"
+)
+
+func main() {
+	message := flag.String("message", "", "fully qualified name of the message")
+	typedefsPath := flag.String("typedefs", "", "path to the typedefs JSON")
+	descriptorSetPath := flag.String("descriptor_set", "", "path to a serialized FileDescriptorSet")
+	flag.Parse()
+
+	if err := run(os.Stdout, *message, *typedefsPath, *descriptorSetPath); err != nil {
+		fmt.Fprintf(os.Stderr, "bqpb-schema: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(w io.Writer, message, typedefsPath, descriptorSetPath string) error {
+	if message == "" {
+		return errors.New("-message is required")
+	}
+	var typedefs *bqpb.Typedefs
+	var err error
+	switch {
+	case typedefsPath != "" && descriptorSetPath != "":
+		return errors.New("-typedefs and -descriptor_set are exclusive")
+	case typedefsPath != "":
+		typedefs, err = readTypedefs(typedefsPath)
+	case descriptorSetPath != "":
+		typedefs, err = readDescriptorSet(descriptorSetPath, message)
+	default:
+		return errors.New("either -typedefs or -descriptor_set is required")
+	}
+	if err != nil {
+		return err
+	}
+
+	schema, err := bqschema.FromTypedefs(typedefs, message)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(schema)
+}
+
+func readTypedefs(path string) (*bqpb.Typedefs, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	typedefs := &bqpb.Typedefs{}
+	if err := json.Unmarshal(data, typedefs); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return typedefs, nil
+}
+
+func readDescriptorSet(path, message string) (*bqpb.Typedefs, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	files, err := bqpbdesc.LoadDescriptorSet(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	md, err := bqpbdesc.FindMessage(files, message)
+	if err != nil {
+		return nil, err
+	}
+	return bqpbdesc.FromMessage(md), nil
+}
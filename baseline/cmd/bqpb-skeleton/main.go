@@ -0,0 +1,109 @@
+// Command bqpb-skeleton infers a typedefs skeleton from sample messages of
+// an unknown type.
+//
+// Usage:
+//
+//	bqpb-skeleton [-message Main] [-delimited] [file ...]
+//
+// Each file contains a single serialized message, unless -delimited is
+// given, in which case each file is a stream of varint-length-prefixed
+// messages. Standard input is read if no files are given.
+//
+// The typedefs are written to standard output. Each field carries a
+// "comment" key stating how confident the guess is; bqpb ignores it.
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/qnighy/bqpb/baseline/skeleton"
+)
+
+func main() {
+	message := flag.String("message", "Main", "name of the root message")
+	delimited := flag.Bool("delimited", false, "read length-delimited message streams")
+	flag.Parse()
+
+	if err := run(os.Stdout, flag.Args(), *message, *delimited); err != nil {
+		fmt.Fprintf(os.Stderr, "bqpb-skeleton: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(w io.Writer, paths []string, message string, delimited bool) error {
+	in := skeleton.New()
+	read := func(name string, r io.Reader) error {
+		var err error
+		if delimited {
+			err = readDelimited(in, r)
+		} else {
+			var data []byte
+			data, err = io.ReadAll(r)
+			if err == nil {
+				err = in.Add(data)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		return nil
+	}
+	if len(paths) == 0 {
+		if err := read("<stdin>", os.Stdin); err != nil {
+			return err
+		}
+	}
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		err = read(path, f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	if in.Samples() == 0 {
+		return errors.New("no messages")
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(in.Typedefs(message))
+}
+
+// maxMessageSize is the maximum length accepted in a length-delimited
+// stream, which is the protobuf limit of 2 GiB.
+const maxMessageSize = 1<<31 - 1
+
+// readDelimited adds each message of a length-delimited stream.
+func readDelimited(in *skeleton.Inferrer, r io.Reader) error {
+	br := bufio.NewReader(r)
+	for i := 0; ; i++ {
+		length, err := binary.ReadUvarint(br)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("message %d: %w", i, err)
+		}
+		if length > maxMessageSize {
+			return fmt.Errorf("message %d: too large (%d bytes)", i, length)
+		}
+		data := make([]byte, length)
+		if _, err := io.ReadFull(br, data); err != nil {
+			return fmt.Errorf("message %d: %w", i, err)
+		}
+		if err := in.Add(data); err != nil {
+			return fmt.Errorf("message %d: %w", i, err)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+// Command bqpb-typedefs derives typedefs from .proto files.
+//
+// Usage:
+//
+//	bqpb-typedefs path/to/file.proto -message pkg.Msg
+//	bqpb-typedefs -I protos -I third_party protos/pkg/file.proto -message pkg.Msg -sql
+//
+// The files are compiled in pure Go, without protoc. Imports are resolved
+// against the -I directories, the current directory by default, and the
+// well-known types are always available. Each file must be inside one of the
+// -I directories, as with protoc.
+//
+// With -message, the typedefs cover the message and the types reachable from
+// it. Otherwise, they cover every type declared in the files. The fields are
+// named after their JSON names, or as declared with -field_names proto. With
+// -type_names relative, the types are named without their packages.
+//
+// The typedefs are written to standard output as JSON, or with -sql, as a
+// JSON literal to paste into a parseProtobuf call.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/bufbuild/protocompile/linker"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+	"github.com/qnighy/bqpb/baseline/bqpbdesc"
+)
+
+// stringsFlag is a flag that may be given more than once.
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+type options struct {
+	importPaths []string
+	message     string
+	fieldNames  string
+	typeNames   string
+	sql         bool
+}
+
+func main() {
+	files, opts, err := parseArgs(os.Args[1:])
+	if err == flag.ErrHelp {
+		os.Exit(0)
+	} else if err != nil {
+		// The flag package has reported the error.
+		os.Exit(2)
+	}
+	if err := run(os.Stdout, files, opts); err != nil {
+		fmt.Fprintf(os.Stderr, "bqpb-typedefs: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// parseArgs parses the command line into the file names and the options.
+func parseArgs(args []string) ([]string, *options, error) {
+	opts := &options{}
+	flags := flag.NewFlagSet("bqpb-typedefs", flag.ContinueOnError)
+	flags.Var((*stringsFlag)(&opts.importPaths), "I", "directory to search for imports (repeatable)")
+	flags.StringVar(&opts.message, "message", "", "fully qualified name of the root message")
+	flags.StringVar(&opts.fieldNames, "field_names", "json", "field names: json or proto")
+	flags.StringVar(&opts.typeNames, "type_names", "full", "type names: full or relative")
+	flags.BoolVar(&opts.sql, "sql", false, `print a JSON"""...""" SQL literal`)
+	if err := flags.Parse(args); err != nil {
+		return nil, nil, err
+	}
+
+	// Allow flags after the file names, as in "bqpb-typedefs file.proto
+	// -message pkg.Msg".
+	var files []string
+	for args := flags.Args(); len(args) > 0; args = flags.Args() {
+		files = append(files, args[0])
+		if err := flags.Parse(args[1:]); err != nil {
+			return nil, nil, err
+		}
+	}
+	return files, opts, nil
+}
+
+// run compiles the files and writes their typedefs to w.
+func run(w io.Writer, files []string, opts *options) error {
+	if len(files) == 0 {
+		return errors.New("no .proto files given")
+	}
+	var convertOpts bqpbdesc.Options
+	switch opts.fieldNames {
+	case "json":
+		convertOpts.FieldNames = bqpbdesc.FieldNamesJSON
+	case "proto":
+		convertOpts.FieldNames = bqpbdesc.FieldNamesProto
+	default:
+		return fmt.Errorf("unknown -field_names: %s", opts.fieldNames)
+	}
+	switch opts.typeNames {
+	case "full":
+		convertOpts.TypeNames = bqpbdesc.TypeNamesFull
+	case "relative":
+		convertOpts.TypeNames = bqpbdesc.TypeNamesPackageRelative
+	default:
+		return fmt.Errorf("unknown -type_names: %s", opts.typeNames)
+	}
+	if opts.message == "" && convertOpts != (bqpbdesc.Options{}) {
+		return errors.New("-field_names and -type_names require -message")
+	}
+
+	importPaths := opts.importPaths
+	if len(importPaths) == 0 {
+		importPaths = []string{"."}
+	}
+	names := make([]string, len(files))
+	for i, file := range files {
+		name, err := importName(importPaths, file)
+		if err != nil {
+			return err
+		}
+		names[i] = name
+	}
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			ImportPaths: importPaths,
+		}),
+	}
+	compiled, err := compiler.Compile(context.Background(), names...)
+	if err != nil {
+		return err
+	}
+
+	var typedefs *bqpb.Typedefs
+	if opts.message != "" {
+		md, err := findMessage(compiled, opts.message)
+		if err != nil {
+			return err
+		}
+		result, err := bqpbdesc.Convert(md, &convertOpts)
+		if err != nil {
+			return err
+		}
+		typedefs = result.Typedefs
+	} else {
+		fds := make([]protoreflect.FileDescriptor, len(compiled))
+		for i, fd := range compiled {
+			fds[i] = fd
+		}
+		typedefs = bqpbdesc.FromFiles(fds...)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(typedefs); err != nil {
+		return err
+	}
+	if opts.sql {
+		_, err = fmt.Fprintf(w, "JSON\"\"\"%s\"\"\"\n", sqlEscape(strings.TrimSuffix(buf.String(), "\n")))
+		return err
+	}
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// importName returns the name of a file as imported, relative to the first
+// import path containing it.
+func importName(importPaths []string, file string) (string, error) {
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return "", err
+	}
+	for _, dir := range importPaths {
+		absDir, err := filepath.Abs(dir)
+		if err != nil {
+			return "", err
+		}
+		rel, err := filepath.Rel(absDir, abs)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		return filepath.ToSlash(rel), nil
+	}
+	return "", fmt.Errorf("%s is not in any of the import paths %s; add -I", file, strings.Join(importPaths, ", "))
+}
+
+func findMessage(files linker.Files, name string) (protoreflect.MessageDescriptor, error) {
+	for _, fd := range files {
+		if md, ok := fd.FindDescriptorByName(protoreflect.FullName(name)).(protoreflect.MessageDescriptor); ok {
+			return md, nil
+		}
+	}
+	return nil, fmt.Errorf("message %s is not declared in the files", name)
+}
+
+// sqlEscape escapes the backslashes in JSON, which would otherwise be taken
+// as escape sequences of the triple-quoted string. The JSON cannot contain
+// three quotes in a row, as the quotes in strings are escaped.
+func sqlEscape(s string) string {
+	return strings.ReplaceAll(s, `\`, `\\`)
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseArgs(t *testing.T) {
+	files, opts, err := parseArgs([]string{"-I", "protos", "a.proto", "-message", "pkg.Msg", "b.proto", "-I", "third_party", "-sql"})
+	if err != nil {
+		t.Fatalf("parseArgs error: %v\n", err)
+	}
+	if diff := cmp.Diff([]string{"a.proto", "b.proto"}, files); diff != "" {
+		t.Errorf("files mismatch (-want +got):\n%s", diff)
+	}
+	want := &options{
+		importPaths: []string{"protos", "third_party"},
+		message:     "pkg.Msg",
+		fieldNames:  "json",
+		typeNames:   "full",
+		sql:         true,
+	}
+	if diff := cmp.Diff(want, opts, cmp.AllowUnexported(options{})); diff != "" {
+		t.Errorf("options mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "protos", "pkg", "main.proto"), `syntax = "proto3";
+package pkg;
+import "dep/dep.proto";
+import "google/protobuf/timestamp.proto";
+message Main {
+  dep.Dep dep = 1;
+  google.protobuf.Timestamp time = 2;
+  string path = 3 [json_name = "C:\\path\"s"];
+}
+`)
+	writeFile(t, filepath.Join(dir, "third_party", "dep", "dep.proto"), `syntax = "proto3";
+package dep;
+message Dep { int32 x = 1; }
+`)
+	protos := filepath.Join(dir, "protos")
+	thirdParty := filepath.Join(dir, "third_party")
+	mainProto := filepath.Join(protos, "pkg", "main.proto")
+
+	testcases := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr string
+	}{
+		{
+			name: "imports from -I",
+			args: []string{mainProto, "-I", protos, "-I", thirdParty, "-message", "pkg.Main", "-type_names", "relative"},
+			want: `{
+  "message Main": {
+    "dep": {
+      "type": "Dep",
+      "id": 1,
+      "fieldPresence": "explicit"
+    },
+    "time": {
+      "type": "google.protobuf.Timestamp",
+      "id": 2,
+      "fieldPresence": "explicit"
+    },
+    "C:\\path\"s": {
+      "type": "string",
+      "id": 3,
+      "fieldPresence": "implicit"
+    }
+  },
+  "message Dep": {
+    "x": {
+      "type": "int32",
+      "id": 1,
+      "fieldPresence": "implicit"
+    }
+  }
+}
+`,
+		},
+		{
+			name: "sql",
+			args: []string{filepath.Join(thirdParty, "dep", "dep.proto"), "-I", thirdParty, "-sql"},
+			want: `JSON"""{
+  "message dep.Dep": {
+    "x": {
+      "type": "int32",
+      "id": 1,
+      "fieldPresence": "implicit"
+    }
+  }
+}"""
+`,
+		},
+		{
+			// The backslashes are doubled, to be read back as in the JSON.
+			name: "sql with backslashes",
+			args: []string{mainProto, "-I", protos, "-I", thirdParty, "-message", "pkg.Main", "-field_names", "json", "-sql"},
+			want: `JSON"""{
+  "message pkg.Main": {
+    "dep": {
+      "type": "dep.Dep",
+      "id": 1,
+      "fieldPresence": "explicit"
+    },
+    "time": {
+      "type": "google.protobuf.Timestamp",
+      "id": 2,
+      "fieldPresence": "explicit"
+    },
+    "C:\\\\path\\"s": {
+      "type": "string",
+      "id": 3,
+      "fieldPresence": "implicit"
+    }
+  },
+  "message dep.Dep": {
+    "x": {
+      "type": "int32",
+      "id": 1,
+      "fieldPresence": "implicit"
+    }
+  }
+}"""
+`,
+		},
+		{
+			name:    "import not in -I",
+			args:    []string{mainProto, "-I", protos},
+			wantErr: "dep/dep.proto",
+		},
+		{
+			name:    "file not in -I",
+			args:    []string{mainProto, "-I", thirdParty},
+			wantErr: mainProto + " is not in any of the import paths " + thirdParty + "; add -I",
+		},
+		{
+			name:    "undeclared message",
+			args:    []string{mainProto, "-I", protos, "-I", thirdParty, "-message", "pkg.Other"},
+			wantErr: "message pkg.Other is not declared in the files",
+		},
+		{
+			name:    "-field_names without -message",
+			args:    []string{mainProto, "-I", protos, "-I", thirdParty, "-field_names", "proto"},
+			wantErr: "-field_names and -type_names require -message",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			files, opts, err := parseArgs(tc.args)
+			if err != nil {
+				t.Fatalf("parseArgs error: %v\n", err)
+			}
+			var buf bytes.Buffer
+			err = run(&buf, files, opts)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Errorf("run() error = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("run error: %v\n", err)
+			}
+			if diff := cmp.Diff(tc.want, buf.String()); diff != "" {
+				t.Errorf("run() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestImportName(t *testing.T) {
+	testcases := []struct {
+		importPaths []string
+		file        string
+		want        string
+		wantErr     bool
+	}{
+		{importPaths: []string{"."}, file: "a.proto", want: "a.proto"},
+		{importPaths: []string{"protos"}, file: "protos/pkg/a.proto", want: "pkg/a.proto"},
+		{importPaths: []string{"other", "protos"}, file: "./protos/../protos/a.proto", want: "a.proto"},
+		// The first import path containing the file wins, as with protoc.
+		{importPaths: []string{".", "protos"}, file: "protos/a.proto", want: "protos/a.proto"},
+		{importPaths: []string{"protos"}, file: "protos2/a.proto", wantErr: true},
+		{importPaths: []string{"protos"}, file: "a.proto", wantErr: true},
+	}
+	for _, tc := range testcases {
+		got, err := importName(tc.importPaths, tc.file)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("importName(%q, %q) = %q, want an error", tc.importPaths, tc.file, got)
+			}
+			continue
+		}
+		if err != nil || got != tc.want {
+			t.Errorf("importName(%q, %q) = %q, %v, want %q", tc.importPaths, tc.file, got, err, tc.want)
+		}
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("MkdirAll error: %v\n", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("WriteFile error: %v\n", err)
+	}
+}
@@ -0,0 +1,64 @@
+// Command bqpb-view generates a BigQuery query, or a view, extracting typed
+// columns from the outputs of parseProtobuf.
+//
+// Usage:
+//
+//	bqpb-view -message pkg.Msg -typedefs typedefs.json -from dataset.table [-column payload] [-view dataset.view]
+//
+// The table given by -from must have a JSON column, named by -column,
+// holding the outputs of parseProtobuf for the message. With -view, a
+// CREATE OR REPLACE VIEW statement is generated instead of a bare SELECT.
+// See package bqview for the conversions.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+	"github.com/qnighy/bqpb/baseline/bqview"
+)
+
+func main() {
+	message := flag.String("message", "", "fully qualified name of the message")
+	typedefsPath := flag.String("typedefs", "", "path to the typedefs JSON")
+	opts := &bqview.Options{}
+	flag.StringVar(&opts.From, "from", "", "table holding the outputs of parseProtobuf")
+	flag.StringVar(&opts.Column, "column", "payload", "JSON column holding the outputs of parseProtobuf")
+	flag.StringVar(&opts.View, "view", "", "name of the view to create")
+	flag.Parse()
+
+	if err := run(os.Stdout, *message, *typedefsPath, opts); err != nil {
+		fmt.Fprintf(os.Stderr, "bqpb-view: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(w io.Writer, message, typedefsPath string, opts *bqview.Options) error {
+	switch {
+	case message == "":
+		return errors.New("-message is required")
+	case typedefsPath == "":
+		return errors.New("-typedefs is required")
+	case opts.From == "":
+		return errors.New("-from is required")
+	}
+	data, err := os.ReadFile(typedefsPath)
+	if err != nil {
+		return err
+	}
+	typedefs := &bqpb.Typedefs{}
+	if err := json.Unmarshal(data, typedefs); err != nil {
+		return fmt.Errorf("%s: %w", typedefsPath, err)
+	}
+	query, err := bqview.SQL(typedefs, message, opts)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, query)
+	return err
+}
@@ -0,0 +1,307 @@
+// Package compat finds the changes of typedefs that break the decoding of
+// stored messages.
+//
+// Messages are usually stored as bytes and decoded at query time with the
+// latest typedefs. A change of the .proto file that is harmless to the
+// producers may still change the JSON of the old messages, or make them
+// undecodable. Check compares two versions of the typedefs and reports:
+//
+//   - field numbers reused with a type of another wire encoding, such as
+//     sint32 and int32, or string and a message;
+//   - other type changes, which change the JSON, such as int32 and int64;
+//   - changes of the presence or the cardinality, which decide whether and
+//     how the fields appear in the JSON;
+//   - renamed fields, which change the keys of the JSON;
+//   - fields removed without reserving their numbers;
+//   - enum values renumbered, renamed or removed.
+//
+// Fields are matched by number, and messages and enums by name.
+package compat
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+	"github.com/qnighy/bqpb/baseline/bqpbdesc"
+)
+
+// Schema is a version of the types to compare.
+type Schema struct {
+	Typedefs *bqpb.Typedefs
+	// Reserved lists the reserved field numbers of each message. If nil, the
+	// reservations are unknown, and every removed field is reported.
+	Reserved map[string][]Range
+}
+
+// Range is an inclusive range of field numbers.
+type Range struct {
+	Start, End int32
+}
+
+// FromFiles returns the schema of every message and enum declared in the
+// files, along with the reserved field numbers.
+func FromFiles(files ...protoreflect.FileDescriptor) *Schema {
+	schema := &Schema{
+		Typedefs: bqpbdesc.FromFiles(files...),
+		Reserved: map[string][]Range{},
+	}
+	var addReserved func(messages protoreflect.MessageDescriptors)
+	addReserved = func(messages protoreflect.MessageDescriptors) {
+		for i := 0; i < messages.Len(); i++ {
+			md := messages.Get(i)
+			ranges := md.ReservedRanges()
+			for j := 0; j < ranges.Len(); j++ {
+				r := ranges.Get(j)
+				// The end of FieldRanges is exclusive.
+				schema.Reserved[string(md.FullName())] = append(schema.Reserved[string(md.FullName())], Range{int32(r[0]), int32(r[1]) - 1})
+			}
+			addReserved(md.Messages())
+		}
+	}
+	for _, fd := range files {
+		addReserved(fd.Messages())
+	}
+	return schema
+}
+
+func (s *Schema) reserved(messageType string, id int32) bool {
+	for _, r := range s.Reserved[messageType] {
+		if r.Start <= id && id <= r.End {
+			return true
+		}
+	}
+	return false
+}
+
+// Kind classifies the findings.
+type Kind string
+
+// Kinds of findings.
+const (
+	KindIncompatibleType   Kind = "incompatible type"
+	KindTypeChanged        Kind = "type changed"
+	KindPresenceChanged    Kind = "presence changed"
+	KindCardinalityChanged Kind = "cardinality changed"
+	KindRenamed            Kind = "renamed"
+	KindRemoved            Kind = "removed without reservation"
+	KindEnumRenumbered     Kind = "enum value renumbered"
+	KindEnumRenamed        Kind = "enum value renamed"
+	KindEnumRemoved        Kind = "enum value removed"
+)
+
+// Finding is a breaking change.
+type Finding struct {
+	Kind Kind
+	// Type is the name of the message or enum.
+	Type string
+	// Name is the old name of the field or enum value.
+	Name   string
+	Detail string
+}
+
+func (f *Finding) String() string {
+	return fmt.Sprintf("%s.%s: %s: %s", f.Type, f.Name, f.Kind, f.Detail)
+}
+
+// Check returns the changes from old to new that alter the decoding of the
+// messages written with old, in the order of the old definitions.
+func Check(old, new *Schema) []*Finding {
+	var findings []*Finding
+	for _, oldMsg := range old.Typedefs.Messages {
+		if old.Typedefs.Message(oldMsg.Name) != oldMsg {
+			// Shadowed by a later definition.
+			continue
+		}
+		newMsg := new.Typedefs.Message(oldMsg.Name)
+		if newMsg == nil {
+			continue
+		}
+		findings = append(findings, checkMessage(old, new, oldMsg, newMsg)...)
+	}
+	for _, oldEnum := range old.Typedefs.Enums {
+		if old.Typedefs.Enum(oldEnum.Name) != oldEnum {
+			continue
+		}
+		newEnum := new.Typedefs.Enum(oldEnum.Name)
+		if newEnum == nil {
+			continue
+		}
+		findings = append(findings, checkEnum(oldEnum, newEnum)...)
+	}
+	return findings
+}
+
+func checkMessage(old, new *Schema, oldMsg, newMsg *bqpb.MessageDef) []*Finding {
+	var findings []*Finding
+	report := func(kind Kind, field *bqpb.FieldDef, format string, args ...interface{}) {
+		findings = append(findings, &Finding{
+			Kind:   kind,
+			Type:   oldMsg.Name,
+			Name:   field.Name,
+			Detail: fmt.Sprintf("#%d ", field.ID) + fmt.Sprintf(format, args...),
+		})
+	}
+
+	newFields := map[int32]*bqpb.FieldDef{}
+	for _, field := range newMsg.Entries() {
+		newFields[field.ID] = field
+	}
+	for _, oldField := range oldMsg.Entries() {
+		newField := newFields[oldField.ID]
+		if newField == nil {
+			if !new.reserved(newMsg.Name, oldField.ID) {
+				report(KindRemoved, oldField, "is no longer decoded and appears as an unknown field")
+			}
+			continue
+		}
+		oldEncoding := encoding(old.Typedefs, oldField)
+		newEncoding := encoding(new.Typedefs, newField)
+		if oldEncoding != newEncoding {
+			report(KindIncompatibleType, oldField, "%s (%s) -> %s (%s)", describeType(oldField), oldEncoding, describeType(newField), newEncoding)
+		} else if describeType(oldField) != describeType(newField) {
+			report(KindTypeChanged, oldField, "%s -> %s", describeType(oldField), describeType(newField))
+		}
+		if oldEncoding == newEncoding && !strings.HasPrefix(oldEncoding, "map<") {
+			if oldField.Repeated != newField.Repeated {
+				report(KindCardinalityChanged, oldField, "%s -> %s", cardinality(oldField), cardinality(newField))
+			} else if !oldField.Repeated && oldEncoding != encodingGroup && presence(oldField) != presence(newField) {
+				report(KindPresenceChanged, oldField, "%s -> %s", presence(oldField), presence(newField))
+			}
+		}
+		if oldField.Name != newField.Name {
+			report(KindRenamed, oldField, "-> %s", newField.Name)
+		}
+	}
+	return findings
+}
+
+func checkEnum(oldEnum, newEnum *bqpb.EnumDef) []*Finding {
+	var findings []*Finding
+	report := func(kind Kind, value *bqpb.EnumValueDef, format string, args ...interface{}) {
+		findings = append(findings, &Finding{
+			Kind:   kind,
+			Type:   oldEnum.Name,
+			Name:   value.Name,
+			Detail: fmt.Sprintf(format, args...),
+		})
+	}
+
+	newNumbers := map[string]int32{}
+	newNames := map[int32]string{}
+	for _, value := range newEnum.Entries() {
+		newNumbers[value.Name] = value.Number
+		if _, ok := newNames[value.Number]; !ok {
+			newNames[value.Number] = value.Name
+		}
+	}
+	renumbered := map[string]bool{}
+	for _, value := range oldEnum.Entries() {
+		if number, ok := newNumbers[value.Name]; ok && number != value.Number {
+			report(KindEnumRenumbered, value, "%d -> %d", value.Number, number)
+			renumbered[value.Name] = true
+		}
+	}
+	printed := map[int32]bool{}
+	for _, value := range oldEnum.Entries() {
+		// Only the first name of a number is printed.
+		if printed[value.Number] {
+			continue
+		}
+		printed[value.Number] = true
+		if renumbered[value.Name] {
+			continue
+		}
+		newName, ok := newNames[value.Number]
+		if !ok {
+			report(KindEnumRemoved, value, "%d is printed as a number", value.Number)
+		} else if newName != value.Name {
+			report(KindEnumRenamed, value, "%d is printed as %s", value.Number, newName)
+		}
+	}
+	return findings
+}
+
+// Encodings of the values on the wire. The values of the same encoding can be
+// decoded as each other, though the results may differ.
+const (
+	encodingVarint  = "varint"
+	encodingZigZag  = "zigzag varint"
+	encodingFixed32 = "fixed32"
+	encodingFloat   = "float"
+	encodingFixed64 = "fixed64"
+	encodingDouble  = "double"
+	encodingBytes   = "bytes"
+	encodingMessage = "message"
+	encodingGroup   = "group"
+)
+
+var scalarEncodings = map[string]string{
+	"bool":     encodingVarint,
+	"uint32":   encodingVarint,
+	"int32":    encodingVarint,
+	"uint64":   encodingVarint,
+	"int64":    encodingVarint,
+	"sint32":   encodingZigZag,
+	"sint64":   encodingZigZag,
+	"fixed32":  encodingFixed32,
+	"sfixed32": encodingFixed32,
+	"float":    encodingFloat,
+	"fixed64":  encodingFixed64,
+	"sfixed64": encodingFixed64,
+	"double":   encodingDouble,
+	"bytes":    encodingBytes,
+	"string":   encodingBytes,
+}
+
+// encoding returns the wire encoding of the field, following the rules of
+// bqpb.Parse. The encoding of a map includes those of the keys and values.
+func encoding(typedefs *bqpb.Typedefs, field *bqpb.FieldDef) string {
+	if strings.HasPrefix(field.Type, "map<") && strings.HasSuffix(field.Type, ">") {
+		args := strings.SplitN(field.Type[len("map<"):len(field.Type)-1], ",", 2)
+		encodings := make([]string, len(args))
+		for i, arg := range args {
+			encodings[i] = typeEncoding(typedefs, strings.TrimSpace(arg), "")
+		}
+		return "map<" + strings.Join(encodings, ", ") + ">"
+	}
+	return typeEncoding(typedefs, field.Type, field.MessageEncoding)
+}
+
+func typeEncoding(typedefs *bqpb.Typedefs, typeName, messageEncoding string) string {
+	if encoding, ok := scalarEncodings[typeName]; ok {
+		return encoding
+	}
+	switch {
+	case typedefs.Enum(typeName) != nil:
+		return encodingVarint
+	case messageEncoding == bqpb.MessageEncodingDelimited:
+		return encodingGroup
+	default:
+		return encodingMessage
+	}
+}
+
+func describeType(field *bqpb.FieldDef) string {
+	if field.MessageEncoding == bqpb.MessageEncodingDelimited {
+		return "group " + field.Type
+	}
+	return field.Type
+}
+
+func cardinality(field *bqpb.FieldDef) string {
+	if field.Repeated {
+		return "repeated"
+	}
+	return "singular"
+}
+
+// presence returns the presence of a singular field as bqpb.Parse sees it.
+func presence(field *bqpb.FieldDef) string {
+	if field.OneofGroup == "" && field.FieldPresence == bqpb.FieldPresenceImplicit {
+		return bqpb.FieldPresenceImplicit
+	}
+	return bqpb.FieldPresenceExplicit
+}
@@ -0,0 +1,162 @@
+package compat_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+	"github.com/qnighy/bqpb/baseline/compat"
+)
+
+func TestCheck(t *testing.T) {
+	testcases := []struct {
+		name string
+		old  string
+		new  string
+		want []string
+	}{
+		{
+			name: "compatible",
+			old:  `{"message Main":{"a":{"type":"int32","id":1},"b":{"type":"Sub","id":2}},"message Sub":{},"enum E":{"A":0,"B":1}}`,
+			new:  `{"message Main":{"a":{"type":"int32","id":1},"b":{"type":"Sub","id":2},"c":{"type":"string","id":3}},"message Sub":{"x":{"type":"bool","id":1}},"enum E":{"A":0,"B":1,"C":2}}`,
+		},
+		{
+			name: "incompatible types",
+			old:  `{"message Main":{"a":{"type":"int32","id":1},"b":{"type":"string","id":2},"c":{"type":"float","id":3},"d":{"type":"Sub","id":4},"e":{"type":"map<string, int32>","id":5}},"message Sub":{}}`,
+			new:  `{"message Main":{"a":{"type":"sint32","id":1},"b":{"type":"Sub","id":2},"c":{"type":"fixed32","id":3},"d":{"type":"Sub","id":4,"messageEncoding":"delimited"},"e":{"type":"map<string, sint32>","id":5}},"message Sub":{}}`,
+			want: []string{
+				"Main.a: incompatible type: #1 int32 (varint) -> sint32 (zigzag varint)",
+				"Main.b: incompatible type: #2 string (bytes) -> Sub (message)",
+				"Main.c: incompatible type: #3 float (float) -> fixed32 (fixed32)",
+				"Main.d: incompatible type: #4 Sub (message) -> group Sub (group)",
+				"Main.e: incompatible type: #5 map<string, int32> (map<bytes, varint>) -> map<string, sint32> (map<bytes, zigzag varint>)",
+			},
+		},
+		{
+			name: "compatible type changes",
+			old:  `{"message Main":{"a":{"type":"int32","id":1},"b":{"type":"string","id":2},"c":{"type":"E","id":3}},"enum E":{"A":0}}`,
+			new:  `{"message Main":{"a":{"type":"int64","id":1},"b":{"type":"bytes","id":2},"c":{"type":"uint32","id":3}},"enum E":{"A":0}}`,
+			want: []string{
+				"Main.a: type changed: #1 int32 -> int64",
+				"Main.b: type changed: #2 string -> bytes",
+				"Main.c: type changed: #3 E -> uint32",
+			},
+		},
+		{
+			name: "presence and cardinality",
+			old:  `{"message Main":{"a":{"type":"int32","id":1,"fieldPresence":"implicit"},"b":{"type":"int32","id":2},"c":{"type":"int32","id":3,"fieldPresence":"implicit"},"d":{"type":"int32","id":4,"repeated":true},"e":{"type":"int32","id":5,"fieldPresence":"implicit"}}}`,
+			new:  `{"message Main":{"a":{"type":"int32","id":1},"b":{"type":"int32","id":2,"fieldPresence":"implicit"},"c":{"type":"int32","id":3,"fieldPresence":"implicit","oneofGroup":"x"},"d":{"type":"int32","id":4},"e":{"type":"int32","id":5,"fieldPresence":"implicit"}}}`,
+			want: []string{
+				"Main.a: presence changed: #1 implicit -> explicit",
+				"Main.b: presence changed: #2 explicit -> implicit",
+				"Main.c: presence changed: #3 implicit -> explicit",
+				"Main.d: cardinality changed: #4 repeated -> singular",
+			},
+		},
+		{
+			name: "renamed and removed",
+			old:  `{"message Main":{"a":{"type":"int32","id":1},"b":{"type":"int32","id":2}}}`,
+			new:  `{"message Main":{"aa":{"type":"int32","id":1},"b":{"type":"int32","id":3}}}`,
+			want: []string{
+				"Main.a: renamed: #1 -> aa",
+				"Main.b: removed without reservation: #2 is no longer decoded and appears as an unknown field",
+			},
+		},
+		{
+			name: "enum values",
+			old:  `{"message Main":{},"enum E":{"A":0,"B":1,"C":2,"D":3,"ALIAS":3}}`,
+			new:  `{"message Main":{},"enum E":{"A":0,"B":5,"CC":2,"ALIAS":3}}`,
+			want: []string{
+				"E.B: enum value renumbered: 1 -> 5",
+				"E.C: enum value renamed: 2 is printed as CC",
+				"E.D: enum value renamed: 3 is printed as ALIAS",
+			},
+		},
+		{
+			name: "enum value removed",
+			old:  `{"message Main":{},"enum E":{"A":0,"B":1}}`,
+			new:  `{"message Main":{},"enum E":{"A":0}}`,
+			want: []string{
+				"E.B: enum value removed: 1 is printed as a number",
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var old, new bqpb.Typedefs
+			if err := json.Unmarshal([]byte(tc.old), &old); err != nil {
+				t.Fatalf("Unmarshal error: %v\n", err)
+			}
+			if err := json.Unmarshal([]byte(tc.new), &new); err != nil {
+				t.Fatalf("Unmarshal error: %v\n", err)
+			}
+			got := findingStrings(compat.Check(&compat.Schema{Typedefs: &old}, &compat.Schema{Typedefs: &new}))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Check() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFromFiles(t *testing.T) {
+	old := compile(t, `
+		syntax = "proto3";
+		package p;
+		message Main {
+			int32 a = 1;
+			int32 b = 2;
+			sint32 c = 3;
+			message Inner {
+				int32 d = 1;
+				int32 e = 2;
+			}
+		}
+	`)
+	new := compile(t, `
+		syntax = "proto3";
+		package p;
+		message Main {
+			reserved 2;
+			optional int32 a = 1;
+			int32 c = 3;
+			message Inner {
+				reserved 1 to max;
+			}
+		}
+	`)
+	got := findingStrings(compat.Check(compat.FromFiles(old), compat.FromFiles(new)))
+	want := []string{
+		"p.Main.a: presence changed: #1 implicit -> explicit",
+		"p.Main.c: incompatible type: #3 sint32 (zigzag varint) -> int32 (varint)",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Check() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func compile(t *testing.T, source string) protoreflect.FileDescriptor {
+	t.Helper()
+	compiler := protocompile.Compiler{
+		Resolver: &protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(map[string]string{"test.proto": source}),
+		},
+	}
+	files, err := compiler.Compile(context.Background(), "test.proto")
+	if err != nil {
+		t.Fatalf("Compile error: %v\n", err)
+	}
+	return files[0]
+}
+
+func findingStrings(findings []*compat.Finding) []string {
+	var strs []string
+	for _, f := range findings {
+		strs = append(strs, f.String())
+	}
+	return strs
+}
@@ -0,0 +1,193 @@
+package baseline_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+	"github.com/qnighy/bqpb/baseline/bqpbdesc"
+	"github.com/qnighy/bqpb/baseline/randgen"
+)
+
+// docSnippet is a code block in docs/parse-protobuf.md.
+type docSnippet struct {
+	// section is the "###" heading above the block, and label is the "####"
+	// one, such as "proto3" or "bqpb".
+	section string
+	label   string
+	line    int
+	text    string
+}
+
+// docSyntaxes maps the labels of proto snippets to the syntax declarations
+// they are written for.
+var docSyntaxes = map[string][]string{
+	"proto":                {`syntax = "proto3";`},
+	"proto2":               {`syntax = "proto2";`},
+	"proto3":               {`syntax = "proto3";`},
+	"proto2 / proto3":      {`syntax = "proto2";`, `syntax = "proto3";`},
+	"proto (edition 2023)": {`edition = "2023";`},
+}
+
+// TestDocs checks the proto snippets in docs/parse-protobuf.md. Each one must
+// compile, and the typedefs derived from it must match the bqpb snippets of
+// the same section, if any, up to the defaults of fieldPresence and
+// messageEncoding. Random messages of the schema must also decode to the
+// same JSON with either typedefs.
+func TestDocs(t *testing.T) {
+	snippets, err := readDocSnippets("../docs/parse-protobuf.md")
+	if err != nil {
+		t.Fatalf("readDocSnippets error: %v\n", err)
+	}
+	protos, pairs := 0, 0
+	for _, protoSnippet := range snippets {
+		if !strings.HasPrefix(protoSnippet.label, "proto") {
+			continue
+		}
+		syntaxes, ok := docSyntaxes[protoSnippet.label]
+		if !ok {
+			t.Errorf("line %d: unknown label %q", protoSnippet.line, protoSnippet.label)
+			continue
+		}
+		var bqpbSnippets []*docSnippet
+		for _, bqpbSnippet := range snippets {
+			if bqpbSnippet.section == protoSnippet.section && bqpbSnippet.label == "bqpb" {
+				bqpbSnippets = append(bqpbSnippets, bqpbSnippet)
+			}
+		}
+		for _, syntax := range syntaxes {
+			protos++
+			pairs += len(bqpbSnippets)
+			name := fmt.Sprintf("%s/%s/line %d", protoSnippet.section, syntax, protoSnippet.line)
+			t.Run(name, func(t *testing.T) {
+				checkDocProto(t, syntax+"\n"+protoSnippet.text, bqpbSnippets)
+			})
+		}
+	}
+	if protos == 0 || pairs == 0 {
+		t.Errorf("found %d proto snippets and %d pairs with bqpb snippets", protos, pairs)
+	}
+}
+
+// checkDocProto compiles the proto snippet and checks it against the bqpb
+// snippets.
+func checkDocProto(t *testing.T, source string, bqpbSnippets []*docSnippet) {
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(map[string]string{"doc.proto": source}),
+		}),
+	}
+	files, err := compiler.Compile(context.Background(), "doc.proto")
+	if err != nil {
+		t.Fatalf("Compile error: %v\n", err)
+	}
+	fd := files[0]
+	derived := bqpbdesc.FromFiles(fd)
+	documented := make([]*bqpb.Typedefs, len(bqpbSnippets))
+	for i, bqpbSnippet := range bqpbSnippets {
+		documented[i] = &bqpb.Typedefs{}
+		if err := json.Unmarshal([]byte(bqpbSnippet.text), documented[i]); err != nil {
+			t.Fatalf("line %d: Unmarshal error: %v\n", bqpbSnippet.line, err)
+		}
+		if diff := cmp.Diff(docTypedefsString(t, derived), docTypedefsString(t, documented[i])); diff != "" {
+			t.Errorf("line %d: typedefs mismatch (-derived +documented):\n%s", bqpbSnippet.line, diff)
+		}
+	}
+
+	if fd.Messages().Len() == 0 {
+		return
+	}
+	md := fd.Messages().Get(0)
+	opts := randgen.DefaultOptions()
+	opts.Presence = 0.8
+	gen, err := randgen.New(derived, opts, rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatalf("randgen.New error: %v\n", err)
+	}
+	for i := 0; i < 20; i++ {
+		data, err := gen.Message(string(md.FullName()))
+		if err != nil {
+			t.Fatalf("Message error: %v\n", err)
+		}
+		// Typedefs do not mark required fields, which may be left unset.
+		if err := (proto.UnmarshalOptions{AllowPartial: true}).Unmarshal(data, dynamicpb.NewMessage(md)); err != nil {
+			t.Fatalf("Unmarshal error on %q: %v\n", data, err)
+		}
+		want, err := bqpb.Parse(data, string(md.FullName()), *derived)
+		if err != nil {
+			t.Fatalf("Parse error on %q: %v\n", data, err)
+		}
+		for j, typedefs := range documented {
+			got, err := bqpb.Parse(data, string(md.FullName()), *typedefs)
+			if err != nil {
+				t.Fatalf("line %d: Parse error on %q: %v\n", bqpbSnippets[j].line, data, err)
+			}
+			if diff := cmp.Diff(string(want), string(got)); diff != "" {
+				t.Errorf("line %d: Parse(%q) mismatch (-derived +documented):\n%s", bqpbSnippets[j].line, data, diff)
+			}
+		}
+	}
+}
+
+// docTypedefsString formats the typedefs for comparison, filling in the
+// defaults the docs may leave out.
+func docTypedefsString(t *testing.T, typedefs *bqpb.Typedefs) string {
+	for _, m := range typedefs.Messages {
+		for _, f := range m.Fields {
+			if f.FieldPresence == "" {
+				f.FieldPresence = bqpb.FieldPresenceExplicit
+			}
+			if f.MessageEncoding == "" {
+				f.MessageEncoding = bqpb.MessageEncodingLengthPrefixed
+			}
+		}
+	}
+	data, err := json.MarshalIndent(typedefs, "", "  ")
+	if err != nil {
+		t.Fatalf("MarshalIndent error: %v\n", err)
+	}
+	return string(data)
+}
+
+// readDocSnippets returns the code blocks in the markdown file.
+func readDocSnippets(path string) ([]*docSnippet, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var snippets []*docSnippet
+	var section, label string
+	var current *docSnippet
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		switch {
+		case current != nil && text == "```":
+			snippets = append(snippets, current)
+			current = nil
+		case current != nil:
+			current.text += text + "\n"
+		case strings.HasPrefix(text, "```"):
+			current = &docSnippet{section: section, label: label, line: line}
+		case strings.HasPrefix(text, "### "):
+			section, label = strings.TrimPrefix(text, "### "), ""
+		case strings.HasPrefix(text, "#### "):
+			label = strings.TrimPrefix(text, "#### ")
+		case strings.HasPrefix(text, "## "):
+			section, label = "", ""
+		}
+	}
+	return snippets, scanner.Err()
+}
@@ -0,0 +1,97 @@
+package baseline_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+)
+
+// TestEncodeRoundTrip encodes the output of Parse back on every
+// serialization case. Parsing the encoded message must give the same JSON,
+// and protobuf-go must see the same message as in the original input unless
+// Parse loses information on it.
+func TestEncodeRoundTrip(t *testing.T) {
+	for _, tc := range serializationTestCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			md := tc.datatype.ProtoReflect().Descriptor()
+			messageType := string(md.FullName())
+			typedefs := *corpusTypedefs(md)
+			parsed, err := bqpb.Parse(tc.data, messageType, typedefs)
+			if err != nil {
+				t.Skipf("Parse error: %v", err)
+			}
+
+			encoded, err := bqpb.Encode(parsed, messageType, typedefs)
+			if err != nil {
+				t.Fatalf("Encode error: %v", err)
+			}
+			reparsed, err := bqpb.Parse(encoded, messageType, typedefs)
+			if err != nil {
+				t.Fatalf("Parse error on %q: %v", encoded, err)
+			}
+			if diff := cmp.Diff(abbreviate(string(parsed)), abbreviate(string(reparsed))); diff != "" {
+				t.Errorf("Parse(Encode()) mismatch (-want +got):\n%s", diff)
+			}
+
+			// Deviations beyond the members dropped by canonicalJSON mean that
+			// Parse loses information.
+			if tc.wantErr != "" || (tc.deviation != nil && !sameCanonical(tc.want, tc.deviation.want)) {
+				return
+			}
+			want := tc.datatype.ProtoReflect().New().Interface()
+			if err := proto.Unmarshal(tc.data, want); err != nil {
+				t.Fatalf("proto.Unmarshal error: %v", err)
+			}
+			got := tc.datatype.ProtoReflect().New().Interface()
+			if err := proto.Unmarshal(encoded, got); err != nil {
+				t.Fatalf("proto.Unmarshal error on %q: %v", encoded, err)
+			}
+			if diff := cmp.Diff(want, got, protocmp.Transform(), cmpopts.EquateNaNs()); diff != "" {
+				t.Errorf("proto.Unmarshal(Encode()) mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestEncodeSchemalessRoundTrip encodes the output of Parse without typedefs
+// back, and checks that protobuf-go still sees the original message, so that
+// unknown submessages keep their wire type. The groups of example2 are left
+// out, as they are inferred in the same way as LEN submessages, and so are
+// the NaNs, whose payloads are lost.
+func TestEncodeSchemalessRoundTrip(t *testing.T) {
+	for _, tc := range serializationTestCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.wantErr != "" || tc.datatype.ProtoReflect().Descriptor().ParentFile().Package() == "example2" {
+				t.Skip("not expected to round-trip")
+			}
+			parsed, err := bqpb.Parse(tc.data, "", bqpb.Typedefs{})
+			if err != nil {
+				t.Skipf("Parse error: %v", err)
+			}
+			if strings.Contains(string(parsed), "NaN") {
+				t.Skip("NaN payloads are lost")
+			}
+			encoded, err := bqpb.Encode(parsed, "", bqpb.Typedefs{})
+			if err != nil {
+				t.Fatalf("Encode error: %v", err)
+			}
+			want := tc.datatype.ProtoReflect().New().Interface()
+			if err := proto.Unmarshal(tc.data, want); err != nil {
+				t.Fatalf("proto.Unmarshal error: %v", err)
+			}
+			got := tc.datatype.ProtoReflect().New().Interface()
+			if err := proto.Unmarshal(encoded, got); err != nil {
+				t.Fatalf("proto.Unmarshal error on %q: %v", encoded, err)
+			}
+			if diff := cmp.Diff(want, got, protocmp.Transform(), cmpopts.EquateNaNs()); diff != "" {
+				t.Errorf("proto.Unmarshal(Encode()) mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
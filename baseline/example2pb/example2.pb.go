@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.31.0
-// 	protoc        v3.12.4
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
 // source: example2.proto
 
 package example2pb
@@ -67,6 +67,227 @@ func (x *RepeatedGroup) GetMyField() []*RepeatedGroup_MyField {
 	return nil
 }
 
+type OptionalGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MyField *OptionalGroup_MyField `protobuf:"group,1,opt,name=My_field,json=myField" json:"my_field,omitempty"`
+}
+
+func (x *OptionalGroup) Reset() {
+	*x = OptionalGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example2_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptionalGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionalGroup) ProtoMessage() {}
+
+func (x *OptionalGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_example2_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionalGroup.ProtoReflect.Descriptor instead.
+func (*OptionalGroup) Descriptor() ([]byte, []int) {
+	return file_example2_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OptionalGroup) GetMyField() *OptionalGroup_MyField {
+	if x != nil {
+		return x.MyField
+	}
+	return nil
+}
+
+type NestedGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OuterField *NestedGroup_OuterField `protobuf:"group,1,opt,name=Outer_field,json=outerField" json:"outer_field,omitempty"`
+}
+
+func (x *NestedGroup) Reset() {
+	*x = NestedGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example2_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NestedGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NestedGroup) ProtoMessage() {}
+
+func (x *NestedGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_example2_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NestedGroup.ProtoReflect.Descriptor instead.
+func (*NestedGroup) Descriptor() ([]byte, []int) {
+	return file_example2_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NestedGroup) GetOuterField() *NestedGroup_OuterField {
+	if x != nil {
+		return x.OuterField
+	}
+	return nil
+}
+
+type OneofGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to MyField:
+	//	*OneofGroup_Uint32Field
+	//	*OneofGroup_GroupField_
+	MyField isOneofGroup_MyField `protobuf_oneof:"my_field"`
+}
+
+func (x *OneofGroup) Reset() {
+	*x = OneofGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example2_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OneofGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneofGroup) ProtoMessage() {}
+
+func (x *OneofGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_example2_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OneofGroup.ProtoReflect.Descriptor instead.
+func (*OneofGroup) Descriptor() ([]byte, []int) {
+	return file_example2_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *OneofGroup) GetMyField() isOneofGroup_MyField {
+	if m != nil {
+		return m.MyField
+	}
+	return nil
+}
+
+func (x *OneofGroup) GetUint32Field() uint32 {
+	if x, ok := x.GetMyField().(*OneofGroup_Uint32Field); ok {
+		return x.Uint32Field
+	}
+	return 0
+}
+
+func (x *OneofGroup) GetGroupField() *OneofGroup_GroupField {
+	if x, ok := x.GetMyField().(*OneofGroup_GroupField_); ok {
+		return x.GroupField
+	}
+	return nil
+}
+
+type isOneofGroup_MyField interface {
+	isOneofGroup_MyField()
+}
+
+type OneofGroup_Uint32Field struct {
+	Uint32Field uint32 `protobuf:"varint,1,opt,name=uint32_field,json=uint32Field,oneof"`
+}
+
+type OneofGroup_GroupField_ struct {
+	GroupField *OneofGroup_GroupField `protobuf:"group,2,opt,name=Group_field,json=groupField,oneof"`
+}
+
+func (*OneofGroup_Uint32Field) isOneofGroup_MyField() {}
+
+func (*OneofGroup_GroupField_) isOneofGroup_MyField() {}
+
+type RecursiveGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MyField *RecursiveGroup_MyField `protobuf:"group,1,opt,name=My_field,json=myField" json:"my_field,omitempty"`
+}
+
+func (x *RecursiveGroup) Reset() {
+	*x = RecursiveGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example2_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecursiveGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecursiveGroup) ProtoMessage() {}
+
+func (x *RecursiveGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_example2_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecursiveGroup.ProtoReflect.Descriptor instead.
+func (*RecursiveGroup) Descriptor() ([]byte, []int) {
+	return file_example2_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RecursiveGroup) GetMyField() *RecursiveGroup_MyField {
+	if x != nil {
+		return x.MyField
+	}
+	return nil
+}
+
 type RepeatedGroup_MyField struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -78,7 +299,7 @@ type RepeatedGroup_MyField struct {
 func (x *RepeatedGroup_MyField) Reset() {
 	*x = RepeatedGroup_MyField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example2_proto_msgTypes[1]
+		mi := &file_example2_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -91,7 +312,7 @@ func (x *RepeatedGroup_MyField) String() string {
 func (*RepeatedGroup_MyField) ProtoMessage() {}
 
 func (x *RepeatedGroup_MyField) ProtoReflect() protoreflect.Message {
-	mi := &file_example2_proto_msgTypes[1]
+	mi := &file_example2_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -114,6 +335,241 @@ func (x *RepeatedGroup_MyField) GetSubmessageField() []uint32 {
 	return nil
 }
 
+type OptionalGroup_MyField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmessageField *uint32 `protobuf:"varint,1,opt,name=submessage_field,json=submessageField" json:"submessage_field,omitempty"`
+}
+
+func (x *OptionalGroup_MyField) Reset() {
+	*x = OptionalGroup_MyField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example2_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptionalGroup_MyField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionalGroup_MyField) ProtoMessage() {}
+
+func (x *OptionalGroup_MyField) ProtoReflect() protoreflect.Message {
+	mi := &file_example2_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionalGroup_MyField.ProtoReflect.Descriptor instead.
+func (*OptionalGroup_MyField) Descriptor() ([]byte, []int) {
+	return file_example2_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *OptionalGroup_MyField) GetSubmessageField() uint32 {
+	if x != nil && x.SubmessageField != nil {
+		return *x.SubmessageField
+	}
+	return 0
+}
+
+type NestedGroup_OuterField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InnerField *NestedGroup_OuterField_InnerField `protobuf:"group,2,opt,name=Inner_field,json=innerField" json:"inner_field,omitempty"`
+}
+
+func (x *NestedGroup_OuterField) Reset() {
+	*x = NestedGroup_OuterField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example2_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NestedGroup_OuterField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NestedGroup_OuterField) ProtoMessage() {}
+
+func (x *NestedGroup_OuterField) ProtoReflect() protoreflect.Message {
+	mi := &file_example2_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NestedGroup_OuterField.ProtoReflect.Descriptor instead.
+func (*NestedGroup_OuterField) Descriptor() ([]byte, []int) {
+	return file_example2_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *NestedGroup_OuterField) GetInnerField() *NestedGroup_OuterField_InnerField {
+	if x != nil {
+		return x.InnerField
+	}
+	return nil
+}
+
+type NestedGroup_OuterField_InnerField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmessageField *uint32 `protobuf:"varint,3,opt,name=submessage_field,json=submessageField" json:"submessage_field,omitempty"`
+}
+
+func (x *NestedGroup_OuterField_InnerField) Reset() {
+	*x = NestedGroup_OuterField_InnerField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example2_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NestedGroup_OuterField_InnerField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NestedGroup_OuterField_InnerField) ProtoMessage() {}
+
+func (x *NestedGroup_OuterField_InnerField) ProtoReflect() protoreflect.Message {
+	mi := &file_example2_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NestedGroup_OuterField_InnerField.ProtoReflect.Descriptor instead.
+func (*NestedGroup_OuterField_InnerField) Descriptor() ([]byte, []int) {
+	return file_example2_proto_rawDescGZIP(), []int{2, 0, 0}
+}
+
+func (x *NestedGroup_OuterField_InnerField) GetSubmessageField() uint32 {
+	if x != nil && x.SubmessageField != nil {
+		return *x.SubmessageField
+	}
+	return 0
+}
+
+type OneofGroup_GroupField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmessageField *uint32 `protobuf:"varint,1,opt,name=submessage_field,json=submessageField" json:"submessage_field,omitempty"`
+}
+
+func (x *OneofGroup_GroupField) Reset() {
+	*x = OneofGroup_GroupField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example2_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OneofGroup_GroupField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneofGroup_GroupField) ProtoMessage() {}
+
+func (x *OneofGroup_GroupField) ProtoReflect() protoreflect.Message {
+	mi := &file_example2_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OneofGroup_GroupField.ProtoReflect.Descriptor instead.
+func (*OneofGroup_GroupField) Descriptor() ([]byte, []int) {
+	return file_example2_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *OneofGroup_GroupField) GetSubmessageField() uint32 {
+	if x != nil && x.SubmessageField != nil {
+		return *x.SubmessageField
+	}
+	return 0
+}
+
+type RecursiveGroup_MyField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecursiveField *RecursiveGroup `protobuf:"bytes,2,opt,name=recursive_field,json=recursiveField" json:"recursive_field,omitempty"`
+}
+
+func (x *RecursiveGroup_MyField) Reset() {
+	*x = RecursiveGroup_MyField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example2_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecursiveGroup_MyField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecursiveGroup_MyField) ProtoMessage() {}
+
+func (x *RecursiveGroup_MyField) ProtoReflect() protoreflect.Message {
+	mi := &file_example2_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecursiveGroup_MyField.ProtoReflect.Descriptor instead.
+func (*RecursiveGroup_MyField) Descriptor() ([]byte, []int) {
+	return file_example2_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *RecursiveGroup_MyField) GetRecursiveField() *RecursiveGroup {
+	if x != nil {
+		return x.RecursiveField
+	}
+	return nil
+}
+
 var File_example2_proto protoreflect.FileDescriptor
 
 var file_example2_proto_rawDesc = []byte{
@@ -127,7 +583,53 @@ var file_example2_proto_rawDesc = []byte{
 	0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52,
 	0x0f, 0x73, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x32, 0x70, 0x62,
+	0x22, 0x83, 0x01, 0x0a, 0x0d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x3b, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0a, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x32, 0x2e,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x4d, 0x79,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a,
+	0x35, 0x0a, 0x08, 0x4d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x73,
+	0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xeb, 0x01, 0x0a, 0x0b, 0x4e, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x42, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0a, 0x32, 0x21, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x32, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x4f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0a,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x97, 0x01, 0x0a, 0x0b, 0x4f,
+	0x75, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x4e, 0x0a, 0x0b, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0a, 0x32,
+	0x2d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x32, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x4f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0a,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x38, 0x0a, 0x0b, 0x49, 0x6e,
+	0x6e, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x62,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x22, 0xbc, 0x01, 0x0a, 0x0a, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x23, 0x0a, 0x0c, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0b, 0x75, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x43, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0a, 0x32, 0x20, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x32, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x48,
+	0x00, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x38, 0x0a,
+	0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x29, 0x0a, 0x10,
+	0x73, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3c, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0a, 0x32, 0x21, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x32, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x4d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x07, 0x6d, 0x79, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x1a, 0x4d, 0x0a, 0x08, 0x4d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x41, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x32, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x32, 0x70, 0x62,
 }
 
 var (
@@ -142,18 +644,33 @@ func file_example2_proto_rawDescGZIP() []byte {
 	return file_example2_proto_rawDescData
 }
 
-var file_example2_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_example2_proto_goTypes = []interface{}{
-	(*RepeatedGroup)(nil),         // 0: example2.RepeatedGroup
-	(*RepeatedGroup_MyField)(nil), // 1: example2.RepeatedGroup.My_field
+var file_example2_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_example2_proto_goTypes = []any{
+	(*RepeatedGroup)(nil),                     // 0: example2.RepeatedGroup
+	(*OptionalGroup)(nil),                     // 1: example2.OptionalGroup
+	(*NestedGroup)(nil),                       // 2: example2.NestedGroup
+	(*OneofGroup)(nil),                        // 3: example2.OneofGroup
+	(*RecursiveGroup)(nil),                    // 4: example2.RecursiveGroup
+	(*RepeatedGroup_MyField)(nil),             // 5: example2.RepeatedGroup.My_field
+	(*OptionalGroup_MyField)(nil),             // 6: example2.OptionalGroup.My_field
+	(*NestedGroup_OuterField)(nil),            // 7: example2.NestedGroup.Outer_field
+	(*NestedGroup_OuterField_InnerField)(nil), // 8: example2.NestedGroup.Outer_field.Inner_field
+	(*OneofGroup_GroupField)(nil),             // 9: example2.OneofGroup.Group_field
+	(*RecursiveGroup_MyField)(nil),            // 10: example2.RecursiveGroup.My_field
 }
 var file_example2_proto_depIdxs = []int32{
-	1, // 0: example2.RepeatedGroup.my_field:type_name -> example2.RepeatedGroup.My_field
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	5,  // 0: example2.RepeatedGroup.my_field:type_name -> example2.RepeatedGroup.My_field
+	6,  // 1: example2.OptionalGroup.my_field:type_name -> example2.OptionalGroup.My_field
+	7,  // 2: example2.NestedGroup.outer_field:type_name -> example2.NestedGroup.Outer_field
+	9,  // 3: example2.OneofGroup.group_field:type_name -> example2.OneofGroup.Group_field
+	10, // 4: example2.RecursiveGroup.my_field:type_name -> example2.RecursiveGroup.My_field
+	8,  // 5: example2.NestedGroup.Outer_field.inner_field:type_name -> example2.NestedGroup.Outer_field.Inner_field
+	4,  // 6: example2.RecursiveGroup.My_field.recursive_field:type_name -> example2.RecursiveGroup
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_example2_proto_init() }
@@ -162,7 +679,7 @@ func file_example2_proto_init() {
 		return
 	}
 	if !protoimpl.UnsafeEnabled {
-		file_example2_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+		file_example2_proto_msgTypes[0].Exporter = func(v any, i int) any {
 			switch v := v.(*RepeatedGroup); i {
 			case 0:
 				return &v.state
@@ -174,7 +691,55 @@ func file_example2_proto_init() {
 				return nil
 			}
 		}
-		file_example2_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+		file_example2_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*OptionalGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example2_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*NestedGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example2_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*OneofGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example2_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*RecursiveGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example2_proto_msgTypes[5].Exporter = func(v any, i int) any {
 			switch v := v.(*RepeatedGroup_MyField); i {
 			case 0:
 				return &v.state
@@ -186,6 +751,70 @@ func file_example2_proto_init() {
 				return nil
 			}
 		}
+		file_example2_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*OptionalGroup_MyField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example2_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*NestedGroup_OuterField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example2_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*NestedGroup_OuterField_InnerField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example2_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*OneofGroup_GroupField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example2_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*RecursiveGroup_MyField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_example2_proto_msgTypes[3].OneofWrappers = []any{
+		(*OneofGroup_Uint32Field)(nil),
+		(*OneofGroup_GroupField_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -193,7 +822,7 @@ func file_example2_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_example2_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,831 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: example3.proto
+
+package example3pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Order_Status int32
+
+const (
+	Order_STATUS_UNSPECIFIED Order_Status = 0
+	Order_STATUS_SHIPPED     Order_Status = 1
+)
+
+// Enum value maps for Order_Status.
+var (
+	Order_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_SHIPPED",
+	}
+	Order_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_SHIPPED":     1,
+	}
+)
+
+func (x Order_Status) Enum() *Order_Status {
+	p := new(Order_Status)
+	*p = x
+	return p
+}
+
+func (x Order_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Order_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_example3_proto_enumTypes[0].Descriptor()
+}
+
+func (Order_Status) Type() protoreflect.EnumType {
+	return &file_example3_proto_enumTypes[0]
+}
+
+func (x Order_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Order_Status.Descriptor instead.
+func (Order_Status) EnumDescriptor() ([]byte, []int) {
+	return file_example3_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type Invoice_Status int32
+
+const (
+	Invoice_STATUS_UNSPECIFIED Invoice_Status = 0
+	Invoice_STATUS_PAID        Invoice_Status = 1
+)
+
+// Enum value maps for Invoice_Status.
+var (
+	Invoice_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_PAID",
+	}
+	Invoice_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_PAID":        1,
+	}
+)
+
+func (x Invoice_Status) Enum() *Invoice_Status {
+	p := new(Invoice_Status)
+	*p = x
+	return p
+}
+
+func (x Invoice_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Invoice_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_example3_proto_enumTypes[1].Descriptor()
+}
+
+func (Invoice_Status) Type() protoreflect.EnumType {
+	return &file_example3_proto_enumTypes[1]
+}
+
+func (x Invoice_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Invoice_Status.Descriptor instead.
+func (Invoice_Status) EnumDescriptor() ([]byte, []int) {
+	return file_example3_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type CustomJsonName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlainField   uint32 `protobuf:"varint,1,opt,name=plain_field,json=plainField,proto3" json:"plain_field,omitempty"`
+	RenamedField uint32 `protobuf:"varint,2,opt,name=renamed_field,json=customName,proto3" json:"renamed_field,omitempty"`
+	SnakeJson    uint32 `protobuf:"varint,3,opt,name=snake_json,proto3" json:"snake_json,omitempty"`
+	// Types that are assignable to MyChoice:
+	//	*CustomJsonName_ChoiceA
+	//	*CustomJsonName_ChoiceB
+	MyChoice    isCustomJsonName_MyChoice `protobuf_oneof:"my_choice"`
+	CountsByKey map[string]uint32         `protobuf:"bytes,6,rep,name=counts_by_key,json=counts,proto3" json:"counts_by_key,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *CustomJsonName) Reset() {
+	*x = CustomJsonName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example3_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomJsonName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomJsonName) ProtoMessage() {}
+
+func (x *CustomJsonName) ProtoReflect() protoreflect.Message {
+	mi := &file_example3_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomJsonName.ProtoReflect.Descriptor instead.
+func (*CustomJsonName) Descriptor() ([]byte, []int) {
+	return file_example3_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CustomJsonName) GetPlainField() uint32 {
+	if x != nil {
+		return x.PlainField
+	}
+	return 0
+}
+
+func (x *CustomJsonName) GetRenamedField() uint32 {
+	if x != nil {
+		return x.RenamedField
+	}
+	return 0
+}
+
+func (x *CustomJsonName) GetSnakeJson() uint32 {
+	if x != nil {
+		return x.SnakeJson
+	}
+	return 0
+}
+
+func (m *CustomJsonName) GetMyChoice() isCustomJsonName_MyChoice {
+	if m != nil {
+		return m.MyChoice
+	}
+	return nil
+}
+
+func (x *CustomJsonName) GetChoiceA() string {
+	if x, ok := x.GetMyChoice().(*CustomJsonName_ChoiceA); ok {
+		return x.ChoiceA
+	}
+	return ""
+}
+
+func (x *CustomJsonName) GetChoiceB() uint32 {
+	if x, ok := x.GetMyChoice().(*CustomJsonName_ChoiceB); ok {
+		return x.ChoiceB
+	}
+	return 0
+}
+
+func (x *CustomJsonName) GetCountsByKey() map[string]uint32 {
+	if x != nil {
+		return x.CountsByKey
+	}
+	return nil
+}
+
+type isCustomJsonName_MyChoice interface {
+	isCustomJsonName_MyChoice()
+}
+
+type CustomJsonName_ChoiceA struct {
+	ChoiceA string `protobuf:"bytes,4,opt,name=choice_a,json=choiceA,proto3,oneof"`
+}
+
+type CustomJsonName_ChoiceB struct {
+	ChoiceB uint32 `protobuf:"varint,5,opt,name=choice_b,json=b,proto3,oneof"`
+}
+
+func (*CustomJsonName_ChoiceA) isCustomJsonName_MyChoice() {}
+
+func (*CustomJsonName_ChoiceB) isCustomJsonName_MyChoice() {}
+
+type Order struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *Order_Item `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example3_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_example3_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_example3_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Order) GetItem() *Order_Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type Invoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Invoice_Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example3_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_example3_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_example3_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Invoice) GetItems() []*Invoice_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type Document struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order   *Order          `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Invoice *Invoice        `protobuf:"bytes,2,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	Names   *CustomJsonName `protobuf:"bytes,3,opt,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *Document) Reset() {
+	*x = Document{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example3_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Document) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document) ProtoMessage() {}
+
+func (x *Document) ProtoReflect() protoreflect.Message {
+	mi := &file_example3_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document.ProtoReflect.Descriptor instead.
+func (*Document) Descriptor() ([]byte, []int) {
+	return file_example3_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Document) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *Document) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+func (x *Document) GetNames() *CustomJsonName {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example3_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_example3_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_example3_proto_rawDescGZIP(), []int{4}
+}
+
+type EmptyConflict struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Local    *Empty         `protobuf:"bytes,1,opt,name=local,proto3" json:"local,omitempty"`
+	Imported *emptypb.Empty `protobuf:"bytes,2,opt,name=imported,proto3" json:"imported,omitempty"`
+}
+
+func (x *EmptyConflict) Reset() {
+	*x = EmptyConflict{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example3_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmptyConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyConflict) ProtoMessage() {}
+
+func (x *EmptyConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_example3_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyConflict.ProtoReflect.Descriptor instead.
+func (*EmptyConflict) Descriptor() ([]byte, []int) {
+	return file_example3_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EmptyConflict) GetLocal() *Empty {
+	if x != nil {
+		return x.Local
+	}
+	return nil
+}
+
+func (x *EmptyConflict) GetImported() *emptypb.Empty {
+	if x != nil {
+		return x.Imported
+	}
+	return nil
+}
+
+type Order_Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemName string       `protobuf:"bytes,1,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	Status   Order_Status `protobuf:"varint,2,opt,name=status,proto3,enum=example3.Order_Status" json:"status,omitempty"`
+}
+
+func (x *Order_Item) Reset() {
+	*x = Order_Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example3_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Order_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order_Item) ProtoMessage() {}
+
+func (x *Order_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_example3_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order_Item.ProtoReflect.Descriptor instead.
+func (*Order_Item) Descriptor() ([]byte, []int) {
+	return file_example3_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Order_Item) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *Order_Item) GetStatus() Order_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Order_STATUS_UNSPECIFIED
+}
+
+type Invoice_Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnitPrice uint64         `protobuf:"varint,1,opt,name=unit_price,json=price,proto3" json:"unit_price,omitempty"`
+	Status    Invoice_Status `protobuf:"varint,2,opt,name=status,proto3,enum=example3.Invoice_Status" json:"status,omitempty"`
+}
+
+func (x *Invoice_Item) Reset() {
+	*x = Invoice_Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example3_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invoice_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice_Item) ProtoMessage() {}
+
+func (x *Invoice_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_example3_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice_Item.ProtoReflect.Descriptor instead.
+func (*Invoice_Item) Descriptor() ([]byte, []int) {
+	return file_example3_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Invoice_Item) GetUnitPrice() uint64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *Invoice_Item) GetStatus() Invoice_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Invoice_STATUS_UNSPECIFIED
+}
+
+var File_example3_proto protoreflect.FileDescriptor
+
+var file_example3_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x33, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbf, 0x02, 0x0a, 0x0e, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c,
+	0x61, 0x69, 0x6e, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x21, 0x0a, 0x0d, 0x72,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x6e, 0x61, 0x6b, 0x65, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x6b, 0x65, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b,
+	0x0a, 0x08, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x41, 0x12, 0x15, 0x0a, 0x08, 0x63,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52,
+	0x01, 0x62, 0x12, 0x48, 0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x79, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x33, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x4b, 0x65, 0x79, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x3e, 0x0a, 0x10,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x4b, 0x65, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0b, 0x0a, 0x09,
+	0x6d, 0x79, 0x5f, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x05, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x33, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x1a, 0x53, 0x0a,
+	0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x33, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x34, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53,
+	0x48, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x01, 0x22, 0xbf, 0x01, 0x0a, 0x07, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x33, 0x2e, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x1a, 0x53, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x19, 0x0a, 0x0a, 0x75, 0x6e,
+	0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x33,
+	0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x31, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x49, 0x44, 0x10, 0x01, 0x22, 0x8e, 0x01, 0x0a, 0x08, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x33, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2b,
+	0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x33, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x33, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4a, 0x73, 0x6f, 0x6e,
+	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x07, 0x0a, 0x05, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x6a, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x33, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x08,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x33, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_example3_proto_rawDescOnce sync.Once
+	file_example3_proto_rawDescData = file_example3_proto_rawDesc
+)
+
+func file_example3_proto_rawDescGZIP() []byte {
+	file_example3_proto_rawDescOnce.Do(func() {
+		file_example3_proto_rawDescData = protoimpl.X.CompressGZIP(file_example3_proto_rawDescData)
+	})
+	return file_example3_proto_rawDescData
+}
+
+var file_example3_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_example3_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_example3_proto_goTypes = []any{
+	(Order_Status)(0),      // 0: example3.Order.Status
+	(Invoice_Status)(0),    // 1: example3.Invoice.Status
+	(*CustomJsonName)(nil), // 2: example3.CustomJsonName
+	(*Order)(nil),          // 3: example3.Order
+	(*Invoice)(nil),        // 4: example3.Invoice
+	(*Document)(nil),       // 5: example3.Document
+	(*Empty)(nil),          // 6: example3.Empty
+	(*EmptyConflict)(nil),  // 7: example3.EmptyConflict
+	nil,                    // 8: example3.CustomJsonName.CountsByKeyEntry
+	(*Order_Item)(nil),     // 9: example3.Order.Item
+	(*Invoice_Item)(nil),   // 10: example3.Invoice.Item
+	(*emptypb.Empty)(nil),  // 11: google.protobuf.Empty
+}
+var file_example3_proto_depIdxs = []int32{
+	8,  // 0: example3.CustomJsonName.counts_by_key:type_name -> example3.CustomJsonName.CountsByKeyEntry
+	9,  // 1: example3.Order.item:type_name -> example3.Order.Item
+	10, // 2: example3.Invoice.items:type_name -> example3.Invoice.Item
+	3,  // 3: example3.Document.order:type_name -> example3.Order
+	4,  // 4: example3.Document.invoice:type_name -> example3.Invoice
+	2,  // 5: example3.Document.names:type_name -> example3.CustomJsonName
+	6,  // 6: example3.EmptyConflict.local:type_name -> example3.Empty
+	11, // 7: example3.EmptyConflict.imported:type_name -> google.protobuf.Empty
+	0,  // 8: example3.Order.Item.status:type_name -> example3.Order.Status
+	1,  // 9: example3.Invoice.Item.status:type_name -> example3.Invoice.Status
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_example3_proto_init() }
+func file_example3_proto_init() {
+	if File_example3_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_example3_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*CustomJsonName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example3_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Order); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example3_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*Invoice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example3_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Document); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example3_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example3_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*EmptyConflict); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example3_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*Order_Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example3_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*Invoice_Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_example3_proto_msgTypes[0].OneofWrappers = []any{
+		(*CustomJsonName_ChoiceA)(nil),
+		(*CustomJsonName_ChoiceB)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_example3_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_example3_proto_goTypes,
+		DependencyIndexes: file_example3_proto_depIdxs,
+		EnumInfos:         file_example3_proto_enumTypes,
+		MessageInfos:      file_example3_proto_msgTypes,
+	}.Build()
+	File_example3_proto = out.File
+	file_example3_proto_rawDesc = nil
+	file_example3_proto_goTypes = nil
+	file_example3_proto_depIdxs = nil
+}
@@ -1,15 +1,16 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.31.0
-// 	protoc        v3.12.4
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
 // source: example.proto
 
 package examplepb
 
 import (
-	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -168,6 +169,55 @@ func (RepeatedEnum_MyEnum) EnumDescriptor() ([]byte, []int) {
 	return file_example_proto_rawDescGZIP(), []int{2, 0}
 }
 
+type MapStringEnum_MyEnum int32
+
+const (
+	MapStringEnum_MY_ENUM_UNSPECIFIED MapStringEnum_MyEnum = 0
+	MapStringEnum_MY_ENUM_VALUE_1     MapStringEnum_MyEnum = 1
+	MapStringEnum_MY_ENUM_VALUE_2     MapStringEnum_MyEnum = 2
+)
+
+// Enum value maps for MapStringEnum_MyEnum.
+var (
+	MapStringEnum_MyEnum_name = map[int32]string{
+		0: "MY_ENUM_UNSPECIFIED",
+		1: "MY_ENUM_VALUE_1",
+		2: "MY_ENUM_VALUE_2",
+	}
+	MapStringEnum_MyEnum_value = map[string]int32{
+		"MY_ENUM_UNSPECIFIED": 0,
+		"MY_ENUM_VALUE_1":     1,
+		"MY_ENUM_VALUE_2":     2,
+	}
+)
+
+func (x MapStringEnum_MyEnum) Enum() *MapStringEnum_MyEnum {
+	p := new(MapStringEnum_MyEnum)
+	*p = x
+	return p
+}
+
+func (x MapStringEnum_MyEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MapStringEnum_MyEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_example_proto_enumTypes[3].Descriptor()
+}
+
+func (MapStringEnum_MyEnum) Type() protoreflect.EnumType {
+	return &file_example_proto_enumTypes[3]
+}
+
+func (x MapStringEnum_MyEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MapStringEnum_MyEnum.Descriptor instead.
+func (MapStringEnum_MyEnum) EnumDescriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{35, 0}
+}
+
 type ImplicitEnum struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1625,13 +1675,341 @@ func (x *MapStringUint32) GetMyField() map[string]uint32 {
 	return nil
 }
 
+type MapInt64Uint32 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MyField map[int64]uint32 `protobuf:"bytes,1,rep,name=my_field,json=myField,proto3" json:"my_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *MapInt64Uint32) Reset() {
+	*x = MapInt64Uint32{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapInt64Uint32) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapInt64Uint32) ProtoMessage() {}
+
+func (x *MapInt64Uint32) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapInt64Uint32.ProtoReflect.Descriptor instead.
+func (*MapInt64Uint32) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MapInt64Uint32) GetMyField() map[int64]uint32 {
+	if x != nil {
+		return x.MyField
+	}
+	return nil
+}
+
+type MapSint64Uint32 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MyField map[int64]uint32 `protobuf:"bytes,1,rep,name=my_field,json=myField,proto3" json:"my_field,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *MapSint64Uint32) Reset() {
+	*x = MapSint64Uint32{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapSint64Uint32) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapSint64Uint32) ProtoMessage() {}
+
+func (x *MapSint64Uint32) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapSint64Uint32.ProtoReflect.Descriptor instead.
+func (*MapSint64Uint32) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MapSint64Uint32) GetMyField() map[int64]uint32 {
+	if x != nil {
+		return x.MyField
+	}
+	return nil
+}
+
+type MapSfixed64Uint32 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MyField map[int64]uint32 `protobuf:"bytes,1,rep,name=my_field,json=myField,proto3" json:"my_field,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *MapSfixed64Uint32) Reset() {
+	*x = MapSfixed64Uint32{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapSfixed64Uint32) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapSfixed64Uint32) ProtoMessage() {}
+
+func (x *MapSfixed64Uint32) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapSfixed64Uint32.ProtoReflect.Descriptor instead.
+func (*MapSfixed64Uint32) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MapSfixed64Uint32) GetMyField() map[int64]uint32 {
+	if x != nil {
+		return x.MyField
+	}
+	return nil
+}
+
+type MapStringSubmessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MyField map[string]*MapStringSubmessage_Sub `protobuf:"bytes,1,rep,name=my_field,json=myField,proto3" json:"my_field,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *MapStringSubmessage) Reset() {
+	*x = MapStringSubmessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapStringSubmessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapStringSubmessage) ProtoMessage() {}
+
+func (x *MapStringSubmessage) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapStringSubmessage.ProtoReflect.Descriptor instead.
+func (*MapStringSubmessage) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MapStringSubmessage) GetMyField() map[string]*MapStringSubmessage_Sub {
+	if x != nil {
+		return x.MyField
+	}
+	return nil
+}
+
+type MapStringEnum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MyField map[string]MapStringEnum_MyEnum `protobuf:"bytes,1,rep,name=my_field,json=myField,proto3" json:"my_field,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=example.MapStringEnum_MyEnum"`
+}
+
+func (x *MapStringEnum) Reset() {
+	*x = MapStringEnum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapStringEnum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapStringEnum) ProtoMessage() {}
+
+func (x *MapStringEnum) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapStringEnum.ProtoReflect.Descriptor instead.
+func (*MapStringEnum) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MapStringEnum) GetMyField() map[string]MapStringEnum_MyEnum {
+	if x != nil {
+		return x.MyField
+	}
+	return nil
+}
+
+type MapStringUint32Wrapper struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MyField map[string]*wrapperspb.UInt32Value `protobuf:"bytes,1,rep,name=my_field,json=myField,proto3" json:"my_field,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *MapStringUint32Wrapper) Reset() {
+	*x = MapStringUint32Wrapper{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapStringUint32Wrapper) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapStringUint32Wrapper) ProtoMessage() {}
+
+func (x *MapStringUint32Wrapper) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapStringUint32Wrapper.ProtoReflect.Descriptor instead.
+func (*MapStringUint32Wrapper) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MapStringUint32Wrapper) GetMyField() map[string]*wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MyField
+	}
+	return nil
+}
+
+type MapStringStruct struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MyField map[string]*structpb.Struct `protobuf:"bytes,1,rep,name=my_field,json=myField,proto3" json:"my_field,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *MapStringStruct) Reset() {
+	*x = MapStringStruct{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapStringStruct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapStringStruct) ProtoMessage() {}
+
+func (x *MapStringStruct) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapStringStruct.ProtoReflect.Descriptor instead.
+func (*MapStringStruct) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MapStringStruct) GetMyField() map[string]*structpb.Struct {
+	if x != nil {
+		return x.MyField
+	}
+	return nil
+}
+
 type Oneof struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to MyField:
-	//
 	//	*Oneof_Uint32Field
 	//	*Oneof_StringField
 	MyField isOneof_MyField `protobuf_oneof:"my_field"`
@@ -1640,7 +2018,7 @@ type Oneof struct {
 func (x *Oneof) Reset() {
 	*x = Oneof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_proto_msgTypes[31]
+		mi := &file_example_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1653,7 +2031,7 @@ func (x *Oneof) String() string {
 func (*Oneof) ProtoMessage() {}
 
 func (x *Oneof) ProtoReflect() protoreflect.Message {
-	mi := &file_example_proto_msgTypes[31]
+	mi := &file_example_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1666,7 +2044,7 @@ func (x *Oneof) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Oneof.ProtoReflect.Descriptor instead.
 func (*Oneof) Descriptor() ([]byte, []int) {
-	return file_example_proto_rawDescGZIP(), []int{31}
+	return file_example_proto_rawDescGZIP(), []int{38}
 }
 
 func (m *Oneof) GetMyField() isOneof_MyField {
@@ -1711,13 +2089,13 @@ type ImplicitUint32Wrapper struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MyField *wrappers.UInt32Value `protobuf:"bytes,1,opt,name=my_field,json=myField,proto3" json:"my_field,omitempty"`
+	MyField *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=my_field,json=myField,proto3" json:"my_field,omitempty"`
 }
 
 func (x *ImplicitUint32Wrapper) Reset() {
 	*x = ImplicitUint32Wrapper{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_proto_msgTypes[32]
+		mi := &file_example_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1730,7 +2108,7 @@ func (x *ImplicitUint32Wrapper) String() string {
 func (*ImplicitUint32Wrapper) ProtoMessage() {}
 
 func (x *ImplicitUint32Wrapper) ProtoReflect() protoreflect.Message {
-	mi := &file_example_proto_msgTypes[32]
+	mi := &file_example_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1743,10 +2121,10 @@ func (x *ImplicitUint32Wrapper) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImplicitUint32Wrapper.ProtoReflect.Descriptor instead.
 func (*ImplicitUint32Wrapper) Descriptor() ([]byte, []int) {
-	return file_example_proto_rawDescGZIP(), []int{32}
+	return file_example_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ImplicitUint32Wrapper) GetMyField() *wrappers.UInt32Value {
+func (x *ImplicitUint32Wrapper) GetMyField() *wrapperspb.UInt32Value {
 	if x != nil {
 		return x.MyField
 	}
@@ -1764,7 +2142,7 @@ type ImplicitSubmessage_Sub struct {
 func (x *ImplicitSubmessage_Sub) Reset() {
 	*x = ImplicitSubmessage_Sub{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_proto_msgTypes[33]
+		mi := &file_example_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1777,7 +2155,7 @@ func (x *ImplicitSubmessage_Sub) String() string {
 func (*ImplicitSubmessage_Sub) ProtoMessage() {}
 
 func (x *ImplicitSubmessage_Sub) ProtoReflect() protoreflect.Message {
-	mi := &file_example_proto_msgTypes[33]
+	mi := &file_example_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1811,7 +2189,7 @@ type ExplicitSubmessage_Sub struct {
 func (x *ExplicitSubmessage_Sub) Reset() {
 	*x = ExplicitSubmessage_Sub{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_proto_msgTypes[34]
+		mi := &file_example_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1824,7 +2202,7 @@ func (x *ExplicitSubmessage_Sub) String() string {
 func (*ExplicitSubmessage_Sub) ProtoMessage() {}
 
 func (x *ExplicitSubmessage_Sub) ProtoReflect() protoreflect.Message {
-	mi := &file_example_proto_msgTypes[34]
+	mi := &file_example_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1858,7 +2236,7 @@ type RepeatedSubmessage_Sub struct {
 func (x *RepeatedSubmessage_Sub) Reset() {
 	*x = RepeatedSubmessage_Sub{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_proto_msgTypes[35]
+		mi := &file_example_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1871,7 +2249,7 @@ func (x *RepeatedSubmessage_Sub) String() string {
 func (*RepeatedSubmessage_Sub) ProtoMessage() {}
 
 func (x *RepeatedSubmessage_Sub) ProtoReflect() protoreflect.Message {
-	mi := &file_example_proto_msgTypes[35]
+	mi := &file_example_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1894,203 +2272,333 @@ func (x *RepeatedSubmessage_Sub) GetSubmessageField() []uint32 {
 	return nil
 }
 
+type MapStringSubmessage_Sub struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmessageField []uint32 `protobuf:"varint,1,rep,packed,name=submessage_field,json=submessageField,proto3" json:"submessage_field,omitempty"`
+}
+
+func (x *MapStringSubmessage_Sub) Reset() {
+	*x = MapStringSubmessage_Sub{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapStringSubmessage_Sub) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapStringSubmessage_Sub) ProtoMessage() {}
+
+func (x *MapStringSubmessage_Sub) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapStringSubmessage_Sub.ProtoReflect.Descriptor instead.
+func (*MapStringSubmessage_Sub) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{34, 1}
+}
+
+func (x *MapStringSubmessage_Sub) GetSubmessageField() []uint32 {
+	if x != nil {
+		return x.SubmessageField
+	}
+	return nil
+}
+
 var File_example_proto protoreflect.FileDescriptor
 
 var file_example_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
-	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x94, 0x01, 0x0a, 0x0c, 0x49, 0x6d, 0x70,
-	0x6c, 0x69, 0x63, 0x69, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x79, 0x5f,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x45, 0x6e,
-	0x75, 0x6d, 0x2e, 0x4d, 0x79, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x22, 0x4b, 0x0a, 0x06, 0x4d, 0x79, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x17, 0x0a, 0x13,
-	0x4d, 0x59, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x59, 0x5f, 0x45, 0x4e, 0x55, 0x4d,
-	0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x31, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x59,
-	0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x32, 0x10, 0x02, 0x22,
-	0xa6, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x45, 0x6e, 0x75, 0x6d,
-	0x12, 0x3c, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x45, 0x78, 0x70,
-	0x6c, 0x69, 0x63, 0x69, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x4d, 0x79, 0x45, 0x6e, 0x75, 0x6d,
-	0x48, 0x00, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x88, 0x01, 0x01, 0x22, 0x4b,
-	0x0a, 0x06, 0x4d, 0x79, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x59, 0x5f, 0x45,
-	0x4e, 0x55, 0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x59, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x56, 0x41, 0x4c,
-	0x55, 0x45, 0x5f, 0x31, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x59, 0x5f, 0x45, 0x4e, 0x55,
-	0x4d, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x32, 0x10, 0x02, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
-	0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x70,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x79, 0x5f,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e,
-	0x75, 0x6d, 0x2e, 0x4d, 0x79, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x22, 0x4b, 0x0a, 0x06, 0x4d, 0x79, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x17, 0x0a, 0x13,
-	0x4d, 0x59, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x59, 0x5f, 0x45, 0x4e, 0x55, 0x4d,
-	0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x31, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x59,
-	0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x32, 0x10, 0x02, 0x22,
-	0x29, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6c, 0x12,
+	0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x94, 0x01, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6c, 0x69,
+	0x63, 0x69, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x45, 0x6e, 0x75, 0x6d,
+	0x2e, 0x4d, 0x79, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x22, 0x4b, 0x0a, 0x06, 0x4d, 0x79, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x59,
+	0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x59, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x56,
+	0x41, 0x4c, 0x55, 0x45, 0x5f, 0x31, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x59, 0x5f, 0x45,
+	0x4e, 0x55, 0x4d, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x32, 0x10, 0x02, 0x22, 0xa6, 0x01,
+	0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x3c,
+	0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x69,
+	0x63, 0x69, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x4d, 0x79, 0x45, 0x6e, 0x75, 0x6d, 0x48, 0x00,
+	0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x88, 0x01, 0x01, 0x22, 0x4b, 0x0a, 0x06,
+	0x4d, 0x79, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x59, 0x5f, 0x45, 0x4e, 0x55,
+	0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x13, 0x0a, 0x0f, 0x4d, 0x59, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45,
+	0x5f, 0x31, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x59, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f,
+	0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x32, 0x10, 0x02, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x79,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d,
+	0x2e, 0x4d, 0x79, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x22, 0x4b, 0x0a, 0x06, 0x4d, 0x79, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x59,
+	0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x59, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x56,
+	0x41, 0x4c, 0x55, 0x45, 0x5f, 0x31, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x59, 0x5f, 0x45,
+	0x4e, 0x55, 0x4d, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x32, 0x10, 0x02, 0x22, 0x29, 0x0a,
+	0x0c, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x19, 0x0a,
+	0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x08, 0x52,
+	0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2b, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6c,
+	0x69, 0x63, 0x69, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x79,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3d, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69,
+	0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x1e, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x79, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x79, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x22, 0x2b, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x22, 0x2a, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2b, 0x0a,
+	0x0e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12,
 	0x19, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x08, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2b, 0x0a, 0x0e, 0x49, 0x6d,
-	0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x19, 0x0a, 0x08,
-	0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
-	0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3d, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6c, 0x69,
-	0x63, 0x69, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x1e, 0x0a, 0x08, 0x6d, 0x79, 0x5f,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x07, 0x6d,
-	0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x79,
-	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2b, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x22, 0x2a, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x49,
-	0x6e, 0x74, 0x33, 0x32, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22,
-	0x2b, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x74, 0x33,
-	0x32, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x11, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2b, 0x0a, 0x0e,
-	0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x19,
-	0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04,
-	0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2a, 0x0a, 0x0d, 0x52, 0x65, 0x70,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79,
-	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x79,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2b, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x12, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x22, 0x2c, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69,
-	0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x07, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x22, 0x2d, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0f, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22,
-	0x2a, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x6c, 0x6f, 0x61, 0x74,
-	0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x02, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2c, 0x0a, 0x0f, 0x52,
-	0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x19,
-	0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x06,
-	0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2d, 0x0a, 0x10, 0x52, 0x65, 0x70,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x19, 0x0a,
-	0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x10, 0x52,
-	0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2b, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79,
-	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x6d, 0x79,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2a, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x22, 0x2b, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x82,
-	0x01, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x1a, 0x30, 0x0a, 0x03, 0x53, 0x75, 0x62, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x62, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0d, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74,
-	0x53, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x6d, 0x79,
-	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x53,
-	0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x48, 0x00, 0x52,
-	0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x88, 0x01, 0x01, 0x1a, 0x30, 0x0a, 0x03, 0x53,
+	0x11, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2b, 0x0a, 0x0e, 0x52, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x19, 0x0a, 0x08,
+	0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07,
+	0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2a, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x22, 0x2b, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x12, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x22, 0x2c, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x78, 0x65,
+	0x64, 0x33, 0x32, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x07, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2d,
+	0x0a, 0x10, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x33, 0x32, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0f, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2a, 0x0a,
+	0x0d, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x02,
+	0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2c, 0x0a, 0x0f, 0x52, 0x65, 0x70,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x19, 0x0a, 0x08,
+	0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x06, 0x52, 0x07,
+	0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2d, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x19, 0x0a, 0x08, 0x6d,
+	0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x10, 0x52, 0x07, 0x6d,
+	0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2b, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x22, 0x2a, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22,
+	0x2b, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x82, 0x01, 0x0a,
+	0x12, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a,
+	0x30, 0x0a, 0x03, 0x53, 0x75, 0x62, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x62, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x0f, 0x73, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x53, 0x75,
+	0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x53, 0x75, 0x62,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x48, 0x00, 0x52, 0x07, 0x6d,
+	0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x88, 0x01, 0x01, 0x1a, 0x30, 0x0a, 0x03, 0x53, 0x75, 0x62,
+	0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x70,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x3a, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x53,
+	0x75, 0x62, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x30, 0x0a, 0x03, 0x53,
 	0x75, 0x62, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x75,
-	0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x0b, 0x0a,
-	0x09, 0x5f, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x52,
-	0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65,
-	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x2e, 0x53, 0x75, 0x62, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x30, 0x0a,
-	0x03, 0x53, 0x75, 0x62, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0f,
-	0x73, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22,
-	0x8f, 0x01, 0x0a, 0x0f, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x55, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x2e,
-	0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x79,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x91, 0x01, 0x0a, 0x10, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46,
-	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x41, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x69, 0x78, 0x65,
-	0x64, 0x33, 0x32, 0x2e, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x79, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x07, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x91, 0x01, 0x0a, 0x10, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x41, 0x0a, 0x08, 0x6d, 0x79,
-	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x2e, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x3a, 0x0a,
-	0x0c, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x4d, 0x61,
-	0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x40, 0x0a,
-	0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a,
-	0x3a, 0x0a, 0x0c, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x91, 0x01, 0x0a, 0x10,
-	0x4d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x12, 0x41, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4d, 0x61, 0x70,
-	0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x2e, 0x4d, 0x79,
+	0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x8f, 0x01,
+	0x0a, 0x0f, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x55, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4d, 0x61,
+	0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x2e, 0x4d, 0x79,
 	0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69,
 	0x65, 0x6c, 0x64, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x07,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
 	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x91, 0x01, 0x0a, 0x10, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x55, 0x69,
-	0x6e, 0x74, 0x33, 0x32, 0x12, 0x41, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x91, 0x01, 0x0a, 0x10, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x12, 0x41, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
 	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x55, 0x69, 0x6e, 0x74, 0x33,
+	0x2e, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33,
 	0x32, 0x2e, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
 	0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x79, 0x46, 0x69, 0x65,
 	0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x42, 0x6f, 0x6f, 0x6c, 0x55,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x3e, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x4d, 0x61, 0x70, 0x42, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x2e,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x07, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x91, 0x01, 0x0a, 0x10, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x41, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x36, 0x34, 0x2e, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d,
+	0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x4d, 0x61, 0x70, 0x55,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x40, 0x0a, 0x08, 0x6d,
+	0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x3a, 0x0a,
+	0x0c, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x91, 0x01, 0x0a, 0x10, 0x4d, 0x61,
+	0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x41,
+	0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x2e, 0x4d, 0x79, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x07, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x91, 0x01,
+	0x0a, 0x10, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x55, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x12, 0x41, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4d,
+	0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x2e,
+	0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x79,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x06, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x42, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x12, 0x3e, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x4d, 0x61, 0x70, 0x42, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x2e, 0x4d, 0x79,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x8f, 0x01, 0x0a, 0x0f, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x2e,
+	0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x79,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x8d, 0x01, 0x0a, 0x0e, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x55, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x12, 0x3f, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x2e,
 	0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x79,
 	0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
 	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55,
+	0x01, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x4d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x55,
 	0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c,
 	0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x69, 0x6e, 0x74, 0x33,
+	0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x55, 0x69, 0x6e, 0x74, 0x33,
 	0x32, 0x2e, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
 	0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x79, 0x46, 0x69, 0x65,
 	0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x20, 0x01, 0x28, 0x12, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
 	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x5d, 0x0a, 0x05, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x23, 0x0a, 0x0c,
-	0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x48, 0x00, 0x52, 0x0b, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x22, 0x50, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x55, 0x69,
-	0x6e, 0x74, 0x33, 0x32, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x08, 0x6d,
-	0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6d, 0x79, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x02, 0x38, 0x01, 0x22, 0x93, 0x01, 0x0a, 0x11, 0x4d, 0x61, 0x70, 0x53, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x42, 0x0a, 0x08, 0x6d, 0x79, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x2e, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x3a, 0x0a,
+	0x0c, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x10, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xeb, 0x01, 0x0a, 0x13, 0x4d, 0x61,
+	0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x44, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4d, 0x61,
+	0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x5c, 0x0a, 0x0c, 0x4d, 0x79, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x30, 0x0a, 0x03, 0x53, 0x75, 0x62, 0x12, 0x29, 0x0a, 0x10,
+	0x73, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xf7, 0x01, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x3e, 0x0a, 0x08, 0x6d, 0x79, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45,
+	0x6e, 0x75, 0x6d, 0x2e, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x59, 0x0a, 0x0c, 0x4d, 0x79, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e,
+	0x75, 0x6d, 0x2e, 0x4d, 0x79, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x4b, 0x0a, 0x06, 0x4d, 0x79, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x17,
+	0x0a, 0x13, 0x4d, 0x59, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x59, 0x5f, 0x45, 0x4e,
+	0x55, 0x4d, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x31, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
+	0x4d, 0x59, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x32, 0x10,
+	0x02, 0x22, 0xbb, 0x01, 0x0a, 0x16, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x08,
+	0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2e,
+	0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x79,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x58, 0x0a, 0x0c, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xa8, 0x01, 0x0a, 0x0f, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e,
+	0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x79,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x53, 0x0a, 0x0c, 0x4d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5d, 0x0a, 0x05, 0x4f, 0x6e,
+	0x65, 0x6f, 0x66, 0x12, 0x23, 0x0a, 0x0c, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0b, 0x75, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x0a, 0x0a,
+	0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x50, 0x0a, 0x15, 0x49, 0x6d, 0x70,
+	0x6c, 0x69, 0x63, 0x69, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x57, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x07, 0x6d, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x0d, 0x5a, 0x0b, 0x2e,
+	0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -2105,79 +2613,107 @@ func file_example_proto_rawDescGZIP() []byte {
 	return file_example_proto_rawDescData
 }
 
-var file_example_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_example_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
-var file_example_proto_goTypes = []interface{}{
-	(ImplicitEnum_MyEnum)(0),       // 0: example.ImplicitEnum.MyEnum
-	(ExplicitEnum_MyEnum)(0),       // 1: example.ExplicitEnum.MyEnum
-	(RepeatedEnum_MyEnum)(0),       // 2: example.RepeatedEnum.MyEnum
-	(*ImplicitEnum)(nil),           // 3: example.ImplicitEnum
-	(*ExplicitEnum)(nil),           // 4: example.ExplicitEnum
-	(*RepeatedEnum)(nil),           // 5: example.RepeatedEnum
-	(*RepeatedBool)(nil),           // 6: example.RepeatedBool
-	(*ImplicitUint32)(nil),         // 7: example.ImplicitUint32
-	(*ExplicitUint32)(nil),         // 8: example.ExplicitUint32
-	(*RepeatedUint32)(nil),         // 9: example.RepeatedUint32
-	(*RepeatedInt32)(nil),          // 10: example.RepeatedInt32
-	(*RepeatedSint32)(nil),         // 11: example.RepeatedSint32
-	(*RepeatedUint64)(nil),         // 12: example.RepeatedUint64
-	(*RepeatedInt64)(nil),          // 13: example.RepeatedInt64
-	(*RepeatedSint64)(nil),         // 14: example.RepeatedSint64
-	(*RepeatedFixed32)(nil),        // 15: example.RepeatedFixed32
-	(*RepeatedSfixed32)(nil),       // 16: example.RepeatedSfixed32
-	(*RepeatedFloat)(nil),          // 17: example.RepeatedFloat
-	(*RepeatedFixed64)(nil),        // 18: example.RepeatedFixed64
-	(*RepeatedSfixed64)(nil),       // 19: example.RepeatedSfixed64
-	(*RepeatedDouble)(nil),         // 20: example.RepeatedDouble
-	(*RepeatedBytes)(nil),          // 21: example.RepeatedBytes
-	(*RepeatedString)(nil),         // 22: example.RepeatedString
-	(*ImplicitSubmessage)(nil),     // 23: example.ImplicitSubmessage
-	(*ExplicitSubmessage)(nil),     // 24: example.ExplicitSubmessage
-	(*RepeatedSubmessage)(nil),     // 25: example.RepeatedSubmessage
-	(*MapUint32Uint32)(nil),        // 26: example.MapUint32Uint32
-	(*MapUint32Fixed32)(nil),       // 27: example.MapUint32Fixed32
-	(*MapUint32Fixed64)(nil),       // 28: example.MapUint32Fixed64
-	(*MapUint32String)(nil),        // 29: example.MapUint32String
-	(*MapFixed32Uint32)(nil),       // 30: example.MapFixed32Uint32
-	(*MapFixed64Uint32)(nil),       // 31: example.MapFixed64Uint32
-	(*MapBoolUint32)(nil),          // 32: example.MapBoolUint32
-	(*MapStringUint32)(nil),        // 33: example.MapStringUint32
-	(*Oneof)(nil),                  // 34: example.Oneof
-	(*ImplicitUint32Wrapper)(nil),  // 35: example.ImplicitUint32Wrapper
-	(*ImplicitSubmessage_Sub)(nil), // 36: example.ImplicitSubmessage.Sub
-	(*ExplicitSubmessage_Sub)(nil), // 37: example.ExplicitSubmessage.Sub
-	(*RepeatedSubmessage_Sub)(nil), // 38: example.RepeatedSubmessage.Sub
-	nil,                            // 39: example.MapUint32Uint32.MyFieldEntry
-	nil,                            // 40: example.MapUint32Fixed32.MyFieldEntry
-	nil,                            // 41: example.MapUint32Fixed64.MyFieldEntry
-	nil,                            // 42: example.MapUint32String.MyFieldEntry
-	nil,                            // 43: example.MapFixed32Uint32.MyFieldEntry
-	nil,                            // 44: example.MapFixed64Uint32.MyFieldEntry
-	nil,                            // 45: example.MapBoolUint32.MyFieldEntry
-	nil,                            // 46: example.MapStringUint32.MyFieldEntry
-	(*wrappers.UInt32Value)(nil),   // 47: google.protobuf.UInt32Value
+var file_example_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_example_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_example_proto_goTypes = []any{
+	(ImplicitEnum_MyEnum)(0),        // 0: example.ImplicitEnum.MyEnum
+	(ExplicitEnum_MyEnum)(0),        // 1: example.ExplicitEnum.MyEnum
+	(RepeatedEnum_MyEnum)(0),        // 2: example.RepeatedEnum.MyEnum
+	(MapStringEnum_MyEnum)(0),       // 3: example.MapStringEnum.MyEnum
+	(*ImplicitEnum)(nil),            // 4: example.ImplicitEnum
+	(*ExplicitEnum)(nil),            // 5: example.ExplicitEnum
+	(*RepeatedEnum)(nil),            // 6: example.RepeatedEnum
+	(*RepeatedBool)(nil),            // 7: example.RepeatedBool
+	(*ImplicitUint32)(nil),          // 8: example.ImplicitUint32
+	(*ExplicitUint32)(nil),          // 9: example.ExplicitUint32
+	(*RepeatedUint32)(nil),          // 10: example.RepeatedUint32
+	(*RepeatedInt32)(nil),           // 11: example.RepeatedInt32
+	(*RepeatedSint32)(nil),          // 12: example.RepeatedSint32
+	(*RepeatedUint64)(nil),          // 13: example.RepeatedUint64
+	(*RepeatedInt64)(nil),           // 14: example.RepeatedInt64
+	(*RepeatedSint64)(nil),          // 15: example.RepeatedSint64
+	(*RepeatedFixed32)(nil),         // 16: example.RepeatedFixed32
+	(*RepeatedSfixed32)(nil),        // 17: example.RepeatedSfixed32
+	(*RepeatedFloat)(nil),           // 18: example.RepeatedFloat
+	(*RepeatedFixed64)(nil),         // 19: example.RepeatedFixed64
+	(*RepeatedSfixed64)(nil),        // 20: example.RepeatedSfixed64
+	(*RepeatedDouble)(nil),          // 21: example.RepeatedDouble
+	(*RepeatedBytes)(nil),           // 22: example.RepeatedBytes
+	(*RepeatedString)(nil),          // 23: example.RepeatedString
+	(*ImplicitSubmessage)(nil),      // 24: example.ImplicitSubmessage
+	(*ExplicitSubmessage)(nil),      // 25: example.ExplicitSubmessage
+	(*RepeatedSubmessage)(nil),      // 26: example.RepeatedSubmessage
+	(*MapUint32Uint32)(nil),         // 27: example.MapUint32Uint32
+	(*MapUint32Fixed32)(nil),        // 28: example.MapUint32Fixed32
+	(*MapUint32Fixed64)(nil),        // 29: example.MapUint32Fixed64
+	(*MapUint32String)(nil),         // 30: example.MapUint32String
+	(*MapFixed32Uint32)(nil),        // 31: example.MapFixed32Uint32
+	(*MapFixed64Uint32)(nil),        // 32: example.MapFixed64Uint32
+	(*MapBoolUint32)(nil),           // 33: example.MapBoolUint32
+	(*MapStringUint32)(nil),         // 34: example.MapStringUint32
+	(*MapInt64Uint32)(nil),          // 35: example.MapInt64Uint32
+	(*MapSint64Uint32)(nil),         // 36: example.MapSint64Uint32
+	(*MapSfixed64Uint32)(nil),       // 37: example.MapSfixed64Uint32
+	(*MapStringSubmessage)(nil),     // 38: example.MapStringSubmessage
+	(*MapStringEnum)(nil),           // 39: example.MapStringEnum
+	(*MapStringUint32Wrapper)(nil),  // 40: example.MapStringUint32Wrapper
+	(*MapStringStruct)(nil),         // 41: example.MapStringStruct
+	(*Oneof)(nil),                   // 42: example.Oneof
+	(*ImplicitUint32Wrapper)(nil),   // 43: example.ImplicitUint32Wrapper
+	(*ImplicitSubmessage_Sub)(nil),  // 44: example.ImplicitSubmessage.Sub
+	(*ExplicitSubmessage_Sub)(nil),  // 45: example.ExplicitSubmessage.Sub
+	(*RepeatedSubmessage_Sub)(nil),  // 46: example.RepeatedSubmessage.Sub
+	nil,                             // 47: example.MapUint32Uint32.MyFieldEntry
+	nil,                             // 48: example.MapUint32Fixed32.MyFieldEntry
+	nil,                             // 49: example.MapUint32Fixed64.MyFieldEntry
+	nil,                             // 50: example.MapUint32String.MyFieldEntry
+	nil,                             // 51: example.MapFixed32Uint32.MyFieldEntry
+	nil,                             // 52: example.MapFixed64Uint32.MyFieldEntry
+	nil,                             // 53: example.MapBoolUint32.MyFieldEntry
+	nil,                             // 54: example.MapStringUint32.MyFieldEntry
+	nil,                             // 55: example.MapInt64Uint32.MyFieldEntry
+	nil,                             // 56: example.MapSint64Uint32.MyFieldEntry
+	nil,                             // 57: example.MapSfixed64Uint32.MyFieldEntry
+	nil,                             // 58: example.MapStringSubmessage.MyFieldEntry
+	(*MapStringSubmessage_Sub)(nil), // 59: example.MapStringSubmessage.Sub
+	nil,                             // 60: example.MapStringEnum.MyFieldEntry
+	nil,                             // 61: example.MapStringUint32Wrapper.MyFieldEntry
+	nil,                             // 62: example.MapStringStruct.MyFieldEntry
+	(*wrapperspb.UInt32Value)(nil),  // 63: google.protobuf.UInt32Value
+	(*structpb.Struct)(nil),         // 64: google.protobuf.Struct
 }
 var file_example_proto_depIdxs = []int32{
 	0,  // 0: example.ImplicitEnum.my_field:type_name -> example.ImplicitEnum.MyEnum
 	1,  // 1: example.ExplicitEnum.my_field:type_name -> example.ExplicitEnum.MyEnum
 	2,  // 2: example.RepeatedEnum.my_field:type_name -> example.RepeatedEnum.MyEnum
-	36, // 3: example.ImplicitSubmessage.my_field:type_name -> example.ImplicitSubmessage.Sub
-	37, // 4: example.ExplicitSubmessage.my_field:type_name -> example.ExplicitSubmessage.Sub
-	38, // 5: example.RepeatedSubmessage.my_field:type_name -> example.RepeatedSubmessage.Sub
-	39, // 6: example.MapUint32Uint32.my_field:type_name -> example.MapUint32Uint32.MyFieldEntry
-	40, // 7: example.MapUint32Fixed32.my_field:type_name -> example.MapUint32Fixed32.MyFieldEntry
-	41, // 8: example.MapUint32Fixed64.my_field:type_name -> example.MapUint32Fixed64.MyFieldEntry
-	42, // 9: example.MapUint32String.my_field:type_name -> example.MapUint32String.MyFieldEntry
-	43, // 10: example.MapFixed32Uint32.my_field:type_name -> example.MapFixed32Uint32.MyFieldEntry
-	44, // 11: example.MapFixed64Uint32.my_field:type_name -> example.MapFixed64Uint32.MyFieldEntry
-	45, // 12: example.MapBoolUint32.my_field:type_name -> example.MapBoolUint32.MyFieldEntry
-	46, // 13: example.MapStringUint32.my_field:type_name -> example.MapStringUint32.MyFieldEntry
-	47, // 14: example.ImplicitUint32Wrapper.my_field:type_name -> google.protobuf.UInt32Value
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	44, // 3: example.ImplicitSubmessage.my_field:type_name -> example.ImplicitSubmessage.Sub
+	45, // 4: example.ExplicitSubmessage.my_field:type_name -> example.ExplicitSubmessage.Sub
+	46, // 5: example.RepeatedSubmessage.my_field:type_name -> example.RepeatedSubmessage.Sub
+	47, // 6: example.MapUint32Uint32.my_field:type_name -> example.MapUint32Uint32.MyFieldEntry
+	48, // 7: example.MapUint32Fixed32.my_field:type_name -> example.MapUint32Fixed32.MyFieldEntry
+	49, // 8: example.MapUint32Fixed64.my_field:type_name -> example.MapUint32Fixed64.MyFieldEntry
+	50, // 9: example.MapUint32String.my_field:type_name -> example.MapUint32String.MyFieldEntry
+	51, // 10: example.MapFixed32Uint32.my_field:type_name -> example.MapFixed32Uint32.MyFieldEntry
+	52, // 11: example.MapFixed64Uint32.my_field:type_name -> example.MapFixed64Uint32.MyFieldEntry
+	53, // 12: example.MapBoolUint32.my_field:type_name -> example.MapBoolUint32.MyFieldEntry
+	54, // 13: example.MapStringUint32.my_field:type_name -> example.MapStringUint32.MyFieldEntry
+	55, // 14: example.MapInt64Uint32.my_field:type_name -> example.MapInt64Uint32.MyFieldEntry
+	56, // 15: example.MapSint64Uint32.my_field:type_name -> example.MapSint64Uint32.MyFieldEntry
+	57, // 16: example.MapSfixed64Uint32.my_field:type_name -> example.MapSfixed64Uint32.MyFieldEntry
+	58, // 17: example.MapStringSubmessage.my_field:type_name -> example.MapStringSubmessage.MyFieldEntry
+	60, // 18: example.MapStringEnum.my_field:type_name -> example.MapStringEnum.MyFieldEntry
+	61, // 19: example.MapStringUint32Wrapper.my_field:type_name -> example.MapStringUint32Wrapper.MyFieldEntry
+	62, // 20: example.MapStringStruct.my_field:type_name -> example.MapStringStruct.MyFieldEntry
+	63, // 21: example.ImplicitUint32Wrapper.my_field:type_name -> google.protobuf.UInt32Value
+	59, // 22: example.MapStringSubmessage.MyFieldEntry.value:type_name -> example.MapStringSubmessage.Sub
+	3,  // 23: example.MapStringEnum.MyFieldEntry.value:type_name -> example.MapStringEnum.MyEnum
+	63, // 24: example.MapStringUint32Wrapper.MyFieldEntry.value:type_name -> google.protobuf.UInt32Value
+	64, // 25: example.MapStringStruct.MyFieldEntry.value:type_name -> google.protobuf.Struct
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_example_proto_init() }
@@ -2186,7 +2722,7 @@ func file_example_proto_init() {
 		return
 	}
 	if !protoimpl.UnsafeEnabled {
-		file_example_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[0].Exporter = func(v any, i int) any {
 			switch v := v.(*ImplicitEnum); i {
 			case 0:
 				return &v.state
@@ -2198,7 +2734,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[1].Exporter = func(v any, i int) any {
 			switch v := v.(*ExplicitEnum); i {
 			case 0:
 				return &v.state
@@ -2210,7 +2746,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[2].Exporter = func(v any, i int) any {
 			switch v := v.(*RepeatedEnum); i {
 			case 0:
 				return &v.state
@@ -2222,7 +2758,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[3].Exporter = func(v any, i int) any {
 			switch v := v.(*RepeatedBool); i {
 			case 0:
 				return &v.state
@@ -2234,7 +2770,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[4].Exporter = func(v any, i int) any {
 			switch v := v.(*ImplicitUint32); i {
 			case 0:
 				return &v.state
@@ -2246,7 +2782,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[5].Exporter = func(v any, i int) any {
 			switch v := v.(*ExplicitUint32); i {
 			case 0:
 				return &v.state
@@ -2258,7 +2794,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[6].Exporter = func(v any, i int) any {
 			switch v := v.(*RepeatedUint32); i {
 			case 0:
 				return &v.state
@@ -2270,7 +2806,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[7].Exporter = func(v any, i int) any {
 			switch v := v.(*RepeatedInt32); i {
 			case 0:
 				return &v.state
@@ -2282,7 +2818,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[8].Exporter = func(v any, i int) any {
 			switch v := v.(*RepeatedSint32); i {
 			case 0:
 				return &v.state
@@ -2294,7 +2830,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[9].Exporter = func(v any, i int) any {
 			switch v := v.(*RepeatedUint64); i {
 			case 0:
 				return &v.state
@@ -2306,7 +2842,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[10].Exporter = func(v any, i int) any {
 			switch v := v.(*RepeatedInt64); i {
 			case 0:
 				return &v.state
@@ -2318,7 +2854,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[11].Exporter = func(v any, i int) any {
 			switch v := v.(*RepeatedSint64); i {
 			case 0:
 				return &v.state
@@ -2330,7 +2866,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[12].Exporter = func(v any, i int) any {
 			switch v := v.(*RepeatedFixed32); i {
 			case 0:
 				return &v.state
@@ -2342,7 +2878,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[13].Exporter = func(v any, i int) any {
 			switch v := v.(*RepeatedSfixed32); i {
 			case 0:
 				return &v.state
@@ -2354,7 +2890,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[14].Exporter = func(v any, i int) any {
 			switch v := v.(*RepeatedFloat); i {
 			case 0:
 				return &v.state
@@ -2366,7 +2902,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[15].Exporter = func(v any, i int) any {
 			switch v := v.(*RepeatedFixed64); i {
 			case 0:
 				return &v.state
@@ -2378,7 +2914,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[16].Exporter = func(v any, i int) any {
 			switch v := v.(*RepeatedSfixed64); i {
 			case 0:
 				return &v.state
@@ -2390,7 +2926,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[17].Exporter = func(v any, i int) any {
 			switch v := v.(*RepeatedDouble); i {
 			case 0:
 				return &v.state
@@ -2402,7 +2938,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[18].Exporter = func(v any, i int) any {
 			switch v := v.(*RepeatedBytes); i {
 			case 0:
 				return &v.state
@@ -2414,7 +2950,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[19].Exporter = func(v any, i int) any {
 			switch v := v.(*RepeatedString); i {
 			case 0:
 				return &v.state
@@ -2426,7 +2962,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[20].Exporter = func(v any, i int) any {
 			switch v := v.(*ImplicitSubmessage); i {
 			case 0:
 				return &v.state
@@ -2438,7 +2974,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[21].Exporter = func(v any, i int) any {
 			switch v := v.(*ExplicitSubmessage); i {
 			case 0:
 				return &v.state
@@ -2450,7 +2986,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[22].Exporter = func(v any, i int) any {
 			switch v := v.(*RepeatedSubmessage); i {
 			case 0:
 				return &v.state
@@ -2462,7 +2998,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[23].Exporter = func(v any, i int) any {
 			switch v := v.(*MapUint32Uint32); i {
 			case 0:
 				return &v.state
@@ -2474,7 +3010,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[24].Exporter = func(v any, i int) any {
 			switch v := v.(*MapUint32Fixed32); i {
 			case 0:
 				return &v.state
@@ -2486,7 +3022,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[25].Exporter = func(v any, i int) any {
 			switch v := v.(*MapUint32Fixed64); i {
 			case 0:
 				return &v.state
@@ -2498,7 +3034,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[26].Exporter = func(v any, i int) any {
 			switch v := v.(*MapUint32String); i {
 			case 0:
 				return &v.state
@@ -2510,7 +3046,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[27].Exporter = func(v any, i int) any {
 			switch v := v.(*MapFixed32Uint32); i {
 			case 0:
 				return &v.state
@@ -2522,7 +3058,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[28].Exporter = func(v any, i int) any {
 			switch v := v.(*MapFixed64Uint32); i {
 			case 0:
 				return &v.state
@@ -2534,7 +3070,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[29].Exporter = func(v any, i int) any {
 			switch v := v.(*MapBoolUint32); i {
 			case 0:
 				return &v.state
@@ -2546,7 +3082,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[30].Exporter = func(v any, i int) any {
 			switch v := v.(*MapStringUint32); i {
 			case 0:
 				return &v.state
@@ -2558,7 +3094,91 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*MapInt64Uint32); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*MapSint64Uint32); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*MapSfixed64Uint32); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*MapStringSubmessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*MapStringEnum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*MapStringUint32Wrapper); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*MapStringStruct); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example_proto_msgTypes[38].Exporter = func(v any, i int) any {
 			switch v := v.(*Oneof); i {
 			case 0:
 				return &v.state
@@ -2570,7 +3190,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[39].Exporter = func(v any, i int) any {
 			switch v := v.(*ImplicitUint32Wrapper); i {
 			case 0:
 				return &v.state
@@ -2582,7 +3202,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[40].Exporter = func(v any, i int) any {
 			switch v := v.(*ImplicitSubmessage_Sub); i {
 			case 0:
 				return &v.state
@@ -2594,7 +3214,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[41].Exporter = func(v any, i int) any {
 			switch v := v.(*ExplicitSubmessage_Sub); i {
 			case 0:
 				return &v.state
@@ -2606,7 +3226,7 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
-		file_example_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_example_proto_msgTypes[42].Exporter = func(v any, i int) any {
 			switch v := v.(*RepeatedSubmessage_Sub); i {
 			case 0:
 				return &v.state
@@ -2618,11 +3238,23 @@ func file_example_proto_init() {
 				return nil
 			}
 		}
+		file_example_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*MapStringSubmessage_Sub); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_example_proto_msgTypes[1].OneofWrappers = []interface{}{}
-	file_example_proto_msgTypes[5].OneofWrappers = []interface{}{}
-	file_example_proto_msgTypes[21].OneofWrappers = []interface{}{}
-	file_example_proto_msgTypes[31].OneofWrappers = []interface{}{
+	file_example_proto_msgTypes[1].OneofWrappers = []any{}
+	file_example_proto_msgTypes[5].OneofWrappers = []any{}
+	file_example_proto_msgTypes[21].OneofWrappers = []any{}
+	file_example_proto_msgTypes[38].OneofWrappers = []any{
 		(*Oneof_Uint32Field)(nil),
 		(*Oneof_StringField)(nil),
 	}
@@ -2631,8 +3263,8 @@ func file_example_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_example_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   44,
+			NumEnums:      4,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,139 @@
+// Package fixturegen compiles the .proto fixtures of the baseline without
+// protoc.
+//
+// The fixtures are parsed with github.com/bufbuild/protocompile, a .proto
+// compiler in pure Go, and the Go code is generated by protoc-gen-go, run
+// with go run as a plugin. Thus the outputs only depend on the versions in
+// go.mod.
+package fixturegen
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/bufbuild/protocompile"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// Files lists the fixtures, relative to the baseline directory.
+var Files = []string{
+	"example.proto",
+	"example2.proto",
+	"example3.proto",
+	"conformance/test_messages_proto2.proto",
+	"conformance/test_messages_proto3.proto",
+}
+
+// DescriptorSet is the path of the FileDescriptorSet of the fixtures,
+// including their imports as with protoc --include_imports.
+const DescriptorSet = "testdata/descriptors.pb"
+
+// Generate compiles the fixtures in dir and returns the contents of the
+// generated files, keyed by their paths relative to dir.
+func Generate(dir string) (map[string][]byte, error) {
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			ImportPaths: []string{dir},
+		}),
+		// protoc-gen-go copies the comments into the Go code.
+		SourceInfoMode: protocompile.SourceInfoStandard,
+	}
+	compiled, err := compiler.Compile(context.Background(), Files...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Order the files so that each comes after its imports, as protoc does.
+	var files []*descriptorpb.FileDescriptorProto
+	seen := map[string]bool{}
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		files = append(files, protodesc.ToFileDescriptorProto(fd))
+	}
+	for _, fd := range compiled {
+		add(fd)
+	}
+
+	outputs := map[string][]byte{}
+	if err := generateGo(dir, files, outputs); err != nil {
+		return nil, err
+	}
+
+	set := &descriptorpb.FileDescriptorSet{}
+	for _, file := range files {
+		file = proto.Clone(file).(*descriptorpb.FileDescriptorProto)
+		// protoc leaves out the source info unless --include_source_info.
+		file.SourceCodeInfo = nil
+		set.File = append(set.File, file)
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(set)
+	if err != nil {
+		return nil, err
+	}
+	outputs[DescriptorSet] = data
+	return outputs, nil
+}
+
+// generateGo runs protoc-gen-go on the fixtures. Its code generator is
+// internal to protobuf-go, with no compatibility promise, so it is run as a
+// plugin through the stable plugin protocol, at the version in go.mod.
+func generateGo(dir string, files []*descriptorpb.FileDescriptorProto, outputs map[string][]byte) error {
+	req, err := proto.Marshal(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: Files,
+		ProtoFile:      files,
+	})
+	if err != nil {
+		return err
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("go", "run", "google.golang.org/protobuf/cmd/protoc-gen-go")
+	cmd.Dir = dir
+	cmd.Stdin = bytes.NewReader(req)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("protoc-gen-go: %w\n%s", err, stderr.Bytes())
+	}
+	resp := &pluginpb.CodeGeneratorResponse{}
+	if err := proto.Unmarshal(stdout.Bytes(), resp); err != nil {
+		return fmt.Errorf("protoc-gen-go: %w", err)
+	}
+	if resp.Error != nil {
+		return errors.New("protoc-gen-go: " + resp.GetError())
+	}
+	for _, f := range resp.File {
+		outputs[filepath.FromSlash(f.GetName())] = []byte(f.GetContent())
+	}
+	return nil
+}
+
+// Write writes the generated files into dir.
+func Write(dir string, outputs map[string][]byte) error {
+	for name, data := range outputs {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package fixturegen_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/qnighy/bqpb/baseline/bqpbdesc"
+	"github.com/qnighy/bqpb/baseline/conformance/proto2pb"
+	"github.com/qnighy/bqpb/baseline/conformance/proto3pb"
+	"github.com/qnighy/bqpb/baseline/example2pb"
+	"github.com/qnighy/bqpb/baseline/example3pb"
+	"github.com/qnighy/bqpb/baseline/examplepb"
+	"github.com/qnighy/bqpb/baseline/fixturegen"
+)
+
+func TestGenerate(t *testing.T) {
+	outputs, err := fixturegen.Generate("..")
+	if err != nil {
+		t.Fatalf("Generate error: %v\n", err)
+	}
+	for name, got := range outputs {
+		want, err := os.ReadFile(filepath.Join("..", name))
+		if err != nil {
+			t.Errorf("%s is missing; run go generate: %v", name, err)
+			continue
+		}
+		if diff := cmp.Diff(string(want), string(got)); diff != "" {
+			t.Errorf("%s is stale; run go generate (-want +got):\n%s", name, diff)
+		}
+	}
+	if _, ok := outputs[fixturegen.DescriptorSet]; !ok {
+		t.Errorf("%s is not generated", fixturegen.DescriptorSet)
+	}
+}
+
+// TestDescriptorSet checks that the descriptor set describes the same files
+// as the generated Go packages.
+func TestDescriptorSet(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", fixturegen.DescriptorSet))
+	if err != nil {
+		t.Fatalf("ReadFile error: %v\n", err)
+	}
+	files, err := bqpbdesc.LoadDescriptorSet(data)
+	if err != nil {
+		t.Fatalf("LoadDescriptorSet error: %v\n", err)
+	}
+	for _, want := range []protoreflect.FileDescriptor{
+		examplepb.File_example_proto,
+		example2pb.File_example2_proto,
+		example3pb.File_example3_proto,
+		proto2pb.File_conformance_test_messages_proto2_proto,
+		proto3pb.File_conformance_test_messages_proto3_proto,
+	} {
+		got, err := files.FindFileByPath(want.Path())
+		if err != nil {
+			t.Errorf("FindFileByPath error: %v", err)
+			continue
+		}
+		if diff := cmp.Diff(protodesc.ToFileDescriptorProto(want), protodesc.ToFileDescriptorProto(got), protocmp.Transform()); diff != "" {
+			t.Errorf("%s mismatch (-want +got):\n%s", want.Path(), diff)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+// Command gen regenerates the Go packages and the descriptor set of the
+// .proto fixtures. Run it through go generate in the baseline directory.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/qnighy/bqpb/baseline/fixturegen"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "baseline directory")
+	flag.Parse()
+
+	outputs, err := fixturegen.Generate(*dir)
+	if err == nil {
+		err = fixturegen.Write(*dir, outputs)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "gen: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -1,2 +0,0 @@
-#!/bin/sh
-PATH="$(pwd)/bin:$PATH" protoc --experimental_allow_proto3_optional -I=. --go_out=. example.proto example2.proto
@@ -1,9 +1,11 @@
 module github.com/qnighy/bqpb/baseline
 
-go 1.19
+go 1.21
 
 require (
-	github.com/golang/protobuf v1.5.0
-	github.com/google/go-cmp v0.5.5
-	google.golang.org/protobuf v1.31.0
+	github.com/bufbuild/protocompile v0.14.1
+	github.com/google/go-cmp v0.6.0
+	google.golang.org/protobuf v1.34.2
 )
+
+require golang.org/x/sync v0.8.0 // indirect
@@ -0,0 +1,209 @@
+package baseline_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/qnighy/bqpb/baseline/bqjson"
+	"github.com/qnighy/bqpb/baseline/bqpb"
+)
+
+// features are the groups of the compatibility report, in order.
+var features = []string{
+	"presence",
+	"repeated fields",
+	"floats",
+	"oneof",
+	"maps",
+	"groups",
+	"well-known types",
+	"unknown fields",
+}
+
+// caseFeature returns the feature a serialization case is about, judging
+// from its name and message type.
+func caseFeature(tc serializationTestCase) string {
+	md := tc.datatype.ProtoReflect().Descriptor()
+	name := string(md.Name())
+	switch {
+	case strings.Contains(tc.name, "unknown"):
+		return "unknown fields"
+	case strings.HasPrefix(name, "Map"):
+		return "maps"
+	case md.ParentFile().Package() == "google.protobuf" || strings.HasSuffix(name, "Wrapper"):
+		return "well-known types"
+	case strings.Contains(name, "Oneof"):
+		return "oneof"
+	case md.ParentFile().Package() == "example2":
+		return "groups"
+	case strings.HasPrefix(name, "Explicit") || strings.HasPrefix(name, "Implicit"):
+		return "presence"
+	case name == "RepeatedFloat" || name == "RepeatedDouble":
+		return "floats"
+	}
+	return "repeated fields"
+}
+
+// Statuses in the compatibility report.
+const (
+	// statusSame means the outputs agree up to the systematic differences
+	// absorbed by canonicalJSON, and are stored as the same BigQuery JSON.
+	statusSame = "same"
+	// statusBothFail means both protojson and bqpb fail.
+	statusBothFail = "both fail"
+	// statusIntended means the difference is recorded as a deviation.
+	statusIntended = "intended"
+	// statusUnclassified means the difference is not explained anywhere.
+	statusUnclassified = "unclassified"
+)
+
+type matrixEntry struct {
+	Name    string `json:"name"`
+	Feature string `json:"feature"`
+	Status  string `json:"status"`
+	// BigQuery is whether the outputs still differ once stored as BigQuery
+	// JSON.
+	BigQuery  bool   `json:"bigquery"`
+	Protojson string `json:"protojson"`
+	Bqpb      string `json:"bqpb"`
+	Reason    string `json:"reason,omitempty"`
+	Spec      string `json:"spec,omitempty"`
+}
+
+// TestCompatibilityMatrix runs every serialization case through protojson
+// and bqpb, and writes testdata/compat.md and testdata/compat.json, which
+// group the outcomes by feature. It fails on differences that are not
+// recorded as deviations.
+func TestCompatibilityMatrix(t *testing.T) {
+	var entries []*matrixEntry
+	for _, tc := range serializationTestCases() {
+		md := tc.datatype.ProtoReflect().Descriptor()
+		e := &matrixEntry{
+			Name:      tc.name,
+			Feature:   caseFeature(tc),
+			Protojson: tc.want,
+		}
+		if tc.wantErr != "" {
+			e.Protojson = "error: " + tc.wantErr
+		}
+		got, err := bqpb.Parse(tc.data, string(md.FullName()), *corpusTypedefs(md))
+		e.Bqpb = string(got)
+		if err != nil {
+			e.Bqpb = "error: " + err.Error()
+		}
+
+		if tc.wantErr == "" || err == nil {
+			e.BigQuery = tc.wantErr != "" || err != nil || !sameInBigQuery(e.Protojson, e.Bqpb)
+		}
+		switch {
+		case tc.deviation != nil:
+			e.Status = statusIntended
+			e.Reason = tc.deviation.reason
+			e.Spec = tc.deviation.spec
+		case tc.wantErr != "" && err != nil:
+			e.Status = statusBothFail
+		case tc.wantErr == "" && err == nil && sameCanonical(tc.want, e.Bqpb) && !e.BigQuery:
+			e.Status = statusSame
+		default:
+			// Dropping the null members may hide a difference that BigQuery
+			// keeps, which needs a deviation too.
+			e.Status = statusUnclassified
+			t.Errorf("%s: unclassified difference:\nprotojson: %s\nbqpb:      %s", tc.name, abbreviate(e.Protojson), abbreviate(e.Bqpb))
+		}
+		e.Protojson = abbreviate(e.Protojson)
+		e.Bqpb = abbreviate(e.Bqpb)
+		entries = append(entries, e)
+	}
+
+	var jsonBuf bytes.Buffer
+	enc := json.NewEncoder(&jsonBuf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(entries); err != nil {
+		t.Fatalf("Encode error: %v\n", err)
+	}
+	checkGolden(t, "testdata/compat.json", jsonBuf.Bytes())
+	checkGolden(t, "testdata/compat.md", []byte(matrixMarkdown(entries)))
+}
+
+func sameCanonical(want, got string) bool {
+	want, errWant := canonicalJSON(want)
+	got, errGot := canonicalJSON(got)
+	return errWant == nil && errGot == nil && want == got
+}
+
+// sameInBigQuery reports whether the outputs are stored as the same BigQuery
+// JSON value.
+func sameInBigQuery(want, got string) bool {
+	want, errWant := bqjson.Canonicalize(want, bqjson.Round)
+	got, errGot := bqjson.Canonicalize(got, bqjson.Round)
+	return errWant == nil && errGot == nil && want == got
+}
+
+func matrixMarkdown(entries []*matrixEntry) string {
+	var sb strings.Builder
+	sb.WriteString("# Compatibility between protojson and bqpb\n\n")
+	sb.WriteString("<!-- Generated by TestCompatibilityMatrix; DO NOT EDIT. -->\n\n")
+	sb.WriteString("Each serialization case of the baseline tests, decoded by protojson (with\n")
+	sb.WriteString("`EmitUnpopulated`) and by bqpb. The outputs are the \"same\" if they agree\n")
+	sb.WriteString("after sorting the keys, dropping null members and formatting the numbers as\n")
+	sb.WriteString("JSON.stringify does, and are stored as the same JSON value in BigQuery.\n")
+	sb.WriteString("\"In BigQuery\" tells whether the difference remains once the outputs are\n")
+	sb.WriteString("stored as the JSON type, which keeps null members.\n\n")
+
+	sb.WriteString("| Feature | Cases | Same | Both fail | Intended | In BigQuery |\n")
+	sb.WriteString("| --- | --: | --: | --: | --: | --: |\n")
+	for _, feature := range features {
+		counts := map[string]int{}
+		total, bigquery := 0, 0
+		for _, e := range entries {
+			if e.Feature != feature {
+				continue
+			}
+			total++
+			counts[e.Status]++
+			if e.BigQuery {
+				bigquery++
+			}
+		}
+		fmt.Fprintf(&sb, "| %s | %d | %d | %d | %d | %d |\n", feature, total, counts[statusSame], counts[statusBothFail], counts[statusIntended], bigquery)
+	}
+
+	for _, feature := range features {
+		fmt.Fprintf(&sb, "\n## %s\n\n", feature)
+		sb.WriteString("| Case | Status | In BigQuery | protojson | bqpb | Reason |\n")
+		sb.WriteString("| --- | --- | --- | --- | --- | --- |\n")
+		for _, e := range entries {
+			if e.Feature != feature {
+				continue
+			}
+			bigquery := ""
+			if e.BigQuery {
+				bigquery = "differs"
+			}
+			fmt.Fprintf(&sb, "| %s | %s | %s | %s | %s | %s |\n", markdownCell(e.Name), e.Status, bigquery, markdownCode(e.Protojson), markdownCode(e.Bqpb), markdownReason(e))
+		}
+	}
+	return sb.String()
+}
+
+func markdownReason(e *matrixEntry) string {
+	if e.Spec == "" {
+		return markdownCell(e.Reason)
+	}
+	return fmt.Sprintf("%s ([spec](%s))", markdownCell(e.Reason), e.Spec)
+}
+
+func markdownCell(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
+
+func markdownCode(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "`" + markdownCell(s) + "`"
+}
@@ -0,0 +1,265 @@
+package baseline_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+	"github.com/qnighy/bqpb/baseline/bqpbdesc"
+	"github.com/qnighy/bqpb/baseline/example2pb"
+	"github.com/qnighy/bqpb/baseline/examplepb"
+)
+
+// TestParse checks the Go port of parseProtobuf against protojson on the
+// serialization cases. The outputs are compared after canonicalJSON, which
+// absorbs the systematic differences:
+//
+//   - bqpb orders the keys as JavaScript does.
+//   - bqpb omits absent fields with explicit presence, where protojson emits
+//     null.
+//   - bqpb formats numbers with JSON.stringify, which turns -0 into 0.
+//
+// When protobuf-go fails, bqpb is only expected to fail too, as the error
+// messages differ. Other differences are recorded in the deviation of the
+// case, which must still differ from protojson, at least once stored in
+// BigQuery.
+func TestParse(t *testing.T) {
+	for _, tc := range serializationTestCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			md := tc.datatype.ProtoReflect().Descriptor()
+			got, err := bqpb.Parse(tc.data, string(md.FullName()), *corpusTypedefs(md))
+			gotText := string(got)
+			if err != nil {
+				gotText = "error: " + err.Error()
+			}
+
+			if tc.deviation != nil {
+				if tc.deviation.reason == "" || tc.deviation.spec == "" {
+					t.Errorf("deviation without a reason or a spec link")
+				}
+				if diff := cmp.Diff(tc.deviation.want, abbreviate(gotText)); diff != "" {
+					t.Errorf("Parse() mismatch (-want +got):\n%s", diff)
+				}
+				if tc.wantErr == "" && err == nil && sameCanonical(tc.want, gotText) && sameInBigQuery(tc.want, gotText) {
+					t.Errorf("Parse() matches protojson; remove the deviation")
+				}
+				return
+			}
+			if tc.wantErr != "" {
+				if err == nil {
+					t.Errorf("Parse() = %s, want error like %q", abbreviate(gotText), tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse error: %v\n", err)
+			}
+			want, err := canonicalJSON(tc.want)
+			if err != nil {
+				t.Fatalf("canonicalJSON error: %v\n", err)
+			}
+			gotCanonical, err := canonicalJSON(gotText)
+			if err != nil {
+				t.Fatalf("canonicalJSON error: %v\n", err)
+			}
+			if diff := cmp.Diff(abbreviate(want), abbreviate(gotCanonical)); diff != "" {
+				t.Errorf("Parse() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestRecursionLimit checks the recursion cases structurally: each level of
+// recursiveGroup nests a group and a submessage, so bqpb decodes
+// bqpb.MaxDepth/2 levels and fails on one more, with the message of the
+// RangeError bqpb.ts throws when it runs out of stack.
+func TestRecursionLimit(t *testing.T) {
+	md := (&example2pb.RecursiveGroup{}).ProtoReflect().Descriptor()
+	typedefs := *corpusTypedefs(md)
+	levels := bqpb.MaxDepth / 2
+
+	got, err := bqpb.Parse(recursiveGroup(levels), string(md.FullName()), typedefs)
+	if err != nil {
+		t.Fatalf("Parse error at %d levels: %v\n", levels, err)
+	}
+	want := strings.Repeat(`{"myField":{"recursiveField":`, levels) + `{}` + strings.Repeat(`}}`, levels)
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("Parse() mismatch at %d levels (-want +got):\n%s", levels, diff)
+	}
+
+	for _, levels := range []int{levels + 1, 4999, 5000} {
+		_, err := bqpb.Parse(recursiveGroup(levels), string(md.FullName()), typedefs)
+		if err == nil || err.Error() != "Maximum call stack size exceeded" {
+			t.Errorf("Parse() error at %d levels = %v, want the RangeError of bqpb.ts", levels, err)
+		}
+	}
+}
+
+// corpusTypedefs returns the typedefs for the message type, along with those
+// of all the example messages so that google.protobuf.Any can refer to them.
+func corpusTypedefs(md protoreflect.MessageDescriptor) *bqpb.Typedefs {
+	typedefs := bqpbdesc.FromMessage(md)
+	for _, fd := range []protoreflect.FileDescriptor{examplepb.File_example_proto, example2pb.File_example2_proto} {
+		for i := 0; i < fd.Messages().Len(); i++ {
+			for _, m := range bqpbdesc.FromMessage(fd.Messages().Get(i)).Messages {
+				if typedefs.Message(m.Name) == nil {
+					typedefs.Messages = append(typedefs.Messages, m)
+				}
+			}
+		}
+	}
+	return typedefs
+}
+
+// canonicalJSON reformats the JSON text for comparison. Object keys are
+// sorted, members with null values are dropped and numbers are formatted as
+// JSON.stringify does. Unlike encoding/json, it has no limit on nesting.
+func canonicalJSON(s string) (string, error) {
+	p := &jsonParser{s: s}
+	v, err := p.value()
+	if err != nil {
+		return "", err
+	}
+	if p.skipSpace(); p.p < len(p.s) {
+		return "", fmt.Errorf("trailing data at %d", p.p)
+	}
+	var buf strings.Builder
+	writeCanonical(&buf, v)
+	return buf.String(), nil
+}
+
+type jsonParser struct {
+	s string
+	p int
+}
+
+type jsonObject map[string]interface{}
+
+func (p *jsonParser) skipSpace() {
+	for p.p < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.p]) >= 0 {
+		p.p++
+	}
+}
+
+func (p *jsonParser) value() (interface{}, error) {
+	p.skipSpace()
+	if p.p >= len(p.s) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	switch p.s[p.p] {
+	case '{':
+		obj := jsonObject{}
+		p.p++
+		for i := 0; ; i++ {
+			if p.skipSpace(); p.p < len(p.s) && p.s[p.p] == '}' {
+				p.p++
+				return obj, nil
+			}
+			if i > 0 {
+				if err := p.expect(','); err != nil {
+					return nil, err
+				}
+			}
+			key, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(':'); err != nil {
+				return nil, err
+			}
+			member, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			obj[key.(string)] = member
+		}
+	case '[':
+		arr := []interface{}{}
+		p.p++
+		for i := 0; ; i++ {
+			if p.skipSpace(); p.p < len(p.s) && p.s[p.p] == ']' {
+				p.p++
+				return arr, nil
+			}
+			if i > 0 {
+				if err := p.expect(','); err != nil {
+					return nil, err
+				}
+			}
+			elem, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, elem)
+		}
+	}
+	// A scalar; let encoding/json find its end.
+	dec := json.NewDecoder(strings.NewReader(p.s[p.p:]))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	p.p += int(dec.InputOffset())
+	if n, ok := v.(json.Number); ok {
+		f, err := n.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return f, nil
+	}
+	return v, nil
+}
+
+func (p *jsonParser) expect(c byte) error {
+	if p.skipSpace(); p.p >= len(p.s) || p.s[p.p] != c {
+		return fmt.Errorf("expected %q at %d", c, p.p)
+	}
+	p.p++
+	return nil
+}
+
+func writeCanonical(buf *strings.Builder, v interface{}) {
+	switch v := v.(type) {
+	case jsonObject:
+		var keys []string
+		for key, member := range v {
+			if member != nil {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		buf.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			k, _ := json.Marshal(key)
+			buf.Write(k)
+			buf.WriteByte(':')
+			writeCanonical(buf, v[key])
+		}
+		buf.WriteByte('}')
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, elem := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			writeCanonical(buf, elem)
+		}
+		buf.WriteByte(']')
+	case float64:
+		buf.WriteString(bqpb.FormatNumber(v))
+	default:
+		b, _ := json.Marshal(v)
+		buf.Write(b)
+	}
+}
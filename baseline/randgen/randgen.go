@@ -0,0 +1,391 @@
+// Package randgen generates random messages from typedefs.
+//
+// The messages are built as the JSON values that bqpb.Parse produces, and
+// serialized with bqpb.EncodeValue, so they are valid for the typedefs by
+// construction. The sizes follow geometric distributions of the given means,
+// which have the long tails of real data.
+package randgen
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+)
+
+// Options controls the shape of the messages. The zero values are taken as
+// they are; start from DefaultOptions to change only some of them.
+type Options struct {
+	// MaxDepth is the maximum nesting of messages. Message fields below it
+	// are left unset. The default is 4.
+	MaxDepth int
+	// MeanRepeated is the mean number of elements of repeated fields and
+	// maps. The default is 2.
+	MeanRepeated float64
+	// MeanLength is the mean length of strings and bytes. The default is 8.
+	MeanLength float64
+	// Presence is the probability that a singular field is set. The default
+	// is 0.5. Fields with implicit presence may be set to zero values too,
+	// which are not written.
+	Presence float64
+	// MeanUnknown is the mean number of unknown fields injected into each
+	// message. The default is 0, which injects none.
+	MeanUnknown float64
+	// Any lists the messages to pack into google.protobuf.Any fields. If
+	// empty, Any fields are left unset.
+	Any []string
+}
+
+// DefaultOptions returns the default options.
+func DefaultOptions() *Options {
+	return &Options{
+		MaxDepth:     4,
+		MeanRepeated: 2,
+		MeanLength:   8,
+		Presence:     0.5,
+	}
+}
+
+// Generator generates random messages of a type.
+type Generator struct {
+	typedefs *bqpb.Typedefs
+	opts     Options
+	rnd      *rand.Rand
+}
+
+// New returns a generator reading the randomness from rnd.
+func New(typedefs *bqpb.Typedefs, opts *Options, rnd *rand.Rand) (*Generator, error) {
+	g := &Generator{typedefs: typedefs, opts: *opts, rnd: rnd}
+	for _, name := range g.opts.Any {
+		if !bqpb.IsSpecialType(name) && typedefs.Message(name) == nil {
+			return nil, fmt.Errorf("message %s is not defined in the typedefs", name)
+		}
+	}
+	return g, nil
+}
+
+// Message returns a serialized random message.
+func (g *Generator) Message(messageType string) ([]byte, error) {
+	value, err := g.Value(messageType)
+	if err != nil {
+		return nil, err
+	}
+	return bqpb.EncodeValue(value, messageType, *g.typedefs)
+}
+
+// Value returns a random message as a JSON value of the shape bqpb.Parse
+// produces.
+func (g *Generator) Value(messageType string) (bqpb.Value, error) {
+	if !bqpb.IsSpecialType(messageType) && g.typedefs.Message(messageType) == nil {
+		return nil, fmt.Errorf("message %s is not defined in the typedefs", messageType)
+	}
+	return g.message(messageType, 0), nil
+}
+
+func (g *Generator) message(messageType string, depth int) bqpb.Value {
+	if bqpb.IsSpecialType(messageType) {
+		return g.special(messageType, depth)
+	}
+	obj := bqpb.NewObject()
+	msgDef := g.typedefs.Message(messageType)
+	if msgDef == nil {
+		return obj
+	}
+	oneofs := map[string]bool{}
+	used := map[int32]bool{}
+	maxID := int32(0)
+	for _, fieldDef := range msgDef.Entries() {
+		used[fieldDef.ID] = true
+		if fieldDef.ID > maxID {
+			maxID = fieldDef.ID
+		}
+		if g.isMessage(fieldDef.Type) && depth+1 >= g.opts.MaxDepth {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(fieldDef.Type, "map<"):
+			obj.Set(fieldDef.Name, g.mapValue(fieldDef.Type, depth))
+		case fieldDef.Repeated:
+			values := []bqpb.Value{}
+			for n := g.count(g.opts.MeanRepeated); n > 0; n-- {
+				values = append(values, g.single(fieldDef.Type, depth))
+			}
+			obj.Set(fieldDef.Name, values)
+		default:
+			if fieldDef.OneofGroup != "" {
+				if oneofs[fieldDef.OneofGroup] {
+					continue
+				}
+			}
+			if g.rnd.Float64() >= g.opts.Presence {
+				continue
+			}
+			oneofs[fieldDef.OneofGroup] = true
+			obj.Set(fieldDef.Name, g.single(fieldDef.Type, depth))
+		}
+	}
+	for n := g.count(g.opts.MeanUnknown); n > 0; n-- {
+		id := maxID + 1 + int32(g.rnd.Intn(16))
+		if used[id] {
+			continue
+		}
+		used[id] = true
+		obj.Set("#"+strconv.Itoa(int(id)), g.unknown())
+	}
+	return obj
+}
+
+// isMessage reports whether the type is or contains a message.
+func (g *Generator) isMessage(typeName string) bool {
+	if strings.HasPrefix(typeName, "map<") {
+		_, valueType := mapTypes(typeName)
+		return g.isMessage(valueType)
+	}
+	return !isScalar(typeName) && g.typedefs.Enum(typeName) == nil
+}
+
+func (g *Generator) mapValue(typeName string, depth int) bqpb.Value {
+	keyType, valueType := mapTypes(typeName)
+	obj := bqpb.NewObject()
+	for n := g.count(g.opts.MeanRepeated); n > 0; n-- {
+		key := g.single(keyType, depth)
+		var keyString string
+		switch key := key.(type) {
+		case string:
+			keyString = key
+		case float64:
+			keyString = strconv.FormatFloat(key, 'f', -1, 64)
+		case bool:
+			keyString = strconv.FormatBool(key)
+		}
+		obj.Set(keyString, g.single(valueType, depth))
+	}
+	return obj
+}
+
+func mapTypes(typeName string) (string, string) {
+	args := strings.SplitN(typeName[len("map<"):len(typeName)-1], ",", 2)
+	if len(args) < 2 {
+		return strings.TrimSpace(args[0]), ""
+	}
+	return strings.TrimSpace(args[0]), strings.TrimSpace(args[1])
+}
+
+func isScalar(typeName string) bool {
+	switch typeName {
+	case "bool", "uint32", "int32", "sint32", "uint64", "int64", "sint64",
+		"fixed32", "sfixed32", "float", "fixed64", "sfixed64", "double",
+		"bytes", "string":
+		return true
+	}
+	return false
+}
+
+// single returns a random value of a non-repeated type.
+func (g *Generator) single(typeName string, depth int) bqpb.Value {
+	switch typeName {
+	case "bool":
+		return g.rnd.Intn(2) == 0
+	case "uint32", "fixed32":
+		return float64(uint32(g.integer(32)))
+	case "int32", "sint32", "sfixed32":
+		return float64(int32(g.integer(32)))
+	case "uint64", "fixed64":
+		return strconv.FormatUint(g.integer(64), 10)
+	case "int64", "sint64", "sfixed64":
+		return strconv.FormatInt(int64(g.integer(64)), 10)
+	case "float":
+		return g.float(32)
+	case "double":
+		return g.float(64)
+	case "string":
+		return g.string()
+	case "bytes":
+		return base64.StdEncoding.EncodeToString(g.bytes())
+	}
+	if enumDef := g.typedefs.Enum(typeName); enumDef != nil {
+		entries := enumDef.Entries()
+		if len(entries) == 0 || g.rnd.Intn(10) == 0 {
+			// A number without a name.
+			return float64(int32(g.integer(32)))
+		}
+		return entries[g.rnd.Intn(len(entries))].Name
+	}
+	return g.message(typeName, depth+1)
+}
+
+// integer returns a random integer of the given width, biased to small
+// magnitudes and the extremes.
+func (g *Generator) integer(bits int) uint64 {
+	switch g.rnd.Intn(4) {
+	case 0:
+		return uint64(g.rnd.Intn(16))
+	case 1:
+		// Small negative numbers, in two's complement.
+		return -uint64(g.rnd.Intn(16)+1) & (math.MaxUint64 >> (64 - bits))
+	case 2:
+		// Extremes.
+		edges := []uint64{0, 1, 1<<(bits-1) - 1, 1 << (bits - 1), math.MaxUint64 >> (64 - bits)}
+		return edges[g.rnd.Intn(len(edges))]
+	default:
+		return g.rnd.Uint64() >> (64 - bits)
+	}
+}
+
+func (g *Generator) float(bits int) bqpb.Value {
+	switch g.rnd.Intn(8) {
+	case 0:
+		return []bqpb.Value{"NaN", "Infinity", "-Infinity"}[g.rnd.Intn(3)]
+	case 1:
+		return 0.0
+	case 2:
+		return float64(g.rnd.Intn(201) - 100)
+	}
+	f := g.rnd.NormFloat64() * math.Pow(10, float64(g.rnd.Intn(21)-10))
+	if bits == 32 {
+		f = float64(float32(f))
+	}
+	return f
+}
+
+// runes lists the characters of the random strings, from one to four bytes
+// in UTF-8.
+var runes = []rune("abcxyzABCXYZ019 _-.\"\\\néßあ中\U0001f600")
+
+func (g *Generator) string() string {
+	var sb strings.Builder
+	for n := g.count(g.opts.MeanLength); n > 0; n-- {
+		sb.WriteRune(runes[g.rnd.Intn(len(runes))])
+	}
+	return sb.String()
+}
+
+func (g *Generator) bytes() []byte {
+	b := make([]byte, g.count(g.opts.MeanLength))
+	g.rnd.Read(b)
+	return b
+}
+
+// count returns a random count of the given mean, from the geometric
+// distribution.
+func (g *Generator) count(mean float64) int {
+	n := 0
+	for p := mean / (mean + 1); g.rnd.Float64() < p; {
+		n++
+	}
+	return n
+}
+
+// unknown returns a random unknown field value in the notation of
+// bqpb.Parse.
+func (g *Generator) unknown() bqpb.Value {
+	switch g.rnd.Intn(5) {
+	case 0:
+		return "unknown:int32:" + strconv.Itoa(g.rnd.Intn(1000))
+	case 1:
+		return "unknown:int64:" + strconv.FormatInt(int64(g.rnd.Uint64()>>1), 10)
+	case 2:
+		return "unknown:double:" + bqpb.FormatNumber(g.rnd.NormFloat64())
+	case 3:
+		return "unknown:float:" + bqpb.FormatNumber(float64(float32(g.rnd.NormFloat64())))
+	default:
+		return "unknown:bytes:" + base64.StdEncoding.EncodeToString(g.bytes())
+	}
+}
+
+// special returns a random value of a type that bqpb decodes on its own.
+func (g *Generator) special(messageType string, depth int) bqpb.Value {
+	shortType := strings.TrimPrefix(messageType, "google.protobuf.")
+	if strings.HasSuffix(shortType, "Value") && shortType != "Value" && shortType != "ListValue" {
+		return g.single(strings.ToLower(strings.TrimSuffix(shortType, "Value")), depth)
+	}
+	switch shortType {
+	case "Any":
+		obj := bqpb.NewObject()
+		if len(g.opts.Any) == 0 || depth+1 >= g.opts.MaxDepth {
+			// An Any without a type, which Parse leaves empty.
+			return obj
+		}
+		name := g.opts.Any[g.rnd.Intn(len(g.opts.Any))]
+		obj.Set("@type", "type.googleapis.com/"+name)
+		inner := g.message(name, depth+1)
+		if bqpb.IsSpecialType(name) {
+			obj.Set("value", inner)
+		} else if innerObj, ok := inner.(*bqpb.Object); ok {
+			for _, key := range innerObj.Keys() {
+				v, _ := innerObj.Get(key)
+				obj.Set(key, v)
+			}
+		}
+		return obj
+	case "Value":
+		return g.jsonValue(depth)
+	case "Struct":
+		return g.jsonObject(depth)
+	case "ListValue":
+		return g.jsonArray(depth)
+	case "FieldMask":
+		var paths []string
+		for n := g.count(g.opts.MeanRepeated); n > 0; n-- {
+			paths = append(paths, []string{"foo", "fooBar", "foo.barBaz", "a1"}[g.rnd.Intn(4)])
+		}
+		return strings.Join(paths, ",")
+	case "Timestamp":
+		// Between 0001-01-01 and 9999-12-31.
+		seconds := g.rnd.Int63n(253402300800+62135596800) - 62135596800
+		t := time.Unix(seconds, int64(g.rnd.Intn(1e9))).UTC()
+		return t.Format("2006-01-02T15:04:05.000000000Z")
+	case "Duration":
+		seconds := g.rnd.Int63n(315576000000)
+		sign := ""
+		if g.rnd.Intn(2) == 0 {
+			sign = "-"
+		}
+		return fmt.Sprintf("%s%d.%09ds", sign, seconds, g.rnd.Intn(1e9))
+	}
+	return bqpb.NewObject()
+}
+
+// jsonValue returns a random google.protobuf.Value.
+func (g *Generator) jsonValue(depth int) bqpb.Value {
+	n := 4
+	if depth+1 < g.opts.MaxDepth {
+		n = 6
+	}
+	switch g.rnd.Intn(n) {
+	case 0:
+		return nil
+	case 1:
+		// Value holds a double, but not NaN or Infinity.
+		return float64(g.rnd.Intn(2001)-1000) / 8
+	case 2:
+		return g.string()
+	case 3:
+		return g.rnd.Intn(2) == 0
+	case 4:
+		return g.jsonObject(depth + 1)
+	default:
+		return g.jsonArray(depth + 1)
+	}
+}
+
+func (g *Generator) jsonObject(depth int) bqpb.Value {
+	obj := bqpb.NewObject()
+	for n := g.count(g.opts.MeanRepeated); n > 0; n-- {
+		obj.Set(g.string(), g.jsonValue(depth))
+	}
+	return obj
+}
+
+func (g *Generator) jsonArray(depth int) bqpb.Value {
+	values := []bqpb.Value{}
+	for n := g.count(g.opts.MeanRepeated); n > 0; n-- {
+		values = append(values, g.jsonValue(depth))
+	}
+	return values
+}
@@ -0,0 +1,154 @@
+package randgen_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/sourcecontextpb"
+	"google.golang.org/protobuf/types/known/typepb"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+	"github.com/qnighy/bqpb/baseline/randgen"
+	"github.com/qnighy/bqpb/baseline/wkt"
+)
+
+func TestDeterministic(t *testing.T) {
+	typedefs := wkt.Typedefs()
+	var outputs [2][]byte
+	for i := range outputs {
+		opts := randgen.DefaultOptions()
+		opts.MeanUnknown = 1
+		gen, err := randgen.New(typedefs, opts, rand.New(rand.NewSource(42)))
+		if err != nil {
+			t.Fatalf("New error: %v\n", err)
+		}
+		for n := 0; n < 10; n++ {
+			data, err := gen.Message("google.protobuf.Type")
+			if err != nil {
+				t.Fatalf("Message error: %v\n", err)
+			}
+			outputs[i] = append(outputs[i], data...)
+		}
+	}
+	if !bytes.Equal(outputs[0], outputs[1]) {
+		t.Errorf("outputs differ for the same seed")
+	}
+}
+
+func TestAny(t *testing.T) {
+	typedefs := wkt.Typedefs()
+	opts := randgen.DefaultOptions()
+	opts.Presence = 1
+	opts.Any = []string{"google.protobuf.SourceContext"}
+	gen, err := randgen.New(typedefs, opts, rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatalf("New error: %v\n", err)
+	}
+	data, err := gen.Message("google.protobuf.Option")
+	if err != nil {
+		t.Fatalf("Message error: %v\n", err)
+	}
+	option := &typepb.Option{}
+	if err := proto.Unmarshal(data, option); err != nil {
+		t.Fatalf("Unmarshal error: %v\n", err)
+	}
+	if err := option.Value.UnmarshalTo(&sourcecontextpb.SourceContext{}); err != nil {
+		t.Errorf("UnmarshalTo error: %v", err)
+	}
+}
+
+func TestMaxDepth(t *testing.T) {
+	var typedefs bqpb.Typedefs
+	if err := json.Unmarshal([]byte(`{"message Node":{"child":{"type":"Node","id":1},"children":{"type":"Node","id":2,"repeated":true}}}`), &typedefs); err != nil {
+		t.Fatalf("Unmarshal error: %v\n", err)
+	}
+	opts := randgen.DefaultOptions()
+	opts.MaxDepth = 3
+	opts.Presence = 1
+	gen, err := randgen.New(&typedefs, opts, rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatalf("New error: %v\n", err)
+	}
+	value, err := gen.Value("Node")
+	if err != nil {
+		t.Fatalf("Value error: %v\n", err)
+	}
+	got, err := bqpb.Marshal(value)
+	if err != nil {
+		t.Fatalf("Marshal error: %v\n", err)
+	}
+	want := `{"child":{"child":{}`
+	if !strings.HasPrefix(string(got), want) || strings.Contains(string(got), `{"child":{"child":{"child"`) {
+		t.Errorf("Value() = %s, want three levels", got)
+	}
+}
+
+func TestZeroOptions(t *testing.T) {
+	var typedefs bqpb.Typedefs
+	if err := json.Unmarshal([]byte(`{"message Main":{"s":{"type":"string","id":1,"fieldPresence":"explicit"},"y":{"type":"bytes","id":2,"fieldPresence":"explicit"},"r":{"type":"int32","id":3,"repeated":true}}}`), &typedefs); err != nil {
+		t.Fatalf("Unmarshal error: %v\n", err)
+	}
+	testcases := []struct {
+		name string
+		opts func(opts *randgen.Options)
+		// want is in every message.
+		want string
+	}{
+		{
+			name: "Presence",
+			opts: func(opts *randgen.Options) { opts.Presence = 0; opts.MeanRepeated = 1 },
+			want: `{"r":[`,
+		},
+		{
+			name: "MeanRepeated",
+			opts: func(opts *randgen.Options) { opts.Presence = 1; opts.MeanRepeated = 0 },
+			want: `"r":[]}`,
+		},
+		{
+			name: "MeanLength",
+			opts: func(opts *randgen.Options) { opts.Presence = 1; opts.MeanLength = 0 },
+			want: `{"s":"","y":"",`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := randgen.DefaultOptions()
+			tc.opts(opts)
+			gen, err := randgen.New(&typedefs, opts, rand.New(rand.NewSource(1)))
+			if err != nil {
+				t.Fatalf("New error: %v\n", err)
+			}
+			for n := 0; n < 10; n++ {
+				value, err := gen.Value("Main")
+				if err != nil {
+					t.Fatalf("Value error: %v\n", err)
+				}
+				got, err := bqpb.Marshal(value)
+				if err != nil {
+					t.Fatalf("Marshal error: %v\n", err)
+				}
+				if !strings.Contains(string(got), tc.want) {
+					t.Errorf("Value() = %s, want it to contain %s", got, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	var typedefs bqpb.Typedefs
+	if _, err := randgen.New(&typedefs, &randgen.Options{Any: []string{"Packed"}}, rand.New(rand.NewSource(1))); err == nil || err.Error() != "message Packed is not defined in the typedefs" {
+		t.Errorf("New() error = %v", err)
+	}
+	gen, err := randgen.New(&typedefs, randgen.DefaultOptions(), rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatalf("New error: %v\n", err)
+	}
+	if _, err := gen.Message("Main"); err == nil || err.Error() != "message Main is not defined in the typedefs" {
+		t.Errorf("Message() error = %v", err)
+	}
+}
@@ -0,0 +1,264 @@
+// Package remote serves parseProtobuf as a BigQuery Remote Function.
+//
+// The function takes the same arguments as the JavaScript UDF:
+//
+//	parseProtobuf(input BYTES, messageType STRING, typedefs JSON) RETURNS JSON
+//
+// BigQuery sends the calls in batches, as in
+//
+//	{"calls": [["CAE=", "Main", {"message Main": {...}}], ...]}
+//
+// and expects one reply per call:
+//
+//	{"replies": [{"field1": 1}, ...]}
+//
+// or an error for the whole batch:
+//
+//	{"errorMessage": "..."}
+package remote
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"runtime"
+	"sync"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+)
+
+// DefaultMaxRequestBytes is the default limit of the request body size.
+const DefaultMaxRequestBytes = 10 << 20
+
+// Handler is an http.Handler implementing the remote function.
+type Handler struct {
+	// MaxRequestBytes limits the size of request bodies.
+	// If zero, DefaultMaxRequestBytes is used.
+	MaxRequestBytes int64
+	// MaxCalls limits the number of calls in a request. If zero, there is
+	// no limit.
+	MaxCalls int
+	// Concurrency is the number of calls decoded in parallel.
+	// If zero, runtime.GOMAXPROCS(0) is used.
+	Concurrency int
+	// Strict makes a failing call fail the whole request, as the
+	// JavaScript UDF fails the query. Otherwise the failing call replies
+	// with null, and the error is logged.
+	Strict bool
+	// ErrorLog is the logger for failing calls. If nil, the log package's
+	// standard logger is used.
+	ErrorLog *log.Logger
+}
+
+type request struct {
+	RequestID string              `json:"requestId"`
+	Calls     [][]json.RawMessage `json:"calls"`
+}
+
+type response struct {
+	Replies []json.RawMessage `json:"replies"`
+}
+
+type errorResponse struct {
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// CallError is an error in a single call.
+type CallError struct {
+	// Index is the index of the call in the request.
+	Index int
+	Err   error
+}
+
+func (e *CallError) Error() string {
+	return fmt.Sprintf("call %d: %v", e.Index, e.Err)
+}
+
+func (e *CallError) Unwrap() error {
+	return e.Err
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeResponse(w, http.StatusMethodNotAllowed, &errorResponse{ErrorMessage: "method not allowed"})
+		return
+	}
+	maxRequestBytes := h.MaxRequestBytes
+	if maxRequestBytes == 0 {
+		maxRequestBytes = DefaultMaxRequestBytes
+	}
+
+	var req request
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes)).Decode(&req); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			writeResponse(w, http.StatusRequestEntityTooLarge, &errorResponse{
+				ErrorMessage: fmt.Sprintf("request body exceeds %d bytes", maxRequestBytes),
+			})
+			return
+		}
+		writeResponse(w, http.StatusBadRequest, &errorResponse{ErrorMessage: "invalid request: " + err.Error()})
+		return
+	}
+	if h.MaxCalls > 0 && len(req.Calls) > h.MaxCalls {
+		writeResponse(w, http.StatusRequestEntityTooLarge, &errorResponse{
+			ErrorMessage: fmt.Sprintf("too many calls: %d > %d", len(req.Calls), h.MaxCalls),
+		})
+		return
+	}
+
+	replies, errs := h.Batch(req.Calls)
+	for _, err := range errs {
+		if h.Strict {
+			writeResponse(w, http.StatusBadRequest, &errorResponse{ErrorMessage: err.Error()})
+			return
+		}
+		h.logf("request %s: %v", req.RequestID, err)
+	}
+	writeResponse(w, http.StatusOK, &response{Replies: replies})
+}
+
+// Batch decodes the calls in parallel. A failing call gets a null reply
+// and an error in the returned list, without affecting the other calls.
+func (h *Handler) Batch(calls [][]json.RawMessage) ([]json.RawMessage, []*CallError) {
+	concurrency := h.Concurrency
+	if concurrency <= 0 {
+		concurrency = runtime.GOMAXPROCS(0)
+	}
+	replies := make([]json.RawMessage, len(calls))
+	callErrs := make([]error, len(calls))
+	typedefsCache := newTypedefsCache()
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				replies[i], callErrs[i] = safeCall(calls[i], typedefsCache)
+			}
+		}()
+	}
+	for i := range calls {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	var errs []*CallError
+	for i, err := range callErrs {
+		if err != nil {
+			replies[i] = json.RawMessage("null")
+			errs = append(errs, &CallError{Index: i, Err: err})
+		}
+	}
+	return replies, errs
+}
+
+// safeCall runs call, turning a panic into an error of that call only, as
+// the other calls of the batch share the process.
+func safeCall(args []json.RawMessage, typedefsCache *typedefsCache) (reply json.RawMessage, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			reply, err = nil, fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return call(args, typedefsCache)
+}
+
+// call decodes a single call. As with the JavaScript UDF, a NULL input
+// yields NULL.
+func call(args []json.RawMessage, typedefsCache *typedefsCache) (json.RawMessage, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf("expected 3 arguments, got %d", len(args))
+	}
+	var input *string
+	if err := json.Unmarshal(args[0], &input); err != nil {
+		return nil, fmt.Errorf("input: %w", err)
+	}
+	if input == nil {
+		return json.RawMessage("null"), nil
+	}
+	// BYTES arguments are encoded in base64.
+	data, err := base64.StdEncoding.DecodeString(*input)
+	if err != nil {
+		return nil, fmt.Errorf("input: %w", err)
+	}
+	var messageType string
+	if err := json.Unmarshal(args[1], &messageType); err != nil {
+		return nil, fmt.Errorf("messageType: %w", err)
+	}
+	typedefs, err := typedefsCache.get(args[2])
+	if err != nil {
+		return nil, fmt.Errorf("typedefs: %w", err)
+	}
+	return bqpb.Parse(data, messageType, *typedefs)
+}
+
+// typedefsCache decodes each distinct typedefs argument once per request;
+// usually all the calls share the same one.
+type typedefsCache struct {
+	mu      sync.Mutex
+	entries map[string]*typedefsEntry
+}
+
+type typedefsEntry struct {
+	once     sync.Once
+	typedefs *bqpb.Typedefs
+	err      error
+}
+
+func newTypedefsCache() *typedefsCache {
+	return &typedefsCache{entries: map[string]*typedefsEntry{}}
+}
+
+func (c *typedefsCache) get(raw json.RawMessage) (*bqpb.Typedefs, error) {
+	c.mu.Lock()
+	entry := c.entries[string(raw)]
+	if entry == nil {
+		entry = &typedefsEntry{}
+		c.entries[string(raw)] = entry
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.typedefs, entry.err = decodeTypedefs(raw)
+	})
+	return entry.typedefs, entry.err
+}
+
+func decodeTypedefs(raw json.RawMessage) (*bqpb.Typedefs, error) {
+	// Accept typedefs passed as a STRING too.
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		raw = json.RawMessage(text)
+	}
+	typedefs := &bqpb.Typedefs{}
+	if err := json.Unmarshal(raw, typedefs); err != nil {
+		return nil, err
+	}
+	return typedefs, nil
+}
+
+func (h *Handler) logf(format string, args ...interface{}) {
+	if h.ErrorLog != nil {
+		h.ErrorLog.Printf(format, args...)
+	} else {
+		log.Printf(format, args...)
+	}
+}
+
+func writeResponse(w http.ResponseWriter, status int, resp interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	// Keep the replies as bqpb produces them.
+	enc.SetEscapeHTML(false)
+	// The replies are already valid JSON; encoding cannot fail.
+	_ = enc.Encode(resp)
+}
@@ -0,0 +1,185 @@
+package remote_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/qnighy/bqpb/baseline/remote"
+)
+
+const typedefs = `{"message Main":{"field1":{"type":"uint32","id":1}}}`
+
+func TestHandler(t *testing.T) {
+	testcases := []struct {
+		name       string
+		handler    *remote.Handler
+		method     string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "batch",
+			body:       `{"requestId":"r","calls":[["CAE=","Main",` + typedefs + `],["CAI=","Main",` + typedefs + `],["","Main",` + typedefs + `]]}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"replies":[{"field1":1},{"field1":2},{}]}`,
+		},
+		{
+			name:       "no calls",
+			body:       `{"calls":[]}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"replies":[]}`,
+		},
+		{
+			name:       "schemaless",
+			body:       `{"calls":[["CAE=","Main",{}]]}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"replies":[{"#1":"unknown:int32:1"}]}`,
+		},
+		{
+			name:       "typedefs as string",
+			body:       `{"calls":[["CAE=","Main",` + quote(typedefs) + `]]}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"replies":[{"field1":1}]}`,
+		},
+		{
+			name:       "null input",
+			body:       `{"calls":[[null,"Main",` + typedefs + `]]}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"replies":[null]}`,
+		},
+		{
+			name:       "no HTML escaping",
+			body:       `{"calls":[["CgM8Jj4=","Main",{"message Main":{"f":{"type":"string","id":1}}}]]}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"replies":[{"f":"<&>"}]}`,
+		},
+		{
+			name:       "failing calls are isolated",
+			body:       `{"calls":[["CAE=","Main",` + typedefs + `],["CA==","Main",` + typedefs + `],["CgA=","Main",` + typedefs + `],["!","Main",{}],["CAE=","Main"],["CAI=","Main",` + typedefs + `]]}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"replies":[{"field1":1},null,null,null,null,{"field1":2}]}`,
+		},
+		{
+			name:       "deeply nested groups",
+			body:       `{"calls":[["` + nestedGroups(1000000) + `","Main",{}],["CAE=","Main",` + typedefs + `]]}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"replies":[null,{"field1":1}]}`,
+		},
+		{
+			name:       "deeply nested groups, strict",
+			handler:    &remote.Handler{Strict: true},
+			body:       `{"calls":[["` + nestedGroups(1000000) + `","Main",{}]]}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"errorMessage":"call 0: Maximum call stack size exceeded"}`,
+		},
+		{
+			name:       "strict",
+			handler:    &remote.Handler{Strict: true},
+			body:       `{"calls":[["CAE=","Main",` + typedefs + `],["CgA=","Main",` + typedefs + `]]}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"errorMessage":"call 1: Expected wire type 0, got 2"}`,
+		},
+		{
+			name:       "invalid request",
+			body:       `{"calls":`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"errorMessage":"invalid request: unexpected EOF"}`,
+		},
+		{
+			name:       "request too large",
+			handler:    &remote.Handler{MaxRequestBytes: 32},
+			body:       `{"calls":[["CAE=","Main",` + typedefs + `]]}`,
+			wantStatus: http.StatusRequestEntityTooLarge,
+			wantBody:   `{"errorMessage":"request body exceeds 32 bytes"}`,
+		},
+		{
+			name:       "too many calls",
+			handler:    &remote.Handler{MaxCalls: 1},
+			body:       `{"calls":[["CAE=","Main",{}],["CAE=","Main",{}]]}`,
+			wantStatus: http.StatusRequestEntityTooLarge,
+			wantBody:   `{"errorMessage":"too many calls: 2 > 1"}`,
+		},
+		{
+			name:       "wrong method",
+			method:     http.MethodGet,
+			wantStatus: http.StatusMethodNotAllowed,
+			wantBody:   `{"errorMessage":"method not allowed"}`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := tc.handler
+			if handler == nil {
+				handler = &remote.Handler{}
+			}
+			handler.ErrorLog = log.New(io.Discard, "", 0)
+			server := httptest.NewServer(handler)
+			defer server.Close()
+
+			method := tc.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			req, err := http.NewRequest(method, server.URL, strings.NewReader(tc.body))
+			if err != nil {
+				t.Fatalf("NewRequest error: %v\n", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Do error: %v\n", err)
+			}
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("ReadAll error: %v\n", err)
+			}
+			if resp.StatusCode != tc.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tc.wantStatus)
+			}
+			if diff := cmp.Diff(tc.wantBody, strings.TrimSuffix(string(body), "\n")); diff != "" {
+				t.Errorf("body mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBatchErrors(t *testing.T) {
+	h := &remote.Handler{Concurrency: 2}
+	_, errs := h.Batch([][]json.RawMessage{
+		{json.RawMessage(`"CAE="`), json.RawMessage(`"Main"`), json.RawMessage(typedefs)},
+		{json.RawMessage(`"CA=="`), json.RawMessage(`"Main"`), json.RawMessage(typedefs)},
+		{json.RawMessage(`"CAE="`), json.RawMessage(`"Main"`)},
+	})
+	var got []string
+	for _, err := range errs {
+		got = append(got, err.Error())
+	}
+	want := []string{
+		"call 1: Unexpected EOF",
+		"call 2: expected 3 arguments, got 2",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Batch() errors mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// nestedGroups returns the base64 encoding of depth unterminated groups,
+// which used to overflow the stack of the decoder.
+func nestedGroups(depth int) string {
+	return base64.StdEncoding.EncodeToString([]byte(strings.Repeat("\x0b", depth)))
+}
+
+func quote(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
@@ -0,0 +1,69 @@
+package baseline_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// TestSchemaless records what bqpb infers from the inputs of the
+// serialization cases when no typedefs are given.
+// Changes to the inference rules show up as diffs in the golden file.
+func TestSchemaless(t *testing.T) {
+	var buf bytes.Buffer
+	for _, tc := range serializationTestCases() {
+		md := tc.datatype.ProtoReflect().Descriptor()
+		if pkg := md.ParentFile().Package(); pkg != "example" && pkg != "example2" {
+			continue
+		}
+		fmt.Fprintf(&buf, "=== %s\n", tc.name)
+		fmt.Fprintf(&buf, "%s %s\n", md.FullName(), abbreviate(hex.EncodeToString(tc.data)))
+		got, err := bqpb.Infer(tc.data)
+		if err != nil {
+			fmt.Fprintf(&buf, "error: %v\n", err)
+			continue
+		}
+		output, err := bqpb.Marshal(got)
+		if err != nil {
+			t.Fatalf("Marshal error: %v\n", err)
+		}
+		fmt.Fprintf(&buf, "%s\n", abbreviate(string(output)))
+	}
+	checkGolden(t, "testdata/schemaless.golden", buf.Bytes())
+}
+
+// abbreviate replaces long lines, such as those of the recursion limit cases,
+// with their digests to keep the golden files reviewable.
+func abbreviate(s string) string {
+	if len(s) <= 1000 {
+		return s
+	}
+	return fmt.Sprintf("sha256:%x (%d bytes)", sha256.Sum256([]byte(s)), len(s))
+}
+
+func checkGolden(t *testing.T, path string, got []byte) {
+	t.Helper()
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("WriteFile error: %v\n", err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile error: %v\n", err)
+	}
+	if diff := cmp.Diff(string(want), string(got)); diff != "" {
+		t.Errorf("%s mismatch (-want +got):\n%s", path, diff)
+	}
+}
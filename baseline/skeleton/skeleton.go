@@ -0,0 +1,500 @@
+// Package skeleton infers a typedefs skeleton from sample messages whose
+// schema is unknown.
+//
+// The result is a guess, meant as a starting point for hand-written
+// typedefs. Each field carries a comment stating how confident the guess is
+// and why.
+package skeleton
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+)
+
+// Confidence levels used in the comments.
+const (
+	ConfidenceHigh   = "high"
+	ConfidenceMedium = "medium"
+	ConfidenceLow    = "low"
+)
+
+// Inferrer accumulates sample messages of the same type.
+type Inferrer struct {
+	root *messageStats
+}
+
+// New returns an Inferrer with no samples.
+func New() *Inferrer {
+	return &Inferrer{root: newMessageStats()}
+}
+
+// Add adds a sample message.
+func (in *Inferrer) Add(data []byte) error {
+	fields, err := bqpb.ParseWire(data)
+	if err != nil {
+		return err
+	}
+	in.root.add(fields)
+	return nil
+}
+
+// Samples returns the number of sample messages added so far.
+func (in *Inferrer) Samples() int {
+	return in.root.count
+}
+
+// Typedefs returns the inferred typedefs, with the root message named name.
+// Fields are named after their numbers, like "field1", and nested messages
+// after the fields containing them, like "Main.Field1".
+func (in *Inferrer) Typedefs(name string) *bqpb.Typedefs {
+	g := &generator{typedefs: &bqpb.Typedefs{}}
+	g.message(name, in.root)
+	return g.typedefs
+}
+
+type messageStats struct {
+	// count is the number of message instances seen.
+	count  int
+	fields map[uint64]*fieldStats
+}
+
+type fieldStats struct {
+	number uint64
+	// presentIn is the number of message instances containing the field.
+	presentIn int
+	// maxPerMessage is the maximum number of occurrences in an instance.
+	maxPerMessage int
+	varints       []uint64
+	fixed32s      []uint64
+	fixed64s      []uint64
+	lens          [][]byte
+	groups        *messageStats
+}
+
+func newMessageStats() *messageStats {
+	return &messageStats{fields: map[uint64]*fieldStats{}}
+}
+
+func (m *messageStats) add(fields []bqpb.WireField) {
+	m.count++
+	occurrences := map[uint64]int{}
+	for _, field := range fields {
+		f := m.fields[field.Number]
+		if f == nil {
+			f = &fieldStats{number: field.Number}
+			m.fields[field.Number] = f
+		}
+		occurrences[field.Number]++
+		switch field.Type {
+		case protowire.VarintType:
+			f.varints = append(f.varints, field.Value)
+		case protowire.Fixed32Type:
+			f.fixed32s = append(f.fixed32s, field.Value)
+		case protowire.Fixed64Type:
+			f.fixed64s = append(f.fixed64s, field.Value)
+		case protowire.BytesType:
+			f.lens = append(f.lens, field.Bytes)
+		case protowire.StartGroupType:
+			if f.groups == nil {
+				f.groups = newMessageStats()
+			}
+			f.groups.add(field.Group)
+		}
+	}
+	for number, n := range occurrences {
+		f := m.fields[number]
+		f.presentIn++
+		if n > f.maxPerMessage {
+			f.maxPerMessage = n
+		}
+	}
+}
+
+func (f *fieldStats) groupCount() int {
+	if f.groups == nil {
+		return 0
+	}
+	return f.groups.count
+}
+
+func (f *fieldStats) total() int {
+	return len(f.varints) + len(f.fixed32s) + len(f.fixed64s) + len(f.lens) + f.groupCount()
+}
+
+type generator struct {
+	typedefs *bqpb.Typedefs
+}
+
+func (g *generator) message(name string, m *messageStats) {
+	msgDef := &bqpb.MessageDef{Name: name}
+	g.typedefs.Messages = append(g.typedefs.Messages, msgDef)
+
+	numbers := make([]uint64, 0, len(m.fields))
+	for number := range m.fields {
+		numbers = append(numbers, number)
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+	for _, number := range numbers {
+		msgDef.Fields = append(msgDef.Fields, g.field(name, m, m.fields[number]))
+	}
+}
+
+// guess is an inferred type along with the reasoning behind it.
+type guess struct {
+	typ        string
+	packed     bool
+	delimited  bool
+	confidence string
+	notes      []string
+}
+
+func (g *generator) field(parent string, m *messageStats, f *fieldStats) *bqpb.FieldDef {
+	fieldDef := &bqpb.FieldDef{
+		Name: fmt.Sprintf("field%d", f.number),
+		ID:   int32(f.number),
+	}
+	gs := g.guessType(fmt.Sprintf("%s.Field%d", parent, f.number), f)
+	fieldDef.Type = gs.typ
+	if gs.delimited {
+		fieldDef.MessageEncoding = bqpb.MessageEncodingDelimited
+	}
+	if f.maxPerMessage > 1 {
+		fieldDef.Repeated = true
+		gs.notes = append(gs.notes, fmt.Sprintf("up to %d occurrences per message", f.maxPerMessage))
+	} else if gs.packed {
+		fieldDef.Repeated = true
+	} else if f.presentIn < 3 {
+		// Too few instances to rule out repeated fields.
+		gs.confidence = lower(gs.confidence, ConfidenceMedium)
+	}
+	if f.total() < 3 {
+		gs.confidence = lower(gs.confidence, ConfidenceLow)
+	}
+	fieldDef.Comment = fmt.Sprintf(
+		"confidence: %s; seen %d times in %d of %d messages",
+		gs.confidence, f.total(), f.presentIn, m.count,
+	)
+	if len(gs.notes) > 0 {
+		fieldDef.Comment += "; " + strings.Join(gs.notes, "; ")
+	}
+	return fieldDef
+}
+
+func (g *generator) guessType(nestedName string, f *fieldStats) guess {
+	counts := []struct {
+		name  string
+		count int
+	}{
+		{"VARINT", len(f.varints)},
+		{"I32", len(f.fixed32s)},
+		{"I64", len(f.fixed64s)},
+		{"LEN", len(f.lens)},
+		{"SGROUP", f.groupCount()},
+	}
+	var wireTypes []string
+	for _, wt := range counts {
+		if wt.count > 0 {
+			wireTypes = append(wireTypes, wt.name)
+		}
+	}
+	mixed := len(wireTypes) > 1
+
+	// LEN occurrences that unpack as the scalars seen are packed repeated
+	// fields, rather than a wire type of their own.
+	packed := ""
+	var unpacked []uint64
+	if len(f.lens) > 0 {
+		var ok bool
+		switch {
+		case len(f.varints) > 0:
+			unpacked, ok = unpack(f.lens, unpackVarints)
+			packed = "VARINT"
+		case len(f.fixed32s) > 0:
+			unpacked, ok = unpack(f.lens, unpackFixed(4))
+			packed = "I32"
+		case len(f.fixed64s) > 0:
+			unpacked, ok = unpack(f.lens, unpackFixed(8))
+			packed = "I64"
+		}
+		if !ok {
+			packed = ""
+		}
+	}
+	if packed != "" {
+		for i := range counts {
+			switch counts[i].name {
+			case packed:
+				counts[i].count += len(f.lens)
+			case "LEN":
+				counts[i].count = 0
+			}
+		}
+		mixed = len(wireTypes) > 2
+	}
+
+	// Otherwise, the most frequent wire type decides the type, as bqpb
+	// rejects the occurrences of the others. A stray LEN occurrence among
+	// varints should not make every other sample fail.
+	dominant := counts[0]
+	for _, wt := range counts[1:] {
+		if wt.count > dominant.count {
+			dominant = wt
+		}
+	}
+	var minorities []string
+	for _, wt := range counts {
+		if wt.count > 0 && wt.name != dominant.name {
+			minorities = append(minorities, fmt.Sprintf("%d of %d occurrences are %s", wt.count, f.total(), wt.name))
+		}
+	}
+
+	var gs guess
+	switch dominant.name {
+	case "VARINT", "I32", "I64":
+		gs = guess{confidence: ConfidenceHigh}
+		var values []uint64
+		switch dominant.name {
+		case "VARINT":
+			values = f.varints
+		case "I32":
+			values = f.fixed32s
+		case "I64":
+			values = f.fixed64s
+		}
+		if packed == dominant.name {
+			values = append(append([]uint64{}, values...), unpacked...)
+			gs.packed = true
+			gs.notes = append(gs.notes, "packed")
+		}
+		switch dominant.name {
+		case "VARINT":
+			gs = guessVarint(values, gs)
+		case "I32":
+			gs = guessFixed(values, 32, gs)
+		case "I64":
+			gs = guessFixed(values, 64, gs)
+		}
+	case "SGROUP":
+		g.message(nestedName, f.groups)
+		gs = guess{typ: nestedName, delimited: true, confidence: ConfidenceHigh}
+	default:
+		gs = g.guessLen(nestedName, f.lens)
+	}
+	gs = withMixed(gs, mixed, wireTypes)
+	gs.notes = append(gs.notes, minorities...)
+	return gs
+}
+
+func withMixed(gs guess, mixed bool, wireTypes []string) guess {
+	if mixed {
+		gs.confidence = ConfidenceLow
+		gs.notes = append(gs.notes, "mixed wire types "+strings.Join(wireTypes, ", "))
+	}
+	return gs
+}
+
+func guessVarint(values []uint64, gs guess) guess {
+	var max, negatives, odds uint64
+	allInt32 := true
+	for _, v := range values {
+		if v >= 1<<63 {
+			negatives++
+			if v < 1<<64-1<<31 {
+				allInt32 = false
+			}
+			continue
+		}
+		if v > max {
+			max = v
+		}
+		if v >= 1<<31 {
+			allInt32 = false
+		}
+		if v&1 == 1 {
+			odds++
+		}
+	}
+	switch {
+	case negatives > 0:
+		// Plain encoding of negative numbers; not zigzag.
+		if allInt32 {
+			gs.typ = "int32"
+		} else {
+			gs.typ = "int64"
+		}
+	case max <= 1:
+		gs.typ = "bool"
+		gs.confidence = lower(gs.confidence, ConfidenceMedium)
+		gs.notes = append(gs.notes, "only 0 and 1 seen")
+	case odds > 0 && odds*5 <= uint64(len(values)):
+		// A few odd values among mostly even ones look like occasional
+		// negative numbers in zigzag encoding.
+		if max < 1<<32 {
+			gs.typ = "sint32"
+		} else {
+			gs.typ = "sint64"
+		}
+		gs.confidence = lower(gs.confidence, ConfidenceMedium)
+		gs.notes = append(gs.notes, "mostly even values suggest zigzag encoding")
+	case max >= 1<<32:
+		gs.typ = "int64"
+	case max >= 1<<31:
+		gs.typ = "uint32"
+	default:
+		gs.typ = "int32"
+		if len(values) > 0 && odds == 0 {
+			gs.confidence = lower(gs.confidence, ConfidenceMedium)
+			gs.notes = append(gs.notes, "may be zigzag-encoded")
+		}
+	}
+	return gs
+}
+
+func guessFixed(values []uint64, bits int, gs guess) guess {
+	floatType, fixedType, sfixedType := "float", "fixed32", "sfixed32"
+	if bits == 64 {
+		floatType, fixedType, sfixedType = "double", "fixed64", "sfixed64"
+	}
+	plausibleFloats, negatives := 0, 0
+	for _, v := range values {
+		var f float64
+		if bits == 64 {
+			f = math.Float64frombits(v)
+		} else {
+			f = float64(math.Float32frombits(uint32(v)))
+		}
+		if abs := math.Abs(f); abs == 0 || (1e-6 <= abs && abs <= 1e12) {
+			plausibleFloats++
+		}
+		if v>>(bits-1) == 1 && v >= 1<<bits-1<<(bits/2) {
+			negatives++
+		}
+	}
+	switch {
+	case plausibleFloats == len(values):
+		gs.typ = floatType
+		gs.confidence = lower(gs.confidence, ConfidenceMedium)
+		gs.notes = append(gs.notes, "values look like floating-point numbers")
+	case negatives > 0:
+		gs.typ = sfixedType
+		gs.confidence = lower(gs.confidence, ConfidenceMedium)
+		gs.notes = append(gs.notes, "values look like small negative integers")
+	default:
+		gs.typ = fixedType
+	}
+	return gs
+}
+
+// controlChars is the same as the regexp used for unknown fields in bqpb.ts.
+var controlChars = regexp.MustCompile("[\x00-\x08\x0b-\x1f\x7f]")
+
+func (g *generator) guessLen(nestedName string, lens [][]byte) guess {
+	strs, msgs, nonEmpty := 0, 0, 0
+	var parsed [][]bqpb.WireField
+	for _, b := range lens {
+		if len(b) == 0 {
+			continue
+		}
+		nonEmpty++
+		if utf8.Valid(b) && !controlChars.Match(b) {
+			strs++
+		}
+		if fields, err := bqpb.ParseWire(b); err == nil {
+			msgs++
+			parsed = append(parsed, fields)
+		}
+	}
+	switch {
+	case nonEmpty == 0:
+		return guess{typ: "string", confidence: ConfidenceLow, notes: []string{"only empty values seen"}}
+	case strs == nonEmpty:
+		gs := guess{typ: "string", confidence: ConfidenceHigh}
+		if msgs == nonEmpty {
+			gs.confidence = ConfidenceMedium
+			gs.notes = append(gs.notes, "could also be a message")
+		}
+		return gs
+	case msgs == nonEmpty:
+		nested := newMessageStats()
+		for _, b := range lens {
+			if len(b) == 0 {
+				nested.add(nil)
+			}
+		}
+		for _, fields := range parsed {
+			nested.add(fields)
+		}
+		g.message(nestedName, nested)
+		return guess{typ: nestedName, confidence: ConfidenceHigh}
+	}
+	if packed, ok := unpack(lens, unpackVarints); ok {
+		gs := guessVarint(packed, guess{packed: true, confidence: ConfidenceMedium})
+		gs.notes = append(gs.notes, "packed")
+		return gs
+	}
+	return guess{typ: "bytes", confidence: ConfidenceHigh}
+}
+
+func unpack(lens [][]byte, fn func(b []byte) ([]uint64, bool)) ([]uint64, bool) {
+	var values []uint64
+	for _, b := range lens {
+		unpacked, ok := fn(b)
+		if !ok {
+			return nil, false
+		}
+		values = append(values, unpacked...)
+	}
+	return values, true
+}
+
+func unpackVarints(b []byte) ([]uint64, bool) {
+	var values []uint64
+	for len(b) > 0 {
+		v, n := protowire.ConsumeVarint(b)
+		if n < 0 {
+			return nil, false
+		}
+		values = append(values, v)
+		b = b[n:]
+	}
+	return values, true
+}
+
+func unpackFixed(size int) func(b []byte) ([]uint64, bool) {
+	return func(b []byte) ([]uint64, bool) {
+		if len(b)%size != 0 {
+			return nil, false
+		}
+		var values []uint64
+		for ; len(b) > 0; b = b[size:] {
+			var v uint64
+			for i := 0; i < size; i++ {
+				v |= uint64(b[i]) << (8 * i)
+			}
+			values = append(values, v)
+		}
+		return values, true
+	}
+}
+
+var confidenceOrder = map[string]int{
+	ConfidenceLow:    0,
+	ConfidenceMedium: 1,
+	ConfidenceHigh:   2,
+}
+
+// lower returns the lower of the two confidence levels.
+func lower(a, b string) string {
+	if confidenceOrder[a] < confidenceOrder[b] {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,313 @@
+package skeleton_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+	"github.com/qnighy/bqpb/baseline/skeleton"
+)
+
+func varintField(num protowire.Number, v uint64) []byte {
+	b := protowire.AppendTag(nil, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func fixed32Field(num protowire.Number, v uint32) []byte {
+	b := protowire.AppendTag(nil, num, protowire.Fixed32Type)
+	return protowire.AppendFixed32(b, v)
+}
+
+func fixed64Field(num protowire.Number, v uint64) []byte {
+	b := protowire.AppendTag(nil, num, protowire.Fixed64Type)
+	return protowire.AppendFixed64(b, v)
+}
+
+func bytesField(num protowire.Number, v []byte) []byte {
+	b := protowire.AppendTag(nil, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+func groupField(num protowire.Number, v []byte) []byte {
+	b := protowire.AppendTag(nil, num, protowire.StartGroupType)
+	b = append(b, v...)
+	return protowire.AppendTag(b, num, protowire.EndGroupType)
+}
+
+func concat(parts ...[]byte) []byte {
+	var b []byte
+	for _, part := range parts {
+		b = append(b, part...)
+	}
+	return b
+}
+
+// repeat returns n samples made by fn.
+func repeat(n int, fn func(i int) []byte) [][]byte {
+	samples := make([][]byte, n)
+	for i := range samples {
+		samples[i] = fn(i)
+	}
+	return samples
+}
+
+func TestTypedefs(t *testing.T) {
+	testCases := []struct {
+		name    string
+		samples [][]byte
+		want    *bqpb.Typedefs
+		// wantConfidence is the confidence of each field of Main.
+		wantConfidence []string
+		// wantNote, if set, is in the comment of the first field of Main.
+		wantNote string
+	}{
+		{
+			name: "int32",
+			samples: repeat(10, func(i int) []byte {
+				return varintField(1, uint64(i*3))
+			}),
+			want: &bqpb.Typedefs{Messages: []*bqpb.MessageDef{
+				{Name: "Main", Fields: []*bqpb.FieldDef{
+					{Name: "field1", Type: "int32", ID: 1},
+				}},
+			}},
+			wantConfidence: []string{"high"},
+		},
+		{
+			name: "negative int32",
+			samples: repeat(10, func(i int) []byte {
+				return varintField(1, uint64(int64(i-5)))
+			}),
+			want: &bqpb.Typedefs{Messages: []*bqpb.MessageDef{
+				{Name: "Main", Fields: []*bqpb.FieldDef{
+					{Name: "field1", Type: "int32", ID: 1},
+				}},
+			}},
+			wantConfidence: []string{"high"},
+		},
+		{
+			name: "int64",
+			samples: repeat(10, func(i int) []byte {
+				return varintField(1, uint64(i)<<40+1)
+			}),
+			want: &bqpb.Typedefs{Messages: []*bqpb.MessageDef{
+				{Name: "Main", Fields: []*bqpb.FieldDef{
+					{Name: "field1", Type: "int64", ID: 1},
+				}},
+			}},
+			wantConfidence: []string{"high"},
+		},
+		{
+			name: "uint32",
+			samples: repeat(10, func(i int) []byte {
+				return varintField(1, math.MaxUint32-uint64(i)*7)
+			}),
+			want: &bqpb.Typedefs{Messages: []*bqpb.MessageDef{
+				{Name: "Main", Fields: []*bqpb.FieldDef{
+					{Name: "field1", Type: "uint32", ID: 1},
+				}},
+			}},
+			wantConfidence: []string{"high"},
+		},
+		{
+			name: "sint32",
+			samples: repeat(10, func(i int) []byte {
+				v := int32(i * 100)
+				if i == 3 {
+					v = -42
+				}
+				return varintField(1, protowire.EncodeZigZag(int64(v)))
+			}),
+			want: &bqpb.Typedefs{Messages: []*bqpb.MessageDef{
+				{Name: "Main", Fields: []*bqpb.FieldDef{
+					{Name: "field1", Type: "sint32", ID: 1},
+				}},
+			}},
+			wantConfidence: []string{"medium"},
+		},
+		{
+			name: "bool",
+			samples: repeat(10, func(i int) []byte {
+				return varintField(1, uint64(i%2))
+			}),
+			want: &bqpb.Typedefs{Messages: []*bqpb.MessageDef{
+				{Name: "Main", Fields: []*bqpb.FieldDef{
+					{Name: "field1", Type: "bool", ID: 1},
+				}},
+			}},
+			wantConfidence: []string{"medium"},
+		},
+		{
+			name: "fixed and floating-point",
+			samples: repeat(10, func(i int) []byte {
+				return concat(
+					fixed32Field(1, math.Float32bits(float32(i)+0.5)),
+					fixed32Field(2, uint32(i)+1),
+					fixed64Field(3, math.Float64bits(float64(i)*1.25)),
+					fixed64Field(4, uint64(int64(-i-1))),
+				)
+			}),
+			want: &bqpb.Typedefs{Messages: []*bqpb.MessageDef{
+				{Name: "Main", Fields: []*bqpb.FieldDef{
+					{Name: "field1", Type: "float", ID: 1},
+					{Name: "field2", Type: "fixed32", ID: 2},
+					{Name: "field3", Type: "double", ID: 3},
+					{Name: "field4", Type: "sfixed64", ID: 4},
+				}},
+			}},
+			wantConfidence: []string{"medium", "high", "medium", "medium"},
+		},
+		{
+			name: "string, bytes and submessage",
+			samples: repeat(10, func(i int) []byte {
+				return concat(
+					bytesField(1, []byte(strings.Repeat("Hello", i))),
+					bytesField(2, []byte{byte(i), 0xff}),
+					bytesField(3, concat(varintField(1, uint64(i)), bytesField(2, []byte{0x80}))),
+				)
+			}),
+			want: &bqpb.Typedefs{Messages: []*bqpb.MessageDef{
+				{Name: "Main", Fields: []*bqpb.FieldDef{
+					{Name: "field1", Type: "string", ID: 1},
+					{Name: "field2", Type: "bytes", ID: 2},
+					{Name: "field3", Type: "Main.Field3", ID: 3},
+				}},
+				{Name: "Main.Field3", Fields: []*bqpb.FieldDef{
+					{Name: "field1", Type: "int32", ID: 1},
+					{Name: "field2", Type: "bytes", ID: 2},
+				}},
+			}},
+			wantConfidence: []string{"high", "high", "high"},
+		},
+		{
+			name: "repeated",
+			samples: repeat(10, func(i int) []byte {
+				var b []byte
+				for j := 0; j < i%3; j++ {
+					b = append(b, bytesField(1, []byte("foo"))...)
+				}
+				return b
+			}),
+			want: &bqpb.Typedefs{Messages: []*bqpb.MessageDef{
+				{Name: "Main", Fields: []*bqpb.FieldDef{
+					{Name: "field1", Type: "string", ID: 1, Repeated: true},
+				}},
+			}},
+			wantConfidence: []string{"high"},
+		},
+		{
+			name: "packed and expanded",
+			samples: repeat(10, func(i int) []byte {
+				if i%2 == 0 {
+					return bytesField(1, []byte{0x01, 0x96, 0x01, 0x03})
+				}
+				return concat(varintField(1, 1), varintField(1, 150))
+			}),
+			want: &bqpb.Typedefs{Messages: []*bqpb.MessageDef{
+				{Name: "Main", Fields: []*bqpb.FieldDef{
+					{Name: "field1", Type: "int32", ID: 1, Repeated: true},
+				}},
+			}},
+			wantConfidence: []string{"high"},
+		},
+		{
+			name: "group",
+			samples: repeat(10, func(i int) []byte {
+				return groupField(1, varintField(2, uint64(i)+1))
+			}),
+			want: &bqpb.Typedefs{Messages: []*bqpb.MessageDef{
+				{Name: "Main", Fields: []*bqpb.FieldDef{
+					{Name: "field1", Type: "Main.Field1", ID: 1, MessageEncoding: bqpb.MessageEncodingDelimited},
+				}},
+				{Name: "Main.Field1", Fields: []*bqpb.FieldDef{
+					{Name: "field2", Type: "int32", ID: 2},
+				}},
+			}},
+			wantConfidence: []string{"high"},
+		},
+		{
+			name: "mixed wire types",
+			samples: repeat(10, func(i int) []byte {
+				if i%2 == 0 {
+					return varintField(1, 1)
+				}
+				return fixed32Field(1, 1)
+			}),
+			want: &bqpb.Typedefs{Messages: []*bqpb.MessageDef{
+				{Name: "Main", Fields: []*bqpb.FieldDef{
+					{Name: "field1", Type: "bool", ID: 1},
+				}},
+			}},
+			wantConfidence: []string{"low"},
+		},
+		{
+			name: "varints mixed with LEN",
+			samples: repeat(10, func(i int) []byte {
+				if i == 0 {
+					// Not a packed varint, as the last byte continues.
+					return bytesField(1, []byte{0x80})
+				}
+				return varintField(1, uint64(i))
+			}),
+			want: &bqpb.Typedefs{Messages: []*bqpb.MessageDef{
+				{Name: "Main", Fields: []*bqpb.FieldDef{
+					{Name: "field1", Type: "int32", ID: 1},
+				}},
+			}},
+			wantConfidence: []string{"low"},
+			wantNote:       "1 of 10 occurrences are LEN",
+		},
+		{
+			name:    "few samples",
+			samples: [][]byte{varintField(1, 42)},
+			want: &bqpb.Typedefs{Messages: []*bqpb.MessageDef{
+				{Name: "Main", Fields: []*bqpb.FieldDef{
+					{Name: "field1", Type: "int32", ID: 1},
+				}},
+			}},
+			wantConfidence: []string{"low"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := skeleton.New()
+			for _, sample := range tc.samples {
+				if err := in.Add(sample); err != nil {
+					t.Fatalf("Add: %v", err)
+				}
+			}
+			got := in.Typedefs("Main")
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreFields(bqpb.FieldDef{}, "Comment")); diff != "" {
+				t.Errorf("Typedefs mismatch (-want +got):\n%s", diff)
+			}
+			var gotConfidence []string
+			for _, fieldDef := range got.Message("Main").Fields {
+				confidence := strings.TrimPrefix(strings.SplitN(fieldDef.Comment, ";", 2)[0], "confidence: ")
+				gotConfidence = append(gotConfidence, confidence)
+			}
+			if diff := cmp.Diff(tc.wantConfidence, gotConfidence); diff != "" {
+				t.Errorf("confidence mismatch (-want +got):\n%s", diff)
+			}
+			if tc.wantNote != "" {
+				if comment := got.Message("Main").Fields[0].Comment; !strings.Contains(comment, tc.wantNote) {
+					t.Errorf("comment = %q, want it to contain %q", comment, tc.wantNote)
+				}
+			}
+		})
+	}
+}
+
+func TestAddInvalid(t *testing.T) {
+	in := skeleton.New()
+	if err := in.Add([]byte{0x08}); err != bqpb.ErrUnexpectedEOF {
+		t.Errorf("Add() = %v, want %v", err, bqpb.ErrUnexpectedEOF)
+	}
+	if n := in.Samples(); n != 0 {
+		t.Errorf("Samples() = %d, want 0", n)
+	}
+}
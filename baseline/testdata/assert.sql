@@ -0,0 +1,461 @@
+-- Generated by the baseline tests; DO NOT EDIT.
+DECLARE typedefs_1 JSON DEFAULT JSON '{"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_2 JSON DEFAULT JSON '{"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_3 JSON DEFAULT JSON '{"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_4 JSON DEFAULT JSON '{"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}},"enum example.RepeatedEnum.MyEnum":{"MY_ENUM_UNSPECIFIED":0,"MY_ENUM_VALUE_1":1,"MY_ENUM_VALUE_2":2}}';
+DECLARE typedefs_5 JSON DEFAULT JSON '{"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}},"enum example.ImplicitEnum.MyEnum":{"MY_ENUM_UNSPECIFIED":0,"MY_ENUM_VALUE_1":1,"MY_ENUM_VALUE_2":2}}';
+DECLARE typedefs_6 JSON DEFAULT JSON '{"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}},"enum example.ExplicitEnum.MyEnum":{"MY_ENUM_UNSPECIFIED":0,"MY_ENUM_VALUE_1":1,"MY_ENUM_VALUE_2":2}}';
+DECLARE typedefs_7 JSON DEFAULT JSON '{"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_8 JSON DEFAULT JSON '{"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_9 JSON DEFAULT JSON '{"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_10 JSON DEFAULT JSON '{"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_11 JSON DEFAULT JSON '{"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_12 JSON DEFAULT JSON '{"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_13 JSON DEFAULT JSON '{"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_14 JSON DEFAULT JSON '{"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_15 JSON DEFAULT JSON '{"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_16 JSON DEFAULT JSON '{"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_17 JSON DEFAULT JSON '{"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_18 JSON DEFAULT JSON '{"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_19 JSON DEFAULT JSON '{"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_20 JSON DEFAULT JSON '{"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_21 JSON DEFAULT JSON '{"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_22 JSON DEFAULT JSON '{"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_23 JSON DEFAULT JSON '{"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_24 JSON DEFAULT JSON '{"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_25 JSON DEFAULT JSON '{"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_26 JSON DEFAULT JSON '{"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_27 JSON DEFAULT JSON '{"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_28 JSON DEFAULT JSON '{"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_29 JSON DEFAULT JSON '{"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_30 JSON DEFAULT JSON '{"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_31 JSON DEFAULT JSON '{"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_32 JSON DEFAULT JSON '{"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_33 JSON DEFAULT JSON '{"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}},"enum example.MapStringEnum.MyEnum":{"MY_ENUM_UNSPECIFIED":0,"MY_ENUM_VALUE_1":1,"MY_ENUM_VALUE_2":2}}';
+DECLARE typedefs_34 JSON DEFAULT JSON '{"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_35 JSON DEFAULT JSON '{"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_36 JSON DEFAULT JSON '{"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_37 JSON DEFAULT JSON '{"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_38 JSON DEFAULT JSON '{"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_39 JSON DEFAULT JSON '{"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_40 JSON DEFAULT JSON '{"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_41 JSON DEFAULT JSON '{"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_42 JSON DEFAULT JSON '{"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_43 JSON DEFAULT JSON '{"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_44 JSON DEFAULT JSON '{"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_45 JSON DEFAULT JSON '{"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE typedefs_46 JSON DEFAULT JSON '{"message example.ImplicitEnum":{"myField":{"type":"example.ImplicitEnum.MyEnum","id":1,"fieldPresence":"implicit"}},"message example.ExplicitEnum":{"myField":{"type":"example.ExplicitEnum.MyEnum","id":1,"fieldPresence":"explicit"}},"message example.RepeatedEnum":{"myField":{"type":"example.RepeatedEnum.MyEnum","id":1,"repeated":true}},"message example.RepeatedBool":{"myField":{"type":"bool","id":1,"repeated":true}},"message example.ImplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"implicit"}},"message example.ExplicitUint32":{"myField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example.RepeatedUint32":{"myField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedInt32":{"myField":{"type":"int32","id":1,"repeated":true}},"message example.RepeatedSint32":{"myField":{"type":"sint32","id":1,"repeated":true}},"message example.RepeatedUint64":{"myField":{"type":"uint64","id":1,"repeated":true}},"message example.RepeatedInt64":{"myField":{"type":"int64","id":1,"repeated":true}},"message example.RepeatedSint64":{"myField":{"type":"sint64","id":1,"repeated":true}},"message example.RepeatedFixed32":{"myField":{"type":"fixed32","id":1,"repeated":true}},"message example.RepeatedSfixed32":{"myField":{"type":"sfixed32","id":1,"repeated":true}},"message example.RepeatedFloat":{"myField":{"type":"float","id":1,"repeated":true}},"message example.RepeatedFixed64":{"myField":{"type":"fixed64","id":1,"repeated":true}},"message example.RepeatedSfixed64":{"myField":{"type":"sfixed64","id":1,"repeated":true}},"message example.RepeatedDouble":{"myField":{"type":"double","id":1,"repeated":true}},"message example.RepeatedBytes":{"myField":{"type":"bytes","id":1,"repeated":true}},"message example.RepeatedString":{"myField":{"type":"string","id":1,"repeated":true}},"message example.ImplicitSubmessage":{"myField":{"type":"example.ImplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ImplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.ExplicitSubmessage":{"myField":{"type":"example.ExplicitSubmessage.Sub","id":1,"fieldPresence":"explicit"}},"message example.ExplicitSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.RepeatedSubmessage":{"myField":{"type":"example.RepeatedSubmessage.Sub","id":1,"repeated":true}},"message example.RepeatedSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapUint32Uint32":{"myField":{"type":"map\\u003cuint32,uint32\\u003e","id":1}},"message example.MapUint32Fixed32":{"myField":{"type":"map\\u003cuint32,fixed32\\u003e","id":1}},"message example.MapUint32Fixed64":{"myField":{"type":"map\\u003cuint32,fixed64\\u003e","id":1}},"message example.MapUint32String":{"myField":{"type":"map\\u003cuint32,string\\u003e","id":1}},"message example.MapFixed32Uint32":{"myField":{"type":"map\\u003cfixed32,uint32\\u003e","id":1}},"message example.MapFixed64Uint32":{"myField":{"type":"map\\u003cfixed64,uint32\\u003e","id":1}},"message example.MapBoolUint32":{"myField":{"type":"map\\u003cbool,uint32\\u003e","id":1}},"message example.MapStringUint32":{"myField":{"type":"map\\u003cstring,uint32\\u003e","id":1}},"message example.MapInt64Uint32":{"myField":{"type":"map\\u003cint64,uint32\\u003e","id":1}},"message example.MapSint64Uint32":{"myField":{"type":"map\\u003csint64,uint32\\u003e","id":1}},"message example.MapSfixed64Uint32":{"myField":{"type":"map\\u003csfixed64,uint32\\u003e","id":1}},"message example.MapStringSubmessage":{"myField":{"type":"map\\u003cstring,example.MapStringSubmessage.Sub\\u003e","id":1}},"message example.MapStringSubmessage.Sub":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example.MapStringEnum":{"myField":{"type":"map\\u003cstring,example.MapStringEnum.MyEnum\\u003e","id":1}},"message example.MapStringUint32Wrapper":{"myField":{"type":"map\\u003cstring,google.protobuf.UInt32Value\\u003e","id":1}},"message example.MapStringStruct":{"myField":{"type":"map\\u003cstring,google.protobuf.Struct\\u003e","id":1}},"message example.Oneof":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"stringField":{"type":"string","id":2,"oneofGroup":"myField"}},"message example.ImplicitUint32Wrapper":{"myField":{"type":"google.protobuf.UInt32Value","id":1,"fieldPresence":"explicit"}},"message example2.RepeatedGroup":{"myField":{"type":"example2.RepeatedGroup.My_field","id":1,"repeated":true,"messageEncoding":"delimited"}},"message example2.RepeatedGroup.My_field":{"submessageField":{"type":"uint32","id":1,"repeated":true}},"message example2.OptionalGroup":{"myField":{"type":"example2.OptionalGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.OptionalGroup.My_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.NestedGroup":{"outerField":{"type":"example2.NestedGroup.Outer_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field":{"innerField":{"type":"example2.NestedGroup.Outer_field.Inner_field","id":2,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.NestedGroup.Outer_field.Inner_field":{"submessageField":{"type":"uint32","id":3,"fieldPresence":"explicit"}},"message example2.OneofGroup":{"uint32Field":{"type":"uint32","id":1,"oneofGroup":"myField"},"groupField":{"type":"example2.OneofGroup.Group_field","id":2,"messageEncoding":"delimited","oneofGroup":"myField"}},"message example2.OneofGroup.Group_field":{"submessageField":{"type":"uint32","id":1,"fieldPresence":"explicit"}},"message example2.RecursiveGroup":{"myField":{"type":"example2.RecursiveGroup.My_field","id":1,"fieldPresence":"explicit","messageEncoding":"delimited"}},"message example2.RecursiveGroup.My_field":{"recursiveField":{"type":"example2.RecursiveGroup","id":2,"fieldPresence":"explicit"}}}';
+DECLARE result JSON;
+DECLARE failed BOOL;
+
+CREATE TEMP FUNCTION parseProtobuf(input BYTES, messageType STRING, typedefs JSON)
+RETURNS JSON DETERMINISTIC
+LANGUAGE js
+AS r"""
+// bqpb v0.1.0
+// (c) 2023, Masaki Hara. Licensed under the MIT License.
+"use strict";function t(t){
+const n=(t+"=").indexOf("="),e=new Uint8Array(.75*n|0);for(let r=0;n>r;r++){
+const n=t.charCodeAt(r),o=.75*r+.75|0,i=n-(96>n?64>n?48>n?47>n?-19:-16:-4:65:71)<<2+r%4*2
+;e[o-1]|=i>>8,e[o]|=i}return e}function n(t){
+const n=new Array(4*((t.length+2)/3|0)),e=(4*t.length+2)/3|0
+;for(let r=0;e>r;r++){const e=.75*r+.75|0,o=(t[e-1]<<8|t[e])>>2+r%4*2&63
+;n[r]=o+(26>o?65:52>o?71:62>o?-4:63>o?-19:-16)}
+for(let t=e;t<n.length;t++)n[t]=61;return String.fromCharCode(...n)}
+function e(t){try{
+return decodeURIComponent(Array.from(t).map((t=>`%${t.toString(16).padStart(2,"0")}`)).join(""))
+}catch(t){throw new Error("Invalid UTF-8 sequence")}}function r(t){
+if(t.p>=t.b.length)throw new Error("Unexpected EOF");return t.b[t.p++]}
+function o(t){let n=0n,e=0n;for(;;){const o=r(t)
+;if(n|=BigInt(127&o)<<e,128>o)break;e+=7n}return n}function i(t,n){let e=0n
+;for(let o=0;n>o;o++){const n=r(t);e|=BigInt(n)<<BigInt(8*o)}return e}
+function u(t,n){const e=[];for(;;){const r=f(t,n);if(null==r)break;e.push(r)}
+return e}function f(t,n){if(null==n&&t.p>=t.b.length)return null
+;const e=o(t),r=Number(7n&e),f=e>>3n;switch(r){case 0:return{f:f,w:r,v:o(t)}
+;case 1:case 5:return{f:f,w:r,v:i(t,1===r?8:4)};case 2:{const n=Number(o(t))
+;if(t.p+n>t.b.length)throw new Error("Unexpected EOF")
+;const e=t.b.subarray(t.p,t.p+n);return t.p+=n,{f:f,w:r,v:e}}case 3:return{f:f,
+w:r,v:u(t,f)};case 4:if(f===n)return null;throw new Error("Invalid group")
+;default:throw new Error("Unexpected wire type")}}function s(t){return u({b:t,
+p:0})}function c(t,n,e){return a(s(t),n,e)}function a(t,n,e){const r=_(t,n,e)
+;return void 0!==r?r:l(t,n,e)}function l(t,r,u){const f={}
+;for(const n of t)(f[n.f]??=[]).push(n);const s={},l=u[`message ${r}`]
+;if(l)for(const[t,n]of Object.entries(l)){const e=f[n.id]??[];delete f[n.id]
+;const r=z(n.type,u,n.messageEncoding),c=null!=n.oneofGroup?"explicit":n.fieldPresence??"explicit"
+;let a,l;if(r===O){const t=e.map((t=>G(t,r,n.type,u))).filter((t=>null!=t))
+;a=Object.fromEntries(t),l=!0}else if(n.repeated){let t=e;if(B>r){t=[]
+;for(const u of e){if(2!==u.w){t.push(u);continue}const e={b:u.v,p:0}
+;for(;e.p<u.v.length;)j>r?t.push({f:BigInt(n.id),w:0,v:o(e)}):t.push({
+f:BigInt(n.id),w:k>r?5:1,v:i(e,k>r?4:8)})}}a=t.map((t=>G(t,r,n.type,u))),l=!0
+}else"explicit"===c?(a=e.length>0?G(e[e.length-1],r,n.type,u):null,
+l=e.length>0):(a=e.length>0?G(e[e.length-1],r,n.type,u):W(r,n.type,u),l=!0)
+;l&&(s[t]=a)}for(const t of Object.values(f)){const r=t[0].f,o=t.map((t=>{
+if(2===t.w){try{const n=e(t.v)
+;if(!/[\0-\x08\x0b-\x1F\x7F]/.test(n))return`unknown:string:${n}`}catch(t){}
+try{return c(t.v,"",u)}catch(t){}return`unknown:bytes:${n(t.v)}`}
+if(3===t.w)return a(t.v,"",u);{
+const n=1===t.w?"double":5===t.w?"float":0x100000000n>t.v?"int32":"int64"
+;return`unknown:${n}:${G(t,z(n,u),n,u)}`}}));s[`#${r}`]=1===o.length?o[0]:o}
+return s}
+const p=0,w=1,g=8,d=9,b=11,h=12,v=13,m=15,y=16,F=17,x=19,$=20,E=21,S=23,I=24,O=25,L=26,U=27,A=28,j=16,k=20,B=24,N=28
+;function V(t){return!(3&~t)}function C(t){return!(1&~t)}function T(t){
+return!(4&~t)}function D(t){return t>=w&&E>=t&&t!=x||t===U}function W(t,n,e){
+if(t===p){const t=e[`enum ${n}`];return Object.entries(t)[0][0]??0}return Z[t]}
+const Z={[w]:!1,[g]:0,[d]:0,[b]:0,[h]:"0",[v]:"0",[m]:"0",[y]:0,[F]:0,[x]:0,
+[$]:"0",[E]:"0",[S]:0,[I]:null,[L]:"",[U]:""},q={bool:w,uint32:g,int32:d,
+sint32:b,uint64:h,int64:v,sint64:m,fixed32:y,sfixed32:F,float:x,fixed64:$,
+sfixed64:E,double:S,bytes:L,string:U};function z(t,n,e){
+return t in q?q[t]:t.startsWith("map<")?O:`enum ${t}`in n?p:"delimited"===e?A:I
+}function G(t,r,o,i){if(B>r){const n=j>r?0:k>r?5:1
+;if(t.w!==n)throw new Error(`Expected wire type ${n}, got ${t.w}`)
+;if(r===w)return!!t.v;if(r===p){const n=i[`enum ${o}`]
+;for(const[e,r]of Object.entries(n))if(r===Number(t.v))return e
+;return Number(t.v)}if(r===x)return J(P(t.v));if(r===S)return J(M(t.v))
+;const e=V(r)?t.v>>1n^-(1n&t.v):C(r)?T(r)?(0xFFFFFFFFFFFFFFFFn&t.v)-((t.v>>63n&1n)<<64n):(0xFFFFFFFFn&t.v)-((t.v>>31n&1n)<<32n):t.v
+;return T(r)?String(e):Number(e)}if(N>r){
+if(2!==t.w)throw new Error(`Expected wire type 2, got ${t.w}`)
+;if(r===L)return n(t.v);if(r===U)return e(t.v);if(r===O){
+const n=o.indexOf(","),e=o.lastIndexOf(">"),r=o.slice(4,n).trim(),u=o.slice(n+1,e).trim(),f=z(r,i),c=z(u,i)
+;if(!D(f)||c===O)throw new Error("Invalid map type")
+;const a=s(t.v),l=a.findLast((t=>1n===t.f)),p=a.findLast((t=>2n===t.f))
+;if(!l)return null;const w=G(l,f,r,i);if(!p){return[w,W(c,u,i)]}
+return[w,G(p,c,u,i)]}return c(t.v,o,i)}
+if(3!==t.w)throw new Error(`Expected wire type 3, got ${t.w}`)
+;return a(t.v,o,i)}function J(t){return 1/t==0||t!=t?`${t}`:t}function M(t){
+return new Float64Array(BigUint64Array.of(t).buffer)[0]}function P(t){
+return new Float32Array(Uint32Array.of(Number(t)).buffer)[0]}function R(n,e,r){
+return c(t(n),e,r)}function _(t,n,e){
+if(!n.startsWith("google.protobuf."))return;const r=n.slice(16)
+;if(/^(U?Int(32|64)|Double|Float|Bool|String|Bytes)Value$/.test(r)){
+const n=r.slice(0,-5).toLowerCase(),o=z(n,e),i=t.findLast((t=>1n===t.f))
+;return i?G(i,o,n,e):W(o,n,e)}switch(r){case"Any":{
+const n=t.findLast((t=>1n===t.f)),r=t.findLast((t=>2n===t.f)),o=n?G(n,U,"string",e):""
+;if(r&&2!==r.w)throw new Error(`Expected wire type 2, got ${r.w}`)
+;const i=r?.v??new Uint8Array;if(o.startsWith("type.googleapis.com/")){
+const t=o.slice(20),n=s(i),r=_(n,t,e);return void 0!==r?{"@type":o,value:r}:{
+"@type":o,...l(n,t,e)}}break}case"Value":{
+const n=t.findLast((t=>t.f>=1n&&7n>=t.f))
+;if(!n)throw new Error("Invalid JSON Value");switch(n.f){case 1n:
+return G(n,g,"uint32",e),null;case 2n:return G(n,S,"bool",e);case 3n:
+return G(n,U,"string",e);case 4n:return G(n,w,"bool",e);case 5n:
+return G(n,I,"google.protobuf.Struct",e);case 6n:
+return G(n,I,"google.protobuf.ListValue",e)}break}case"Struct":{
+const n=t.filter((t=>1n===t.f)).map((t=>G(t,O,"map<string,google.protobuf.Value>",e)))
+;return Object.fromEntries(n)}case"ListValue":
+return t.filter((t=>1n===t.f)).map((t=>G(t,I,"google.protobuf.Value",e)))
+;case"FieldMask":
+return t.filter((t=>1n===t.f)).map((t=>G(t,U,"string",e))).map((t=>t.replace(/_([a-z])/g,((t,n)=>n.toUpperCase())))).join(",")
+;case"Timestamp":case"Duration":{
+const n=t.findLast((t=>1n===t.f)),o=t.findLast((t=>2n===t.f)),i=n?Number(G(n,v,"",e)):0,u=o?G(o,d,"",e):0
+;if("Timestamp"===r){
+return new Date(1e3*i+u/1e6).toISOString().replace(/Z$/,`${(u%1e6).toString().padStart(6,"0")}Z`)
+}
+return 0>i?`-${-i}.${(-u).toString().padStart(9,"0")}s`:`${i}.${u.toString().padStart(9,"0")}s`
+}}}return R(input,messageType,typedefs);
+""";
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x01', 'example.ImplicitUint32', typedefs_1)) = TO_JSON_STRING(JSON '{"myField":1}') AS 'Parse field with implicit presence of size 1';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'', 'example.ImplicitUint32', typedefs_1)) = TO_JSON_STRING(JSON '{"myField":0}') AS 'Parse field with implicit presence of size 0';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x01\x08\x02', 'example.ImplicitUint32', typedefs_1)) = TO_JSON_STRING(JSON '{"myField":2}') AS 'Pick the last one on duplicate in field with implicit presence';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x01', 'example.ExplicitUint32', typedefs_2)) = TO_JSON_STRING(JSON '{"myField":1}') AS 'Parse field with explicit presence of size 1';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'', 'example.ExplicitUint32', typedefs_2)) = TO_JSON_STRING(JSON '{}') AS 'Parse field with explicit presence of size 2';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x01\x08\x02', 'example.ExplicitUint32', typedefs_2)) = TO_JSON_STRING(JSON '{"myField":2}') AS 'Pick the last one on duplicate in field with explicit presence';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x01', 'example.RepeatedUint32', typedefs_3)) = TO_JSON_STRING(JSON '{"myField":[1]}') AS 'Parse non-repeated field of size 1';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'', 'example.RepeatedUint32', typedefs_3)) = TO_JSON_STRING(JSON '{"myField":[]}') AS 'Parse non-repeated field of size 0';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x01\x08\x02', 'example.RepeatedUint32', typedefs_3)) = TO_JSON_STRING(JSON '{"myField":[1,2]}') AS 'Parse non-repeated fiel of size 2';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x00\x08\x01\x08\x02\x08\x03', 'example.RepeatedEnum', typedefs_4)) = TO_JSON_STRING(JSON '{"myField":["MY_ENUM_UNSPECIFIED","MY_ENUM_VALUE_1","MY_ENUM_VALUE_2",3]}') AS 'enum';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'', 'example.ImplicitEnum', typedefs_5)) = TO_JSON_STRING(JSON '{"myField":"MY_ENUM_UNSPECIFIED"}') AS 'enum with implicit presene with default value';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'', 'example.ExplicitEnum', typedefs_6)) = TO_JSON_STRING(JSON '{}') AS 'enum with explicit presence with default value';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x00\x08\x01', 'example.RepeatedBool', typedefs_7)) = TO_JSON_STRING(JSON '{"myField":[false,true]}') AS 'bool';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x00\x08\x01\x08\x02\x08\xff\xff\xff\xff\x0f', 'example.RepeatedUint32', typedefs_3)) = TO_JSON_STRING(JSON '{"myField":[0,1,2,4294967295]}') AS 'uint32';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x00\x08\x01\x08\x02\x08\xff\xff\xff\xff\x0f', 'example.RepeatedInt32', typedefs_8)) = TO_JSON_STRING(JSON '{"myField":[0,1,2,-1]}') AS 'int32';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x00\x08\x01\x08\x02\x08\x03\x08\x04', 'example.RepeatedSint32', typedefs_9)) = TO_JSON_STRING(JSON '{"myField":[0,-1,1,-2,2]}') AS 'sint32';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x00\x08\x01\x08\x02\x08\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01', 'example.RepeatedUint64', typedefs_10)) = TO_JSON_STRING(JSON '{"myField":["0","1","2","18446744073709551615"]}') AS 'uint64';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x00\x08\x01\x08\x02\x08\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01', 'example.RepeatedInt64', typedefs_11)) = TO_JSON_STRING(JSON '{"myField":["0","1","2","-1"]}') AS 'int64';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x00\x08\x01\x08\x02\x08\x03\x08\x04', 'example.RepeatedSint64', typedefs_12)) = TO_JSON_STRING(JSON '{"myField":["0","-1","1","-2","2"]}') AS 'sint64';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x08\x00\x01\x02\xff\xff\xff\xff\x0f', 'example.RepeatedUint32', typedefs_3)) = TO_JSON_STRING(JSON '{"myField":[0,1,2,4294967295]}') AS 'packed varint';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\r\x00\x00\x00\x00\r\x01\x00\x00\x00\r\x02\x00\x00\x00\r\xff\xff\xff\xff', 'example.RepeatedFixed32', typedefs_13)) = TO_JSON_STRING(JSON '{"myField":[0,1,2,4294967295]}') AS 'fixed32';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\r\x00\x00\x00\x00\r\x01\x00\x00\x00\r\x02\x00\x00\x00\r\xff\xff\xff\xff', 'example.RepeatedSfixed32', typedefs_14)) = TO_JSON_STRING(JSON '{"myField":[0,1,2,-1]}') AS 'sfixed32';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\r\x00\x00\x00\x00\r\x00\x00\x00\x80\r\x00\x00\x80?\r\x00\x00\x80\xbf\r\x00\x00\xc0?\r\x00\x00\xc0\xbf\r\x00\x00\x80\x7f\r\x00\x00\x80\xff\r\x00\x00\xc0\x7f\r\x00\x00\xc0\xff', 'example.RepeatedFloat', typedefs_15)) = TO_JSON_STRING(JSON '{"myField":[0,0,1,-1,1.5,-1.5,"Infinity","-Infinity","NaN","NaN"]}') AS 'float';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x10\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\xff\xff\xff\xff', 'example.RepeatedFixed32', typedefs_13)) = TO_JSON_STRING(JSON '{"myField":[0,1,2,4294967295]}') AS 'packed I32';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\t\x00\x00\x00\x00\x00\x00\x00\x00\t\x01\x00\x00\x00\x00\x00\x00\x00\t\x02\x00\x00\x00\x00\x00\x00\x00\t\xff\xff\xff\xff\xff\xff\xff\xff', 'example.RepeatedFixed64', typedefs_16)) = TO_JSON_STRING(JSON '{"myField":["0","1","2","18446744073709551615"]}') AS 'fixed64';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\t\x00\x00\x00\x00\x00\x00\x00\x00\t\x01\x00\x00\x00\x00\x00\x00\x00\t\x02\x00\x00\x00\x00\x00\x00\x00\t\xff\xff\xff\xff\xff\xff\xff\xff', 'example.RepeatedSfixed64', typedefs_17)) = TO_JSON_STRING(JSON '{"myField":["0","1","2","-1"]}') AS 'sfixed64';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\t\x00\x00\x00\x00\x00\x00\x00\x00\t\x00\x00\x00\x00\x00\x00\x00\x80\t\x00\x00\x00\x00\x00\x00\xf0?\t\x00\x00\x00\x00\x00\x00\xf0\xbf\t\x00\x00\x00\x00\x00\x00\xf8?\t\x00\x00\x00\x00\x00\x00\xf8\xbf\t\x00\x00\x00\x00\x00\x00\xf0\x7f\t\x00\x00\x00\x00\x00\x00\xf0\xff\t\x00\x00\x00\x00\x00\x00\xf8\x7f\t\x00\x00\x00\x00\x00\x00\xf8\xff', 'example.RepeatedDouble', typedefs_18)) = TO_JSON_STRING(JSON '{"myField":[0,0,1,-1,1.5,-1.5,"Infinity","-Infinity","NaN","NaN"]}') AS 'double';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n \x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\xff\xff\xff\xff\xff\xff\xff\xff', 'example.RepeatedFixed64', typedefs_16)) = TO_JSON_STRING(JSON '{"myField":["0","1","2","18446744073709551615"]}') AS 'packed I64';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x04\x00\x01\x02\x03', 'example.RepeatedEnum', typedefs_4)) = TO_JSON_STRING(JSON '{"myField":["MY_ENUM_UNSPECIFIED","MY_ENUM_VALUE_1","MY_ENUM_VALUE_2",3]}') AS 'packed enum';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x03\x00\x01\x02', 'example.RepeatedBool', typedefs_7)) = TO_JSON_STRING(JSON '{"myField":[false,true,true]}') AS 'packed bool';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x05\x00\x01\x02\x03\x04', 'example.RepeatedSint32', typedefs_9)) = TO_JSON_STRING(JSON '{"myField":[0,-1,1,-2,2]}') AS 'packed sint32';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x05\x00\x01\x02\x03\x04', 'example.RepeatedSint64', typedefs_12)) = TO_JSON_STRING(JSON '{"myField":["0","-1","1","-2","2"]}') AS 'packed sint64';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x0c\x00\x00\x00\x00\x00\x00\xc0?\x00\x00\x80\xff', 'example.RepeatedFloat', typedefs_15)) = TO_JSON_STRING(JSON '{"myField":[0,1.5,"-Infinity"]}') AS 'packed float';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x10\x00\x00\x00\x00\x00\x00\xf8\xbf\x00\x00\x00\x00\x00\x00\xf8\x7f', 'example.RepeatedDouble', typedefs_18)) = TO_JSON_STRING(JSON '{"myField":[-1.5,"NaN"]}') AS 'packed double';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x01\n\x02\x02\x03\x08\x04\n\x01\x05', 'example.RepeatedUint32', typedefs_3)) = TO_JSON_STRING(JSON '{"myField":[1,2,3,4,5]}') AS 'packed and expanded interleaved';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\r\x01\x00\x00\x00\n\x08\x02\x00\x00\x00\x03\x00\x00\x00\r\xff\xff\xff\xff', 'example.RepeatedSfixed32', typedefs_14)) = TO_JSON_STRING(JSON '{"myField":[1,2,3,-1]}') AS 'packed and expanded I32 interleaved';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x00', 'example.RepeatedUint32', typedefs_3)) = TO_JSON_STRING(JSON '{"myField":[]}') AS 'empty packed';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x01\n\x00\x08\x02\n\x00', 'example.RepeatedUint32', typedefs_3)) = TO_JSON_STRING(JSON '{"myField":[1,2]}') AS 'empty packed between expanded';
+
+-- want error: Unexpected EOF
+BEGIN
+  SET result = parseProtobuf(b'\n\x02\x01\x80', 'example.RepeatedUint32', typedefs_3);
+  SET failed = FALSE;
+EXCEPTION WHEN ERROR THEN
+  SET failed = TRUE;
+END;
+ASSERT failed AS 'packed varint with truncated final element';
+
+-- want error: Unexpected EOF
+BEGIN
+  SET result = parseProtobuf(b'\n\x06\x01\x00\x00\x00\x02\x00', 'example.RepeatedFixed32', typedefs_13);
+  SET failed = FALSE;
+EXCEPTION WHEN ERROR THEN
+  SET failed = TRUE;
+END;
+ASSERT failed AS 'packed I32 with truncated final element';
+
+-- want error: Unexpected EOF
+BEGIN
+  SET result = parseProtobuf(b'\n\n\x01\x00\x00\x00\x00\x00\x00\x00\x02\x00', 'example.RepeatedFixed64', typedefs_16);
+  SET failed = FALSE;
+EXCEPTION WHEN ERROR THEN
+  SET failed = TRUE;
+END;
+ASSERT failed AS 'packed I64 with truncated final element';
+
+-- want error: Expected wire type 0, got 2
+BEGIN
+  SET result = parseProtobuf(b'\n\x01\x05', 'example.ImplicitUint32', typedefs_1);
+  SET failed = FALSE;
+EXCEPTION WHEN ERROR THEN
+  SET failed = TRUE;
+END;
+ASSERT failed AS 'LEN-encoded singular uint32';
+
+-- want error: Expected wire type 2, got 0
+BEGIN
+  SET result = parseProtobuf(b'\n\x01a\x08\x01', 'example.RepeatedString', typedefs_19);
+  SET failed = FALSE;
+EXCEPTION WHEN ERROR THEN
+  SET failed = TRUE;
+END;
+ASSERT failed AS 'VARINT-encoded repeated string';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x00\n\x06\x00\x01\x02\x80\x81\x82', 'example.RepeatedBytes', typedefs_20)) = TO_JSON_STRING(JSON '{"myField":["","AAECgIGC"]}') AS 'bytes';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x00\n\x06abc\xe3\x81\x82', 'example.RepeatedString', typedefs_19)) = TO_JSON_STRING(JSON '{"myField":["","abcあ"]}') AS 'string';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x02\x08*', 'example.RepeatedSubmessage', typedefs_21)) = TO_JSON_STRING(JSON '{"myField":[{"submessageField":[42]}]}') AS 'submessage';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'', 'example.ImplicitSubmessage', typedefs_22)) = TO_JSON_STRING(JSON '{}') AS 'submessage with implicit presence with default value';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'', 'example.ExplicitSubmessage', typedefs_23)) = TO_JSON_STRING(JSON '{}') AS 'submessage with explicit presence with default value';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x04\x08*\x10d\n\x04\x08+\x10e', 'example.MapUint32Uint32', typedefs_24)) = TO_JSON_STRING(JSON '{"myField":{"42":100,"43":101}}') AS 'map base case';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x07\x08*\x15d\x00\x00\x00\n\x07\x08+\x15e\x00\x00\x00', 'example.MapUint32Fixed32', typedefs_25)) = TO_JSON_STRING(JSON '{"myField":{"42":100,"43":101}}') AS 'map with I32 value';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x0b\x08*\x11d\x00\x00\x00\x00\x00\x00\x00\n\x0b\x08+\x11e\x00\x00\x00\x00\x00\x00\x00', 'example.MapUint32Fixed64', typedefs_26)) = TO_JSON_STRING(JSON '{"myField":{"42":"100","43":"101"}}') AS 'map with I64 value';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x07\x08*\x12\x03\xe3\x81\x82\n\x07\x08+\x12\x03\xe3\x81\x84', 'example.MapUint32String', typedefs_27)) = TO_JSON_STRING(JSON '{"myField":{"42":"あ","43":"い"}}') AS 'map with LEN value';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x07\r*\x00\x00\x00\x10d\n\x07\r+\x00\x00\x00\x10e', 'example.MapFixed32Uint32', typedefs_28)) = TO_JSON_STRING(JSON '{"myField":{"42":100,"43":101}}') AS 'map with I32 key';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x0b\t*\x00\x00\x00\x00\x00\x00\x00\x10d\n\x0b\t+\x00\x00\x00\x00\x00\x00\x00\x10e', 'example.MapFixed64Uint32', typedefs_29)) = TO_JSON_STRING(JSON '{"myField":{"42":100,"43":101}}') AS 'map with I64 key';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x04\x08\x00\x10d\n\x04\x08\x01\x10e', 'example.MapBoolUint32', typedefs_30)) = TO_JSON_STRING(JSON '{"myField":{"false":100,"true":101}}') AS 'map with bool key';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x07\n\x03\xe3\x81\x82\x10d\n\x07\n\x03\xe3\x81\x84\x10e', 'example.MapStringUint32', typedefs_31)) = TO_JSON_STRING(JSON '{"myField":{"あ":100,"い":101}}') AS 'map with string key';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x05\n\x03\xe3\x81\x82\n\x05\n\x03\xe3\x81\x84', 'example.MapStringUint32', typedefs_31)) = TO_JSON_STRING(JSON '{"myField":{"あ":0,"い":0}}') AS 'map with missing value';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x05\n\x01a\x10\x01\n\x05\n\x01a\x10\x02', 'example.MapStringUint32', typedefs_31)) = TO_JSON_STRING(JSON '{"myField":{"a":2}}') AS 'map: duplicate key';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x02\x10d', 'example.MapStringUint32', typedefs_31)) = TO_JSON_STRING(JSON '{"myField":{}}') AS 'map: missing key';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x00', 'example.MapStringUint32', typedefs_31)) = TO_JSON_STRING(JSON '{"myField":{}}') AS 'map: empty entry';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x08\n\x01a\n\x01b\x10d', 'example.MapStringUint32', typedefs_31)) = TO_JSON_STRING(JSON '{"myField":{"b":100}}') AS 'map: duplicate key within entry';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x0e\x18\x07\n\x01a"\x00\x10d-\x01\x00\x00\x00', 'example.MapStringUint32', typedefs_31)) = TO_JSON_STRING(JSON '{"myField":{"a":100}}') AS 'map: unknown fields in entry';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x07\n\x01a\x12\x02\x08*', 'example.MapStringSubmessage', typedefs_32)) = TO_JSON_STRING(JSON '{"myField":{"a":{"submessageField":[42]}}}') AS 'map: message value';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x03\n\x01a', 'example.MapStringSubmessage', typedefs_32)) = TO_JSON_STRING(JSON '{"myField":{"a":null}}') AS 'map: missing message value';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x0b\n\x01a\x12\x02\x08\x01\x12\x02\x08\x02', 'example.MapStringSubmessage', typedefs_32)) = TO_JSON_STRING(JSON '{"myField":{"a":{"submessageField":[2]}}}') AS 'map: message value split in entry';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x05\n\x01a\x10\x01\n\x05\n\x01b\x10\x05\n\x03\n\x01c', 'example.MapStringEnum', typedefs_33)) = TO_JSON_STRING(JSON '{"myField":{"a":"MY_ENUM_VALUE_1","b":5,"c":"MY_ENUM_UNSPECIFIED"}}') AS 'map: enum value';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x07\n\x01a\x12\x02\x08*\n\x05\n\x01b\x12\x00\n\x03\n\x01c', 'example.MapStringUint32Wrapper', typedefs_34)) = TO_JSON_STRING(JSON '{"myField":{"a":42,"b":0,"c":null}}') AS 'map: wrapper value';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x0e\n\x01a\x12\t\n\x07\n\x01x\x12\x02\x08\x00\n\x03\n\x01b', 'example.MapStringStruct', typedefs_35)) = TO_JSON_STRING(JSON '{"myField":{"a":{"x":null},"b":null}}') AS 'map: Struct value';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x04\x08\x01\x10d\n\r\x08\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01\x10e\n\x0b\x08\x81\x80\x80\x80\x80\x80\x80\x10\x10f', 'example.MapInt64Uint32', typedefs_36)) = TO_JSON_STRING(JSON '{"myField":{"1":100,"-1":101,"9007199254740993":102}}') AS 'map: int64 key';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x04\x08\x02\x10d\n\x04\x08\x01\x10e\n\x0b\x08\x82\x80\x80\x80\x80\x80\x80 \x10f', 'example.MapSint64Uint32', typedefs_37)) = TO_JSON_STRING(JSON '{"myField":{"1":100,"-1":101,"9007199254740993":102}}') AS 'map: sint64 key';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x0b\t\x01\x00\x00\x00\x00\x00\x00\x00\x10d\n\x0b\t\xff\xff\xff\xff\xff\xff\xff\xff\x10e\n\x0b\t\x01\x00\x00\x00\x00\x00 \x00\x10f', 'example.MapSfixed64Uint32', typedefs_38)) = TO_JSON_STRING(JSON '{"myField":{"1":100,"-1":101,"9007199254740993":102}}') AS 'map: sfixed64 key';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x04\x08\x01\x10e\n\x04\x08\x00\x10d', 'example.MapBoolUint32', typedefs_30)) = TO_JSON_STRING(JSON '{"myField":{"true":101,"false":100}}') AS 'map: bool key ordering';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x0b\x08*\x0c', 'example2.RepeatedGroup', typedefs_39)) = TO_JSON_STRING(JSON '{"myField":[{"submessageField":[42]}]}') AS 'group';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x0b\x08*\x0c', 'example2.OptionalGroup', typedefs_40)) = TO_JSON_STRING(JSON '{"myField":{"submessageField":42}}') AS 'optional group';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x0b\x0c', 'example2.OptionalGroup', typedefs_40)) = TO_JSON_STRING(JSON '{"myField":{}}') AS 'optional group: empty';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'', 'example2.OptionalGroup', typedefs_40)) = TO_JSON_STRING(JSON '{}') AS 'optional group: missing';
+
+-- want error: Expected wire type 3, got 2
+BEGIN
+  SET result = parseProtobuf(b'\n\x02\x08*', 'example2.OptionalGroup', typedefs_40);
+  SET failed = FALSE;
+EXCEPTION WHEN ERROR THEN
+  SET failed = TRUE;
+END;
+ASSERT failed AS 'optional group: LEN-encoded';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x0b\x13\x18*\x14\x0c', 'example2.NestedGroup', typedefs_41)) = TO_JSON_STRING(JSON '{"outerField":{"innerField":{"submessageField":42}}}') AS 'nested group';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x13\x08*\x14', 'example2.OneofGroup', typedefs_42)) = TO_JSON_STRING(JSON '{"groupField":{"submessageField":42}}') AS 'group in oneof';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x13\x08*\x14\x08\x01', 'example2.OneofGroup', typedefs_42)) = TO_JSON_STRING(JSON '{"uint32Field":1,"groupField":{"submessageField":42}}') AS 'group in oneof followed by another member';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x13\x08*\x14', 'example2.RepeatedGroup', typedefs_39)) = TO_JSON_STRING(JSON '{"myField":[],"#2":{"#1":"unknown:int32:42"}}') AS 'unknown group';
+
+-- want error: Invalid group
+BEGIN
+  SET result = parseProtobuf(b'\x0b\x08*\x14', 'example2.OptionalGroup', typedefs_40);
+  SET failed = FALSE;
+EXCEPTION WHEN ERROR THEN
+  SET failed = TRUE;
+END;
+ASSERT failed AS 'group: END_GROUP with wrong field number';
+
+-- want error: Invalid group
+BEGIN
+  SET result = parseProtobuf(b'\x13\x1c', 'example2.OptionalGroup', typedefs_40);
+  SET failed = FALSE;
+EXCEPTION WHEN ERROR THEN
+  SET failed = TRUE;
+END;
+ASSERT failed AS 'unknown group: END_GROUP with wrong field number';
+
+-- want error: Invalid group
+BEGIN
+  SET result = parseProtobuf(b'\x0c', 'example2.OptionalGroup', typedefs_40);
+  SET failed = FALSE;
+EXCEPTION WHEN ERROR THEN
+  SET failed = TRUE;
+END;
+ASSERT failed AS 'group: stray END_GROUP';
+
+-- want error: Unexpected EOF
+BEGIN
+  SET result = parseProtobuf(b'\x0b\x08*', 'example2.OptionalGroup', typedefs_40);
+  SET failed = FALSE;
+EXCEPTION WHEN ERROR THEN
+  SET failed = TRUE;
+END;
+ASSERT failed AS 'group: missing END_GROUP';
+
+-- want error: Maximum call stack size exceeded
+BEGIN
+  SET result = parseProtobuf(b'\x0b\x12\xb0\xd0\x01\x0b\x12\xaa\xd0\x01\x0b\x12\xa4\xd0\x01\x0b\x12\x9e\xd0\x01\x0b\x12\x98\xd0\x01\x0b\x12\x92\xd0\x01\x0b\x12\x8c\xd0\x01\x0b\x12\x86\xd0\x01\x0b\x12\x80\xd0\x01\x0b\x12\xfa\xcf\x01\x0b\x12\xf4\xcf\x01\x0b\x12\xee\xcf\x01\x0b\x12\xe8\xcf\x01\x0b\x12\xe2\xcf\x01\x0b\x12\xdc\xcf\x01\x0b\x12\xd6\xcf\x01\x0b\x12\xd0\xcf\x01\x0b\x12\xca\xcf\x01\x0b\x12\xc4\xcf\x01\x0b\x12\xbe\xcf\x01\x0b\x12\xb8\xcf\x01\x0b\x12\xb2\xcf\x01\x0b\x12\xac\xcf\x01\x0b\x12\xa6\xcf\x01\x0b\x12\xa0\xcf\x01\x0b\x12\x9a\xcf\x01\x0b\x12\x94\xcf\x01\x0b\x12\x8e\xcf\x01\x0b\x12\x88\xcf\x01\x0b\x12\x82\xcf\x01\x0b\x12\xfc\xce\x01\x0b\x12\xf6\xce\x01\x0b\x12\xf0\xce\x01\x0b\x12\xea\xce\x01\x0b\x12\xe4\xce\x01\x0b\x12\xde\xce\x01\x0b\x12\xd8\xce\x01\x0b\x12\xd2\xce\x01\x0b\x12\xcc\xce\x01\x0b\x12\xc6\xce\x01\x0b\x12\xc0\xce\x01\x0b\x12\xba\xce\x01\x0b\x12\xb4\xce\x01\x0b\x12\xae\xce\x01\x0b\x12\xa8\xce\x01\x0b\x12\xa2\xce\x01\x0b\x12\x9c\xce\x01\x0b\x12\x96\xce\x01\x0b\x12\x90\xce\x01\x0b\x12\x8a\xce\x01\x0b\x12\x84\xce\x01\x0b\x12\xfe\xcd\x01\x0b\x12\xf8\xcd\x01\x0b\x12\xf2\xcd\x01\x0b\x12\xec\xcd\x01\x0b\x12\xe6\xcd\x01\x0b\x12\xe0\xcd\x01\x0b\x12\xda\xcd\x01\x0b\x12\xd4\xcd\x01\x0b\x12\xce\xcd\x01\x0b\x12\xc8\xcd\x01\x0b\x12\xc2\xcd\x01\x0b\x12\xbc\xcd\x01\x0b\x12\xb6\xcd\x01\x0b\x12\xb0\xcd\x01\x0b\x12\xaa\xcd\x01\x0b\x12\xa4\xcd\x01\x0b\x12\x9e\xcd\x01\x0b\x12\x98\xcd\x01\x0b\x12\x92\xcd\x01\x0b\x12\x8c\xcd\x01\x0b\x12\x86\xcd\x01\x0b\x12\x80\xcd\x01\x0b\x12\xfa\xcc\x01\x0b\x12\xf4\xcc\x01\x0b\x12\xee\xcc\x01\x0b\x12\xe8\xcc\x01\x0b\x12\xe2\xcc\x01\x0b\x12\xdc\xcc\x01\x0b\x12\xd6\xcc\x01\x0b\x12\xd0\xcc\x01\x0b\x12\xca\xcc\x01\x0b\x12\xc4\xcc\x01\x0b\x12\xbe\xcc\x01\x0b\x12\xb8\xcc\x01\x0b\x12\xb2\xcc\x01\x0b\x12\xac\xcc\x01\x0b\x12\xa6\xcc\x01\x0b\x12\xa0\xcc\x01\x0b\x12\x9a\xcc\x01\x0b\x12\x94\xcc\x01\x0b\x12\x8e\xcc\x01\x0b\x12\x88\xcc\x01\x0b\x12\x82\xcc\x01\x0b\x12\xfc\xcb\x01\x0b\x12\xf6\xcb\x01\x0b\x12\xf0\xcb\x01\x0b\x12\xea\xcb\x01\x0b\x12\xe4\xcb\x01\x0b\x12\xde\xcb\x01\x0b\x12\xd8\xcb\x01\x0b\x12\xd2\xcb\x01\x0b\x12\xcc\xcb\x01\x0b\x12\xc6\xcb\x01\x0b\x12\xc0\xcb\x01\x0b\x12\xba\xcb\x01\x0b\x12\xb4\xcb\x01\x0b\x12\xae\xcb\x01\x0b\x12\xa8\xcb\x01\x0b\x12\xa2\xcb\x01\x0b\x12\x9c\xcb\x01\x0b\x12\x96\xcb\x01\x0b\x12\x90\xcb\x01\x0b\x12\x8a\xcb\x01\x0b\x12\x84\xcb\x01\x0b\x12\xfe\xca\x01\x0b\x12\xf8\xca\x01\x0b\x12\xf2\xca\x01\x0b\x12\xec\xca\x01\x0b\x12\xe6\xca\x01\x0b\x12\xe0\xca\x01\x0b\x12\xda\xca\x01\x0b\x12\xd4\xca\x01\x0b\x12\xce\xca\x01\x0b\x12\xc8\xca\x01\x0b\x12\xc2\xca\x01\x0b\x12\xbc\xca\x01\x0b\x12\xb6\xca\x01\x0b\x12\xb0\xca\x01\x0b\x12\xaa\xca\x01\x0b\x12\xa4\xca\x01\x0b\x12\x9e\xca\x01\x0b\x12\x98\xca\x01\x0b\x12\x92\xca\x01\x0b\x12\x8c\xca\x01\x0b\x12\x86\xca\x01\x0b\x12\x80\xca\x01\x0b\x12\xfa\xc9\x01\x0b\x12\xf4\xc9\x01\x0b\x12\xee\xc9\x01\x0b\x12\xe8\xc9\x01\x0b\x12\xe2\xc9\x01\x0b\x12\xdc\xc9\x01\x0b\x12\xd6\xc9\x01\x0b\x12\xd0\xc9\x01\x0b\x12\xca\xc9\x01\x0b\x12\xc4\xc9\x01\x0b\x12\xbe\xc9\x01\x0b\x12\xb8\xc9\x01\x0b\x12\xb2\xc9\x01\x0b\x12\xac\xc9\x01\x0b\x12\xa6\xc9\x01\x0b\x12\xa0\xc9\x01\x0b\x12\x9a\xc9\x01\x0b\x12\x94\xc9\x01\x0b\x12\x8e\xc9\x01\x0b\x12\x88\xc9\x01\x0b\x12\x82\xc9\x01\x0b\x12\xfc\xc8\x01\x0b\x12\xf6\xc8\x01\x0b\x12\xf0\xc8\x01\x0b\x12\xea\xc8\x01\x0b\x12\xe4\xc8\x01\x0b\x12\xde\xc8\x01\x0b\x12\xd8\xc8\x01\x0b\x12\xd2\xc8\x01\x0b\x12\xcc\xc8\x01\x0b\x12\xc6\xc8\x01\x0b\x12\xc0\xc8\x01\x0b\x12\xba\xc8\x01\x0b\x12\xb4\xc8\x01\x0b\x12\xae\xc8\x01\x0b\x12\xa8\xc8\x01\x0b\x12\xa2\xc8\x01\x0b\x12\x9c\xc8\x01\x0b\x12\x96\xc8\x01\x0b\x12\x90\xc8\x01\x0b\x12\x8a\xc8\x01\x0b\x12\x84\xc8\x01\x0b\x12\xfe\xc7\x01\x0b\x12\xf8\xc7\x01\x0b\x12\xf2\xc7\x01\x0b\x12\xec\xc7\x01\x0b\x12\xe6\xc7\x01\x0b\x12\xe0\xc7\x01\x0b\x12\xda\xc7\x01\x0b\x12\xd4\xc7\x01\x0b\x12\xce\xc7\x01\x0b\x12\xc8\xc7\x01\x0b\x12\xc2\xc7\x01\x0b\x12\xbc\xc7\x01\x0b\x12\xb6\xc7\x01\x0b\x12\xb0\xc7\x01\x0b\x12\xaa\xc7\x01\x0b\x12\xa4\xc7\x01\x0b\x12\x9e\xc7\x01\x0b\x12\x98\xc7\x01\x0b\x12\x92\xc7\x01\x0b\x12\x8c\xc7\x01\x0b\x12\x86\xc7\x01\x0b\x12\x80\xc7\x01\x0b\x12\xfa\xc6\x01\x0b\x12\xf4\xc6\x01\x0b\x12\xee\xc6\x01\x0b\x12\xe8\xc6\x01\x0b\x12\xe2\xc6\x01\x0b\x12\xdc\xc6\x01\x0b\x12\xd6\xc6\x01\x0b\x12\xd0\xc6\x01\x0b\x12\xca\xc6\x01\x0b\x12\xc4\xc6\x01\x0b\x12\xbe\xc6\x01\x0b\x12\xb8\xc6\x01\x0b\x12\xb2\xc6\x01\x0b\x12\xac\xc6\x01\x0b\x12\xa6\xc6\x01\x0b\x12\xa0\xc6\x01\x0b\x12\x9a\xc6\x01\x0b\x12\x94\xc6\x01\x0b\x12\x8e\xc6\x01\x0b\x12\x88\xc6\x01\x0b\x12\x82\xc6\x01\x0b\x12\xfc\xc5\x01\x0b\x12\xf6\xc5\x01\x0b\x12\xf0\xc5\x01\x0b\x12\xea\xc5\x01\x0b\x12\xe4\xc5\x01\x0b\x12\xde\xc5\x01\x0b\x12\xd8\xc5\x01\x0b\x12\xd2\xc5\x01\x0b\x12\xcc\xc5\x01\x0b\x12\xc6\xc5\x01\x0b\x12\xc0\xc5\x01\x0b\x12\xba\xc5\x01\x0b\x12\xb4\xc5\x01\x0b\x12\xae\xc5\x01\x0b\x12\xa8\xc5\x01\x0b\x12\xa2\xc5\x01\x0b\x12\x9c\xc5\x01\x0b\x12\x96\xc5\x01\x0b\x12\x90\xc5\x01\x0b\x12\x8a\xc5\x01\x0b\x12\x84\xc5\x01\x0b\x12\xfe\xc4\x01\x0b\x12\xf8\xc4\x01\x0b\x12\xf2\xc4\x01\x0b\x12\xec\xc4\x01\x0b\x12\xe6\xc4\x01\x0b\x12\xe0\xc4\x01\x0b\x12\xda\xc4\x01\x0b\x12\xd4\xc4\x01\x0b\x12\xce\xc4\x01\x0b\x12\xc8\xc4\x01\x0b\x12\xc2\xc4\x01\x0b\x12\xbc\xc4\x01\x0b\x12\xb6\xc4\x01\x0b\x12\xb0\xc4\x01\x0b\x12\xaa\xc4\x01\x0b\x12\xa4\xc4\x01\x0b\x12\x9e\xc4\x01\x0b\x12\x98\xc4\x01\x0b\x12\x92\xc4\x01\x0b\x12\x8c\xc4\x01\x0b\x12\x86\xc4\x01\x0b\x12\x80\xc4\x01\x0b\x12\xfa\xc3\x01\x0b\x12\xf4\xc3\x01\x0b\x12\xee\xc3\x01\x0b\x12\xe8\xc3\x01\x0b\x12\xe2\xc3\x01\x0b\x12\xdc\xc3\x01\x0b\x12\xd6\xc3\x01\x0b\x12\xd0\xc3\x01\x0b\x12\xca\xc3\x01\x0b\x12\xc4\xc3\x01\x0b\x12\xbe\xc3\x01\x0b\x12\xb8\xc3\x01\x0b\x12\xb2\xc3\x01\x0b\x12\xac\xc3\x01\x0b\x12\xa6\xc3\x01\x0b\x12\xa0\xc3\x01\x0b\x12\x9a\xc3\x01\x0b\x12\x94\xc3\x01\x0b\x12\x8e\xc3\x01\x0b\x12\x88\xc3\x01\x0b\x12\x82\xc3\x01\x0b\x12\xfc\xc2\x01\x0b\x12\xf6\xc2\x01\x0b\x12\xf0\xc2\x01\x0b\x12\xea\xc2\x01\x0b\x12\xe4\xc2\x01\x0b\x12\xde\xc2\x01\x0b\x12\xd8\xc2\x01\x0b\x12\xd2\xc2\x01\x0b\x12\xcc\xc2\x01\x0b\x12\xc6\xc2\x01\x0b\x12\xc0\xc2\x01\x0b\x12\xba\xc2\x01\x0b\x12\xb4\xc2\x01\x0b\x12\xae\xc2\x01\x0b\x12\xa8\xc2\x01\x0b\x12\xa2\xc2\x01\x0b\x12\x9c\xc2\x01\x0b\x12\x96\xc2\x01\x0b\x12\x90\xc2\x01\x0b\x12\x8a\xc2\x01\x0b\x12\x84\xc2\x01\x0b\x12\xfe\xc1\x01\x0b\x12\xf8\xc1\x01\x0b\x12\xf2\xc1\x01\x0b\x12\xec\xc1\x01\x0b\x12\xe6\xc1\x01\x0b\x12\xe0\xc1\x01\x0b\x12\xda\xc1\x01\x0b\x12\xd4\xc1\x01\x0b\x12\xce\xc1\x01\x0b\x12\xc8\xc1\x01\x0b\x12\xc2\xc1\x01\x0b\x12\xbc\xc1\x01\x0b\x12\xb6\xc1\x01\x0b\x12\xb0\xc1\x01\x0b\x12\xaa\xc1\x01\x0b\x12\xa4\xc1\x01\x0b\x12\x9e\xc1\x01\x0b\x12\x98\xc1\x01\x0b\x12\x92\xc1\x01\x0b\x12\x8c\xc1\x01\x0b\x12\x86\xc1\x01\x0b\x12\x80\xc1\x01\x0b\x12\xfa\xc0\x01\x0b\x12\xf4\xc0\x01\x0b\x12\xee\xc0\x01\x0b\x12\xe8\xc0\x01\x0b\x12\xe2\xc0\x01\x0b\x12\xdc\xc0\x01\x0b\x12\xd6\xc0\x01\x0b\x12\xd0\xc0\x01\x0b\x12\xca\xc0\x01\x0b\x12\xc4\xc0\x01\x0b\x12\xbe\xc0\x01\x0b\x12\xb8\xc0\x01\x0b\x12\xb2\xc0\x01\x0b\x12\xac\xc0\x01\x0b\x12\xa6\xc0\x01\x0b\x12\xa0\xc0\x01\x0b\x12\x9a\xc0\x01\x0b\x12\x94\xc0\x01\x0b\x12\x8e\xc0\x01\x0b\x12\x88\xc0\x01\x0b\x12\x82\xc0\x01\x0b\x12\xfc\xbf\x01\x0b\x12\xf6\xbf\x01\x0b\x12\xf0\xbf\x01\x0b\x12\xea\xbf\x01\x0b\x12\xe4\xbf\x01\x0b\x12\xde\xbf\x01\x0b\x12\xd8\xbf\x01\x0b\x12\xd2\xbf\x01\x0b\x12\xcc\xbf\x01\x0b\x12\xc6\xbf\x01\x0b\x12\xc0\xbf\x01\x0b\x12\xba\xbf\x01\x0b\x12\xb4\xbf\x01\x0b\x12\xae\xbf\x01\x0b\x12\xa8\xbf\x01\x0b\x12\xa2\xbf\x01\x0b\x12\x9c\xbf\x01\x0b\x12\x96\xbf\x01\x0b\x12\x90\xbf\x01\x0b\x12\x8a\xbf\x01\x0b\x12\x84\xbf\x01\x0b\x12\xfe\xbe\x01\x0b\x12\xf8\xbe\x01\x0b\x12\xf2\xbe\x01\x0b\x12\xec\xbe\x01\x0b\x12\xe6\xbe\x01\x0b\x12\xe0\xbe\x01\x0b\x12\xda\xbe\x01\x0b\x12\xd4\xbe\x01\x0b\x12\xce\xbe\x01\x0b\x12\xc8\xbe\x01\x0b\x12\xc2\xbe\x01\x0b\x12\xbc\xbe\x01\x0b\x12\xb6\xbe\x01\x0b\x12\xb0\xbe\x01\x0b\x12\xaa\xbe\x01\x0b\x12\xa4\xbe\x01\x0b\x12\x9e\xbe\x01\x0b\x12\x98\xbe\x01\x0b\x12\x92\xbe\x01\x0b\x12\x8c\xbe\x01\x0b\x12\x86\xbe\x01\x0b\x12\x80\xbe\x01\x0b\x12\xfa\xbd\x01\x0b\x12\xf4\xbd\x01\x0b\x12\xee\xbd\x01\x0b\x12\xe8\xbd\x01\x0b\x12\xe2\xbd\x01\x0b\x12\xdc\xbd\x01\x0b\x12\xd6\xbd\x01\x0b\x12\xd0\xbd\x01\x0b\x12\xca\xbd\x01\x0b\x12\xc4\xbd\x01\x0b\x12\xbe\xbd\x01\x0b\x12\xb8\xbd\x01\x0b\x12\xb2\xbd\x01\x0b\x12\xac\xbd\x01\x0b\x12\xa6\xbd\x01\x0b\x12\xa0\xbd\x01\x0b\x12\x9a\xbd\x01\x0b\x12\x94\xbd\x01\x0b\x12\x8e\xbd\x01\x0b\x12\x88\xbd\x01\x0b\x12\x82\xbd\x01\x0b\x12\xfc\xbc\x01\x0b\x12\xf6\xbc\x01\x0b\x12\xf0\xbc\x01\x0b\x12\xea\xbc\x01\x0b\x12\xe4\xbc\x01\x0b\x12\xde\xbc\x01\x0b\x12\xd8\xbc\x01\x0b\x12\xd2\xbc\x01\x0b\x12\xcc\xbc\x01\x0b\x12\xc6\xbc\x01\x0b\x12\xc0\xbc\x01\x0b\x12\xba\xbc\x01\x0b\x12\xb4\xbc\x01\x0b\x12\xae\xbc\x01\x0b\x12\xa8\xbc\x01\x0b\x12\xa2\xbc\x01\x0b\x12\x9c\xbc\x01\x0b\x12\x96\xbc\x01\x0b\x12\x90\xbc\x01\x0b\x12\x8a\xbc\x01\x0b\x12\x84\xbc\x01\x0b\x12\xfe\xbb\x01\x0b\x12\xf8\xbb\x01\x0b\x12\xf2\xbb\x01\x0b\x12\xec\xbb\x01\x0b\x12\xe6\xbb\x01\x0b\x12\xe0\xbb\x01\x0b\x12\xda\xbb\x01\x0b\x12\xd4\xbb\x01\x0b\x12\xce\xbb\x01\x0b\x12\xc8\xbb\x01\x0b\x12\xc2\xbb\x01\x0b\x12\xbc\xbb\x01\x0b\x12\xb6\xbb\x01\x0b\x12\xb0\xbb\x01\x0b\x12\xaa\xbb\x01\x0b\x12\xa4\xbb\x01\x0b\x12\x9e\xbb\x01\x0b\x12\x98\xbb\x01\x0b\x12\x92\xbb\x01\x0b\x12\x8c\xbb\x01\x0b\x12\x86\xbb\x01\x0b\x12\x80\xbb\x01\x0b\x12\xfa\xba\x01\x0b\x12\xf4\xba\x01\x0b\x12\xee\xba\x01\x0b\x12\xe8\xba\x01\x0b\x12\xe2\xba\x01\x0b\x12\xdc\xba\x01\x0b\x12\xd6\xba\x01\x0b\x12\xd0\xba\x01\x0b\x12\xca\xba\x01\x0b\x12\xc4\xba\x01\x0b\x12\xbe\xba\x01\x0b\x12\xb8\xba\x01\x0b\x12\xb2\xba\x01\x0b\x12\xac\xba\x01\x0b\x12\xa6\xba\x01\x0b\x12\xa0\xba\x01\x0b\x12\x9a\xba\x01\x0b\x12\x94\xba\x01\x0b\x12\x8e\xba\x01\x0b\x12\x88\xba\x01\x0b\x12\x82\xba\x01\x0b\x12\xfc\xb9\x01\x0b\x12\xf6\xb9\x01\x0b\x12\xf0\xb9\x01\x0b\x12\xea\xb9\x01\x0b\x12\xe4\xb9\x01\x0b\x12\xde\xb9\x01\x0b\x12\xd8\xb9\x01\x0b\x12\xd2\xb9\x01\x0b\x12\xcc\xb9\x01\x0b\x12\xc6\xb9\x01\x0b\x12\xc0\xb9\x01\x0b\x12\xba\xb9\x01\x0b\x12\xb4\xb9\x01\x0b\x12\xae\xb9\x01\x0b\x12\xa8\xb9\x01\x0b\x12\xa2\xb9\x01\x0b\x12\x9c\xb9\x01\x0b\x12\x96\xb9\x01\x0b\x12\x90\xb9\x01\x0b\x12\x8a\xb9\x01\x0b\x12\x84\xb9\x01\x0b\x12\xfe\xb8\x01\x0b\x12\xf8\xb8\x01\x0b\x12\xf2\xb8\x01\x0b\x12\xec\xb8\x01\x0b\x12\xe6\xb8\x01\x0b\x12\xe0\xb8\x01\x0b\x12\xda\xb8\x01\x0b\x12\xd4\xb8\x01\x0b\x12\xce\xb8\x01\x0b\x12\xc8\xb8\x01\x0b\x12\xc2\xb8\x01\x0b\x12\xbc\xb8\x01\x0b\x12\xb6\xb8\x01\x0b\x12\xb0\xb8\x01\x0b\x12\xaa\xb8\x01\x0b\x12\xa4\xb8\x01\x0b\x12\x9e\xb8\x01\x0b\x12\x98\xb8\x01\x0b\x12\x92\xb8\x01\x0b\x12\x8c\xb8\x01\x0b\x12\x86\xb8\x01\x0b\x12\x80\xb8\x01\x0b\x12\xfa\xb7\x01\x0b\x12\xf4\xb7\x01\x0b\x12\xee\xb7\x01\x0b\x12\xe8\xb7\x01\x0b\x12\xe2\xb7\x01\x0b\x12\xdc\xb7\x01\x0b\x12\xd6\xb7\x01\x0b\x12\xd0\xb7\x01\x0b\x12\xca\xb7\x01\x0b\x12\xc4\xb7\x01\x0b\x12\xbe\xb7\x01\x0b\x12\xb8\xb7\x01\x0b\x12\xb2\xb7\x01\x0b\x12\xac\xb7\x01\x0b\x12\xa6\xb7\x01\x0b\x12\xa0\xb7\x01\x0b\x12\x9a\xb7\x01\x0b\x12\x94\xb7\x01\x0b\x12\x8e\xb7\x01\x0b\x12\x88\xb7\x01\x0b\x12\x82\xb7\x01\x0b\x12\xfc\xb6\x01\x0b\x12\xf6\xb6\x01\x0b\x12\xf0\xb6\x01\x0b\x12\xea\xb6\x01\x0b\x12\xe4\xb6\x01\x0b\x12\xde\xb6\x01\x0b\x12\xd8\xb6\x01\x0b\x12\xd2\xb6\x01\x0b\x12\xcc\xb6\x01\x0b\x12\xc6\xb6\x01\x0b\x12\xc0\xb6\x01\x0b\x12\xba\xb6\x01\x0b\x12\xb4\xb6\x01\x0b\x12\xae\xb6\x01\x0b\x12\xa8\xb6\x01\x0b\x12\xa2\xb6\x01\x0b\x12\x9c\xb6\x01\x0b\x12\x96\xb6\x01\x0b\x12\x90\xb6\x01\x0b\x12\x8a\xb6\x01\x0b\x12\x84\xb6\x01\x0b\x12\xfe\xb5\x01\x0b\x12\xf8\xb5\x01\x0b\x12\xf2\xb5\x01\x0b\x12\xec\xb5\x01\x0b\x12\xe6\xb5\x01\x0b\x12\xe0\xb5\x01\x0b\x12\xda\xb5\x01\x0b\x12\xd4\xb5\x01\x0b\x12\xce\xb5\x01\x0b\x12\xc8\xb5\x01\x0b\x12\xc2\xb5\x01\x0b\x12\xbc\xb5\x01\x0b\x12\xb6\xb5\x01\x0b\x12\xb0\xb5\x01\x0b\x12\xaa\xb5\x01\x0b\x12\xa4\xb5\x01\x0b\x12\x9e\xb5\x01\x0b\x12\x98\xb5\x01\x0b\x12\x92\xb5\x01\x0b\x12\x8c\xb5\x01\x0b\x12\x86\xb5\x01\x0b\x12\x80\xb5\x01\x0b\x12\xfa\xb4\x01\x0b\x12\xf4\xb4\x01\x0b\x12\xee\xb4\x01\x0b\x12\xe8\xb4\x01\x0b\x12\xe2\xb4\x01\x0b\x12\xdc\xb4\x01\x0b\x12\xd6\xb4\x01\x0b\x12\xd0\xb4\x01\x0b\x12\xca\xb4\x01\x0b\x12\xc4\xb4\x01\x0b\x12\xbe\xb4\x01\x0b\x12\xb8\xb4\x01\x0b\x12\xb2\xb4\x01\x0b\x12\xac\xb4\x01\x0b\x12\xa6\xb4\x01\x0b\x12\xa0\xb4\x01\x0b\x12\x9a\xb4\x01\x0b\x12\x94\xb4\x01\x0b\x12\x8e\xb4\x01\x0b\x12\x88\xb4\x01\x0b\x12\x82\xb4\x01\x0b\x12\xfc\xb3\x01\x0b\x12\xf6\xb3\x01\x0b\x12\xf0\xb3\x01\x0b\x12\xea\xb3\x01\x0b\x12\xe4\xb3\x01\x0b\x12\xde\xb3\x01\x0b\x12\xd8\xb3\x01\x0b\x12\xd2\xb3\x01\x0b\x12\xcc\xb3\x01\x0b\x12\xc6\xb3\x01\x0b\x12\xc0\xb3\x01\x0b\x12\xba\xb3\x01\x0b\x12\xb4\xb3\x01\x0b\x12\xae\xb3\x01\x0b\x12\xa8\xb3\x01\x0b\x12\xa2\xb3\x01\x0b\x12\x9c\xb3\x01\x0b\x12\x96\xb3\x01\x0b\x12\x90\xb3\x01\x0b\x12\x8a\xb3\x01\x0b\x12\x84\xb3\x01\x0b\x12\xfe\xb2\x01\x0b\x12\xf8\xb2\x01\x0b\x12\xf2\xb2\x01\x0b\x12\xec\xb2\x01\x0b\x12\xe6\xb2\x01\x0b\x12\xe0\xb2\x01\x0b\x12\xda\xb2\x01\x0b\x12\xd4\xb2\x01\x0b\x12\xce\xb2\x01\x0b\x12\xc8\xb2\x01\x0b\x12\xc2\xb2\x01\x0b\x12\xbc\xb2\x01\x0b\x12\xb6\xb2\x01\x0b\x12\xb0\xb2\x01\x0b\x12\xaa\xb2\x01\x0b\x12\xa4\xb2\x01\x0b\x12\x9e\xb2\x01\x0b\x12\x98\xb2\x01\x0b\x12\x92\xb2\x01\x0b\x12\x8c\xb2\x01\x0b\x12\x86\xb2\x01\x0b\x12\x80\xb2\x01\x0b\x12\xfa\xb1\x01\x0b\x12\xf4\xb1\x01\x0b\x12\xee\xb1\x01\x0b\x12\xe8\xb1\x01\x0b\x12\xe2\xb1\x01\x0b\x12\xdc\xb1\x01\x0b\x12\xd6\xb1\x01\x0b\x12\xd0\xb1\x01\x0b\x12\xca\xb1\x01\x0b\x12\xc4\xb1\x01\x0b\x12\xbe\xb1\x01\x0b\x12\xb8\xb1\x01\x0b\x12\xb2\xb1\x01\x0b\x12\xac\xb1\x01\x0b\x12\xa6\xb1\x01\x0b\x12\xa0\xb1\x01\x0b\x12\x9a\xb1\x01\x0b\x12\x94\xb1\x01\x0b\x12\x8e\xb1\x01\x0b\x12\x88\xb1\x01\x0b\x12\x82\xb1\x01\x0b\x12\xfc\xb0\x01\x0b\x12\xf6\xb0\x01\x0b\x12\xf0\xb0\x01\x0b\x12\xea\xb0\x01\x0b\x12\xe4\xb0\x01\x0b\x12\xde\xb0\x01\x0b\x12\xd8\xb0\x01\x0b\x12\xd2\xb0\x01\x0b\x12\xcc\xb0\x01\x0b\x12\xc6\xb0\x01\x0b\x12\xc0\xb0\x01\x0b\x12\xba\xb0\x01\x0b\x12\xb4\xb0\x01\x0b\x12\xae\xb0\x01\x0b\x12\xa8\xb0\x01\x0b\x12\xa2\xb0\x01\x0b\x12\x9c\xb0\x01\x0b\x12\x96\xb0\x01\x0b\x12\x90\xb0\x01\x0b\x12\x8a\xb0\x01\x0b\x12\x84\xb0\x01\x0b\x12\xfe\xaf\x01\x0b\x12\xf8\xaf\x01\x0b\x12\xf2\xaf\x01\x0b\x12\xec\xaf\x01\x0b\x12\xe6\xaf\x01\x0b\x12\xe0\xaf\x01\x0b\x12\xda\xaf\x01\x0b\x12\xd4\xaf\x01\x0b\x12\xce\xaf\x01\x0b\x12\xc8\xaf\x01\x0b\x12\xc2\xaf\x01\x0b\x12\xbc\xaf\x01\x0b\x12\xb6\xaf\x01\x0b\x12\xb0\xaf\x01\x0b\x12\xaa\xaf\x01\x0b\x12\xa4\xaf\x01\x0b\x12\x9e\xaf\x01\x0b\x12\x98\xaf\x01\x0b\x12\x92\xaf\x01\x0b\x12\x8c\xaf\x01\x0b\x12\x86\xaf\x01\x0b\x12\x80\xaf\x01\x0b\x12\xfa\xae\x01\x0b\x12\xf4\xae\x01\x0b\x12\xee\xae\x01\x0b\x12\xe8\xae\x01\x0b\x12\xe2\xae\x01\x0b\x12\xdc\xae\x01\x0b\x12\xd6\xae\x01\x0b\x12\xd0\xae\x01\x0b\x12\xca\xae\x01\x0b\x12\xc4\xae\x01\x0b\x12\xbe\xae\x01\x0b\x12\xb8\xae\x01\x0b\x12\xb2\xae\x01\x0b\x12\xac\xae\x01\x0b\x12\xa6\xae\x01\x0b\x12\xa0\xae\x01\x0b\x12\x9a\xae\x01\x0b\x12\x94\xae\x01\x0b\x12\x8e\xae\x01\x0b\x12\x88\xae\x01\x0b\x12\x82\xae\x01\x0b\x12\xfc\xad\x01\x0b\x12\xf6\xad\x01\x0b\x12\xf0\xad\x01\x0b\x12\xea\xad\x01\x0b\x12\xe4\xad\x01\x0b\x12\xde\xad\x01\x0b\x12\xd8\xad\x01\x0b\x12\xd2\xad\x01\x0b\x12\xcc\xad\x01\x0b\x12\xc6\xad\x01\x0b\x12\xc0\xad\x01\x0b\x12\xba\xad\x01\x0b\x12\xb4\xad\x01\x0b\x12\xae\xad\x01\x0b\x12\xa8\xad\x01\x0b\x12\xa2\xad\x01\x0b\x12\x9c\xad\x01\x0b\x12\x96\xad\x01\x0b\x12\x90\xad\x01\x0b\x12\x8a\xad\x01\x0b\x12\x84\xad\x01\x0b\x12\xfe\xac\x01\x0b\x12\xf8\xac\x01\x0b\x12\xf2\xac\x01\x0b\x12\xec\xac\x01\x0b\x12\xe6\xac\x01\x0b\x12\xe0\xac\x01\x0b\x12\xda\xac\x01\x0b\x12\xd4\xac\x01\x0b\x12\xce\xac\x01\x0b\x12\xc8\xac\x01\x0b\x12\xc2\xac\x01\x0b\x12\xbc\xac\x01\x0b\x12\xb6\xac\x01\x0b\x12\xb0\xac\x01\x0b\x12\xaa\xac\x01\x0b\x12\xa4\xac\x01\x0b\x12\x9e\xac\x01\x0b\x12\x98\xac\x01\x0b\x12\x92\xac\x01\x0b\x12\x8c\xac\x01\x0b\x12\x86\xac\x01\x0b\x12\x80\xac\x01\x0b\x12\xfa\xab\x01\x0b\x12\xf4\xab\x01\x0b\x12\xee\xab\x01\x0b\x12\xe8\xab\x01\x0b\x12\xe2\xab\x01\x0b\x12\xdc\xab\x01\x0b\x12\xd6\xab\x01\x0b\x12\xd0\xab\x01\x0b\x12\xca\xab\x01\x0b\x12\xc4\xab\x01\x0b\x12\xbe\xab\x01\x0b\x12\xb8\xab\x01\x0b\x12\xb2\xab\x01\x0b\x12\xac\xab\x01\x0b\x12\xa6\xab\x01\x0b\x12\xa0\xab\x01\x0b\x12\x9a\xab\x01\x0b\x12\x94\xab\x01\x0b\x12\x8e\xab\x01\x0b\x12\x88\xab\x01\x0b\x12\x82\xab\x01\x0b\x12\xfc\xaa\x01\x0b\x12\xf6\xaa\x01\x0b\x12\xf0\xaa\x01\x0b\x12\xea\xaa\x01\x0b\x12\xe4\xaa\x01\x0b\x12\xde\xaa\x01\x0b\x12\xd8\xaa\x01\x0b\x12\xd2\xaa\x01\x0b\x12\xcc\xaa\x01\x0b\x12\xc6\xaa\x01\x0b\x12\xc0\xaa\x01\x0b\x12\xba\xaa\x01\x0b\x12\xb4\xaa\x01\x0b\x12\xae\xaa\x01\x0b\x12\xa8\xaa\x01\x0b\x12\xa2\xaa\x01\x0b\x12\x9c\xaa\x01\x0b\x12\x96\xaa\x01\x0b\x12\x90\xaa\x01\x0b\x12\x8a\xaa\x01\x0b\x12\x84\xaa\x01\x0b\x12\xfe\xa9\x01\x0b\x12\xf8\xa9\x01\x0b\x12\xf2\xa9\x01\x0b\x12\xec\xa9\x01\x0b\x12\xe6\xa9\x01\x0b\x12\xe0\xa9\x01\x0b\x12\xda\xa9\x01\x0b\x12\xd4\xa9\x01\x0b\x12\xce\xa9\x01\x0b\x12\xc8\xa9\x01\x0b\x12\xc2\xa9\x01\x0b\x12\xbc\xa9\x01\x0b\x12\xb6\xa9\x01\x0b\x12\xb0\xa9\x01\x0b\x12\xaa\xa9\x01\x0b\x12\xa4\xa9\x01\x0b\x12\x9e\xa9\x01\x0b\x12\x98\xa9\x01\x0b\x12\x92\xa9\x01\x0b\x12\x8c\xa9\x01\x0b\x12\x86\xa9\x01\x0b\x12\x80\xa9\x01\x0b\x12\xfa\xa8\x01\x0b\x12\xf4\xa8\x01\x0b\x12\xee\xa8\x01\x0b\x12\xe8\xa8\x01\x0b\x12\xe2\xa8\x01\x0b\x12\xdc\xa8\x01\x0b\x12\xd6\xa8\x01\x0b\x12\xd0\xa8\x01\x0b\x12\xca\xa8\x01\x0b\x12\xc4\xa8\x01\x0b\x12\xbe\xa8\x01\x0b\x12\xb8\xa8\x01\x0b\x12\xb2\xa8\x01\x0b\x12\xac\xa8\x01\x0b\x12\xa6\xa8\x01\x0b\x12\xa0\xa8\x01\x0b\x12\x9a\xa8\x01\x0b\x12\x94\xa8\x01\x0b\x12\x8e\xa8\x01\x0b\x12\x88\xa8\x01\x0b\x12\x82\xa8\x01\x0b\x12\xfc\xa7\x01\x0b\x12\xf6\xa7\x01\x0b\x12\xf0\xa7\x01\x0b\x12\xea\xa7\x01\x0b\x12\xe4\xa7\x01\x0b\x12\xde\xa7\x01\x0b\x12\xd8\xa7\x01\x0b\x12\xd2\xa7\x01\x0b\x12\xcc\xa7\x01\x0b\x12\xc6\xa7\x01\x0b\x12\xc0\xa7\x01\x0b\x12\xba\xa7\x01\x0b\x12\xb4\xa7\x01\x0b\x12\xae\xa7\x01\x0b\x12\xa8\xa7\x01\x0b\x12\xa2\xa7\x01\x0b\x12\x9c\xa7\x01\x0b\x12\x96\xa7\x01\x0b\x12\x90\xa7\x01\x0b\x12\x8a\xa7\x01\x0b\x12\x84\xa7\x01\x0b\x12\xfe\xa6\x01\x0b\x12\xf8\xa6\x01\x0b\x12\xf2\xa6\x01\x0b\x12\xec\xa6\x01\x0b\x12\xe6\xa6\x01\x0b\x12\xe0\xa6\x01\x0b\x12\xda\xa6\x01\x0b\x12\xd4\xa6\x01\x0b\x12\xce\xa6\x01\x0b\x12\xc8\xa6\x01\x0b\x12\xc2\xa6\x01\x0b\x12\xbc\xa6\x01\x0b\x12\xb6\xa6\x01\x0b\x12\xb0\xa6\x01\x0b\x12\xaa\xa6\x01\x0b\x12\xa4\xa6\x01\x0b\x12\x9e\xa6\x01\x0b\x12\x98\xa6\x01\x0b\x12\x92\xa6\x01\x0b\x12\x8c\xa6\x01\x0b\x12\x86\xa6\x01\x0b\x12\x80\xa6\x01\x0b\x12\xfa\xa5\x01\x0b\x12\xf4\xa5\x01\x0b\x12\xee\xa5\x01\x0b\x12\xe8\xa5\x01\x0b\x12\xe2\xa5\x01\x0b\x12\xdc\xa5\x01\x0b\x12\xd6\xa5\x01\x0b\x12\xd0\xa5\x01\x0b\x12\xca\xa5\x01\x0b\x12\xc4\xa5\x01\x0b\x12\xbe\xa5\x01\x0b\x12\xb8\xa5\x01\x0b\x12\xb2\xa5\x01\x0b\x12\xac\xa5\x01\x0b\x12\xa6\xa5\x01\x0b\x12\xa0\xa5\x01\x0b\x12\x9a\xa5\x01\x0b\x12\x94\xa5\x01\x0b\x12\x8e\xa5\x01\x0b\x12\x88\xa5\x01\x0b\x12\x82\xa5\x01\x0b\x12\xfc\xa4\x01\x0b\x12\xf6\xa4\x01\x0b\x12\xf0\xa4\x01\x0b\x12\xea\xa4\x01\x0b\x12\xe4\xa4\x01\x0b\x12\xde\xa4\x01\x0b\x12\xd8\xa4\x01\x0b\x12\xd2\xa4\x01\x0b\x12\xcc\xa4\x01\x0b\x12\xc6\xa4\x01\x0b\x12\xc0\xa4\x01\x0b\x12\xba\xa4\x01\x0b\x12\xb4\xa4\x01\x0b\x12\xae\xa4\x01\x0b\x12\xa8\xa4\x01\x0b\x12\xa2\xa4\x01\x0b\x12\x9c\xa4\x01\x0b\x12\x96\xa4\x01\x0b\x12\x90\xa4\x01\x0b\x12\x8a\xa4\x01\x0b\x12\x84\xa4\x01\x0b\x12\xfe\xa3\x01\x0b\x12\xf8\xa3\x01\x0b\x12\xf2\xa3\x01\x0b\x12\xec\xa3\x01\x0b\x12\xe6\xa3\x01\x0b\x12\xe0\xa3\x01\x0b\x12\xda\xa3\x01\x0b\x12\xd4\xa3\x01\x0b\x12\xce\xa3\x01\x0b\x12\xc8\xa3\x01\x0b\x12\xc2\xa3\x01\x0b\x12\xbc\xa3\x01\x0b\x12\xb6\xa3\x01\x0b\x12\xb0\xa3\x01\x0b\x12\xaa\xa3\x01\x0b\x12\xa4\xa3\x01\x0b\x12\x9e\xa3\x01\x0b\x12\x98\xa3\x01\x0b\x12\x92\xa3\x01\x0b\x12\x8c\xa3\x01\x0b\x12\x86\xa3\x01\x0b\x12\x80\xa3\x01\x0b\x12\xfa\xa2\x01\x0b\x12\xf4\xa2\x01\x0b\x12\xee\xa2\x01\x0b\x12\xe8\xa2\x01\x0b\x12\xe2\xa2\x01\x0b\x12\xdc\xa2\x01\x0b\x12\xd6\xa2\x01\x0b\x12\xd0\xa2\x01\x0b\x12\xca\xa2\x01\x0b\x12\xc4\xa2\x01\x0b\x12\xbe\xa2\x01\x0b\x12\xb8\xa2\x01\x0b\x12\xb2\xa2\x01\x0b\x12\xac\xa2\x01\x0b\x12\xa6\xa2\x01\x0b\x12\xa0\xa2\x01\x0b\x12\x9a\xa2\x01\x0b\x12\x94\xa2\x01\x0b\x12\x8e\xa2\x01\x0b\x12\x88\xa2\x01\x0b\x12\x82\xa2\x01\x0b\x12\xfc\xa1\x01\x0b\x12\xf6\xa1\x01\x0b\x12\xf0\xa1\x01\x0b\x12\xea\xa1\x01\x0b\x12\xe4\xa1\x01\x0b\x12\xde\xa1\x01\x0b\x12\xd8\xa1\x01\x0b\x12\xd2\xa1\x01\x0b\x12\xcc\xa1\x01\x0b\x12\xc6\xa1\x01\x0b\x12\xc0\xa1\x01\x0b\x12\xba\xa1\x01\x0b\x12\xb4\xa1\x01\x0b\x12\xae\xa1\x01\x0b\x12\xa8\xa1\x01\x0b\x12\xa2\xa1\x01\x0b\x12\x9c\xa1\x01\x0b\x12\x96\xa1\x01\x0b\x12\x90\xa1\x01\x0b\x12\x8a\xa1\x01\x0b\x12\x84\xa1\x01\x0b\x12\xfe\xa0\x01\x0b\x12\xf8\xa0\x01\x0b\x12\xf2\xa0\x01\x0b\x12\xec\xa0\x01\x0b\x12\xe6\xa0\x01\x0b\x12\xe0\xa0\x01\x0b\x12\xda\xa0\x01\x0b\x12\xd4\xa0\x01\x0b\x12\xce\xa0\x01\x0b\x12\xc8\xa0\x01\x0b\x12\xc2\xa0\x01\x0b\x12\xbc\xa0\x01\x0b\x12\xb6\xa0\x01\x0b\x12\xb0\xa0\x01\x0b\x12\xaa\xa0\x01\x0b\x12\xa4\xa0\x01\x0b\x12\x9e\xa0\x01\x0b\x12\x98\xa0\x01\x0b\x12\x92\xa0\x01\x0b\x12\x8c\xa0\x01\x0b\x12\x86\xa0\x01\x0b\x12\x80\xa0\x01\x0b\x12\xfa\x9f\x01\x0b\x12\xf4\x9f\x01\x0b\x12\xee\x9f\x01\x0b\x12\xe8\x9f\x01\x0b\x12\xe2\x9f\x01\x0b\x12\xdc\x9f\x01\x0b\x12\xd6\x9f\x01\x0b\x12\xd0\x9f\x01\x0b\x12\xca\x9f\x01\x0b\x12\xc4\x9f\x01\x0b\x12\xbe\x9f\x01\x0b\x12\xb8\x9f\x01\x0b\x12\xb2\x9f\x01\x0b\x12\xac\x9f\x01\x0b\x12\xa6\x9f\x01\x0b\x12\xa0\x9f\x01\x0b\x12\x9a\x9f\x01\x0b\x12\x94\x9f\x01\x0b\x12\x8e\x9f\x01\x0b\x12\x88\x9f\x01\x0b\x12\x82\x9f\x01\x0b\x12\xfc\x9e\x01\x0b\x12\xf6\x9e\x01\x0b\x12\xf0\x9e\x01\x0b\x12\xea\x9e\x01\x0b\x12\xe4\x9e\x01\x0b\x12\xde\x9e\x01\x0b\x12\xd8\x9e\x01\x0b\x12\xd2\x9e\x01\x0b\x12\xcc\x9e\x01\x0b\x12\xc6\x9e\x01\x0b\x12\xc0\x9e\x01\x0b\x12\xba\x9e\x01\x0b\x12\xb4\x9e\x01\x0b\x12\xae\x9e\x01\x0b\x12\xa8\x9e\x01\x0b\x12\xa2\x9e\x01\x0b\x12\x9c\x9e\x01\x0b\x12\x96\x9e\x01\x0b\x12\x90\x9e\x01\x0b\x12\x8a\x9e\x01\x0b\x12\x84\x9e\x01\x0b\x12\xfe\x9d\x01\x0b\x12\xf8\x9d\x01\x0b\x12\xf2\x9d\x01\x0b\x12\xec\x9d\x01\x0b\x12\xe6\x9d\x01\x0b\x12\xe0\x9d\x01\x0b\x12\xda\x9d\x01\x0b\x12\xd4\x9d\x01\x0b\x12\xce\x9d\x01\x0b\x12\xc8\x9d\x01\x0b\x12\xc2\x9d\x01\x0b\x12\xbc\x9d\x01\x0b\x12\xb6\x9d\x01\x0b\x12\xb0\x9d\x01\x0b\x12\xaa\x9d\x01\x0b\x12\xa4\x9d\x01\x0b\x12\x9e\x9d\x01\x0b\x12\x98\x9d\x01\x0b\x12\x92\x9d\x01\x0b\x12\x8c\x9d\x01\x0b\x12\x86\x9d\x01\x0b\x12\x80\x9d\x01\x0b\x12\xfa\x9c\x01\x0b\x12\xf4\x9c\x01\x0b\x12\xee\x9c\x01\x0b\x12\xe8\x9c\x01\x0b\x12\xe2\x9c\x01\x0b\x12\xdc\x9c\x01\x0b\x12\xd6\x9c\x01\x0b\x12\xd0\x9c\x01\x0b\x12\xca\x9c\x01\x0b\x12\xc4\x9c\x01\x0b\x12\xbe\x9c\x01\x0b\x12\xb8\x9c\x01\x0b\x12\xb2\x9c\x01\x0b\x12\xac\x9c\x01\x0b\x12\xa6\x9c\x01\x0b\x12\xa0\x9c\x01\x0b\x12\x9a\x9c\x01\x0b\x12\x94\x9c\x01\x0b\x12\x8e\x9c\x01\x0b\x12\x88\x9c\x01\x0b\x12\x82\x9c\x01\x0b\x12\xfc\x9b\x01\x0b\x12\xf6\x9b\x01\x0b\x12\xf0\x9b\x01\x0b\x12\xea\x9b\x01\x0b\x12\xe4\x9b\x01\x0b\x12\xde\x9b\x01\x0b\x12\xd8\x9b\x01\x0b\x12\xd2\x9b\x01\x0b\x12\xcc\x9b\x01\x0b\x12\xc6\x9b\x01\x0b\x12\xc0\x9b\x01\x0b\x12\xba\x9b\x01\x0b\x12\xb4\x9b\x01\x0b\x12\xae\x9b\x01\x0b\x12\xa8\x9b\x01\x0b\x12\xa2\x9b\x01\x0b\x12\x9c\x9b\x01\x0b\x12\x96\x9b\x01\x0b\x12\x90\x9b\x01\x0b\x12\x8a\x9b\x01\x0b\x12\x84\x9b\x01\x0b\x12\xfe\x9a\x01\x0b\x12\xf8\x9a\x01\x0b\x12\xf2\x9a\x01\x0b\x12\xec\x9a\x01\x0b\x12\xe6\x9a\x01\x0b\x12\xe0\x9a\x01\x0b\x12\xda\x9a\x01\x0b\x12\xd4\x9a\x01\x0b\x12\xce\x9a\x01\x0b\x12\xc8\x9a\x01\x0b\x12\xc2\x9a\x01\x0b\x12\xbc\x9a\x01\x0b\x12\xb6\x9a\x01\x0b\x12\xb0\x9a\x01\x0b\x12\xaa\x9a\x01\x0b\x12\xa4\x9a\x01\x0b\x12\x9e\x9a\x01\x0b\x12\x98\x9a\x01\x0b\x12\x92\x9a\x01\x0b\x12\x8c\x9a\x01\x0b\x12\x86\x9a\x01\x0b\x12\x80\x9a\x01\x0b\x12\xfa\x99\x01\x0b\x12\xf4\x99\x01\x0b\x12\xee\x99\x01\x0b\x12\xe8\x99\x01\x0b\x12\xe2\x99\x01\x0b\x12\xdc\x99\x01\x0b\x12\xd6\x99\x01\x0b\x12\xd0\x99\x01\x0b\x12\xca\x99\x01\x0b\x12\xc4\x99\x01\x0b\x12\xbe\x99\x01\x0b\x12\xb8\x99\x01\x0b\x12\xb2\x99\x01\x0b\x12\xac\x99\x01\x0b\x12\xa6\x99\x01\x0b\x12\xa0\x99\x01\x0b\x12\x9a\x99\x01\x0b\x12\x94\x99\x01\x0b\x12\x8e\x99\x01\x0b\x12\x88\x99\x01\x0b\x12\x82\x99\x01\x0b\x12\xfc\x98\x01\x0b\x12\xf6\x98\x01\x0b\x12\xf0\x98\x01\x0b\x12\xea\x98\x01\x0b\x12\xe4\x98\x01\x0b\x12\xde\x98\x01\x0b\x12\xd8\x98\x01\x0b\x12\xd2\x98\x01\x0b\x12\xcc\x98\x01\x0b\x12\xc6\x98\x01\x0b\x12\xc0\x98\x01\x0b\x12\xba\x98\x01\x0b\x12\xb4\x98\x01\x0b\x12\xae\x98\x01\x0b\x12\xa8\x98\x01\x0b\x12\xa2\x98\x01\x0b\x12\x9c\x98\x01\x0b\x12\x96\x98\x01\x0b\x12\x90\x98\x01\x0b\x12\x8a\x98\x01\x0b\x12\x84\x98\x01\x0b\x12\xfe\x97\x01\x0b\x12\xf8\x97\x01\x0b\x12\xf2\x97\x01\x0b\x12\xec\x97\x01\x0b\x12\xe6\x97\x01\x0b\x12\xe0\x97\x01\x0b\x12\xda\x97\x01\x0b\x12\xd4\x97\x01\x0b\x12\xce\x97\x01\x0b\x12\xc8\x97\x01\x0b\x12\xc2\x97\x01\x0b\x12\xbc\x97\x01\x0b\x12\xb6\x97\x01\x0b\x12\xb0\x97\x01\x0b\x12\xaa\x97\x01\x0b\x12\xa4\x97\x01\x0b\x12\x9e\x97\x01\x0b\x12\x98\x97\x01\x0b\x12\x92\x97\x01\x0b\x12\x8c\x97\x01\x0b\x12\x86\x97\x01\x0b\x12\x80\x97\x01\x0b\x12\xfa\x96\x01\x0b\x12\xf4\x96\x01\x0b\x12\xee\x96\x01\x0b\x12\xe8\x96\x01\x0b\x12\xe2\x96\x01\x0b\x12\xdc\x96\x01\x0b\x12\xd6\x96\x01\x0b\x12\xd0\x96\x01\x0b\x12\xca\x96\x01\x0b\x12\xc4\x96\x01\x0b\x12\xbe\x96\x01\x0b\x12\xb8\x96\x01\x0b\x12\xb2\x96\x01\x0b\x12\xac\x96\x01\x0b\x12\xa6\x96\x01\x0b\x12\xa0\x96\x01\x0b\x12\x9a\x96\x01\x0b\x12\x94\x96\x01\x0b\x12\x8e\x96\x01\x0b\x12\x88\x96\x01\x0b\x12\x82\x96\x01\x0b\x12\xfc\x95\x01\x0b\x12\xf6\x95\x01\x0b\x12\xf0\x95\x01\x0b\x12\xea\x95\x01\x0b\x12\xe4\x95\x01\x0b\x12\xde\x95\x01\x0b\x12\xd8\x95\x01\x0b\x12\xd2\x95\x01\x0b\x12\xcc\x95\x01\x0b\x12\xc6\x95\x01\x0b\x12\xc0\x95\x01\x0b\x12\xba\x95\x01\x0b\x12\xb4\x95\x01\x0b\x12\xae\x95\x01\x0b\x12\xa8\x95\x01\x0b\x12\xa2\x95\x01\x0b\x12\x9c\x95\x01\x0b\x12\x96\x95\x01\x0b\x12\x90\x95\x01\x0b\x12\x8a\x95\x01\x0b\x12\x84\x95\x01\x0b\x12\xfe\x94\x01\x0b\x12\xf8\x94\x01\x0b\x12\xf2\x94\x01\x0b\x12\xec\x94\x01\x0b\x12\xe6\x94\x01\x0b\x12\xe0\x94\x01\x0b\x12\xda\x94\x01\x0b\x12\xd4\x94\x01\x0b\x12\xce\x94\x01\x0b\x12\xc8\x94\x01\x0b\x12\xc2\x94\x01\x0b\x12\xbc\x94\x01\x0b\x12\xb6\x94\x01\x0b\x12\xb0\x94\x01\x0b\x12\xaa\x94\x01\x0b\x12\xa4\x94\x01\x0b\x12\x9e\x94\x01\x0b\x12\x98\x94\x01\x0b\x12\x92\x94\x01\x0b\x12\x8c\x94\x01\x0b\x12\x86\x94\x01\x0b\x12\x80\x94\x01\x0b\x12\xfa\x93\x01\x0b\x12\xf4\x93\x01\x0b\x12\xee\x93\x01\x0b\x12\xe8\x93\x01\x0b\x12\xe2\x93\x01\x0b\x12\xdc\x93\x01\x0b\x12\xd6\x93\x01\x0b\x12\xd0\x93\x01\x0b\x12\xca\x93\x01\x0b\x12\xc4\x93\x01\x0b\x12\xbe\x93\x01\x0b\x12\xb8\x93\x01\x0b\x12\xb2\x93\x01\x0b\x12\xac\x93\x01\x0b\x12\xa6\x93\x01\x0b\x12\xa0\x93\x01\x0b\x12\x9a\x93\x01\x0b\x12\x94\x93\x01\x0b\x12\x8e\x93\x01\x0b\x12\x88\x93\x01\x0b\x12\x82\x93\x01\x0b\x12\xfc\x92\x01\x0b\x12\xf6\x92\x01\x0b\x12\xf0\x92\x01\x0b\x12\xea\x92\x01\x0b\x12\xe4\x92\x01\x0b\x12\xde\x92\x01\x0b\x12\xd8\x92\x01\x0b\x12\xd2\x92\x01\x0b\x12\xcc\x92\x01\x0b\x12\xc6\x92\x01\x0b\x12\xc0\x92\x01\x0b\x12\xba\x92\x01\x0b\x12\xb4\x92\x01\x0b\x12\xae\x92\x01\x0b\x12\xa8\x92\x01\x0b\x12\xa2\x92\x01\x0b\x12\x9c\x92\x01\x0b\x12\x96\x92\x01\x0b\x12\x90\x92\x01\x0b\x12\x8a\x92\x01\x0b\x12\x84\x92\x01\x0b\x12\xfe\x91\x01\x0b\x12\xf8\x91\x01\x0b\x12\xf2\x91\x01\x0b\x12\xec\x91\x01\x0b\x12\xe6\x91\x01\x0b\x12\xe0\x91\x01\x0b\x12\xda\x91\x01\x0b\x12\xd4\x91\x01\x0b\x12\xce\x91\x01\x0b\x12\xc8\x91\x01\x0b\x12\xc2\x91\x01\x0b\x12\xbc\x91\x01\x0b\x12\xb6\x91\x01\x0b\x12\xb0\x91\x01\x0b\x12\xaa\x91\x01\x0b\x12\xa4\x91\x01\x0b\x12\x9e\x91\x01\x0b\x12\x98\x91\x01\x0b\x12\x92\x91\x01\x0b\x12\x8c\x91\x01\x0b\x12\x86\x91\x01\x0b\x12\x80\x91\x01\x0b\x12\xfa\x90\x01\x0b\x12\xf4\x90\x01\x0b\x12\xee\x90\x01\x0b\x12\xe8\x90\x01\x0b\x12\xe2\x90\x01\x0b\x12\xdc\x90\x01\x0b\x12\xd6\x90\x01\x0b\x12\xd0\x90\x01\x0b\x12\xca\x90\x01\x0b\x12\xc4\x90\x01\x0b\x12\xbe\x90\x01\x0b\x12\xb8\x90\x01\x0b\x12\xb2\x90\x01\x0b\x12\xac\x90\x01\x0b\x12\xa6\x90\x01\x0b\x12\xa0\x90\x01\x0b\x12\x9a\x90\x01\x0b\x12\x94\x90\x01\x0b\x12\x8e\x90\x01\x0b\x12\x88\x90\x01\x0b\x12\x82\x90\x01\x0b\x12\xfc\x8f\x01\x0b\x12\xf6\x8f\x01\x0b\x12\xf0\x8f\x01\x0b\x12\xea\x8f\x01\x0b\x12\xe4\x8f\x01\x0b\x12\xde\x8f\x01\x0b\x12\xd8\x8f\x01\x0b\x12\xd2\x8f\x01\x0b\x12\xcc\x8f\x01\x0b\x12\xc6\x8f\x01\x0b\x12\xc0\x8f\x01\x0b\x12\xba\x8f\x01\x0b\x12\xb4\x8f\x01\x0b\x12\xae\x8f\x01\x0b\x12\xa8\x8f\x01\x0b\x12\xa2\x8f\x01\x0b\x12\x9c\x8f\x01\x0b\x12\x96\x8f\x01\x0b\x12\x90\x8f\x01\x0b\x12\x8a\x8f\x01\x0b\x12\x84\x8f\x01\x0b\x12\xfe\x8e\x01\x0b\x12\xf8\x8e\x01\x0b\x12\xf2\x8e\x01\x0b\x12\xec\x8e\x01\x0b\x12\xe6\x8e\x01\x0b\x12\xe0\x8e\x01\x0b\x12\xda\x8e\x01\x0b\x12\xd4\x8e\x01\x0b\x12\xce\x8e\x01\x0b\x12\xc8\x8e\x01\x0b\x12\xc2\x8e\x01\x0b\x12\xbc\x8e\x01\x0b\x12\xb6\x8e\x01\x0b\x12\xb0\x8e\x01\x0b\x12\xaa\x8e\x01\x0b\x12\xa4\x8e\x01\x0b\x12\x9e\x8e\x01\x0b\x12\x98\x8e\x01\x0b\x12\x92\x8e\x01\x0b\x12\x8c\x8e\x01\x0b\x12\x86\x8e\x01\x0b\x12\x80\x8e\x01\x0b\x12\xfa\x8d\x01\x0b\x12\xf4\x8d\x01\x0b\x12\xee\x8d\x01\x0b\x12\xe8\x8d\x01\x0b\x12\xe2\x8d\x01\x0b\x12\xdc\x8d\x01\x0b\x12\xd6\x8d\x01\x0b\x12\xd0\x8d\x01\x0b\x12\xca\x8d\x01\x0b\x12\xc4\x8d\x01\x0b\x12\xbe\x8d\x01\x0b\x12\xb8\x8d\x01\x0b\x12\xb2\x8d\x01\x0b\x12\xac\x8d\x01\x0b\x12\xa6\x8d\x01\x0b\x12\xa0\x8d\x01\x0b\x12\x9a\x8d\x01\x0b\x12\x94\x8d\x01\x0b\x12\x8e\x8d\x01\x0b\x12\x88\x8d\x01\x0b\x12\x82\x8d\x01\x0b\x12\xfc\x8c\x01\x0b\x12\xf6\x8c\x01\x0b\x12\xf0\x8c\x01\x0b\x12\xea\x8c\x01\x0b\x12\xe4\x8c\x01\x0b\x12\xde\x8c\x01\x0b\x12\xd8\x8c\x01\x0b\x12\xd2\x8c\x01\x0b\x12\xcc\x8c\x01\x0b\x12\xc6\x8c\x01\x0b\x12\xc0\x8c\x01\x0b\x12\xba\x8c\x01\x0b\x12\xb4\x8c\x01\x0b\x12\xae\x8c\x01\x0b\x12\xa8\x8c\x01\x0b\x12\xa2\x8c\x01\x0b\x12\x9c\x8c\x01\x0b\x12\x96\x8c\x01\x0b\x12\x90\x8c\x01\x0b\x12\x8a\x8c\x01\x0b\x12\x84\x8c\x01\x0b\x12\xfe\x8b\x01\x0b\x12\xf8\x8b\x01\x0b\x12\xf2\x8b\x01\x0b\x12\xec\x8b\x01\x0b\x12\xe6\x8b\x01\x0b\x12\xe0\x8b\x01\x0b\x12\xda\x8b\x01\x0b\x12\xd4\x8b\x01\x0b\x12\xce\x8b\x01\x0b\x12\xc8\x8b\x01\x0b\x12\xc2\x8b\x01\x0b\x12\xbc\x8b\x01\x0b\x12\xb6\x8b\x01\x0b\x12\xb0\x8b\x01\x0b\x12\xaa\x8b\x01\x0b\x12\xa4\x8b\x01\x0b\x12\x9e\x8b\x01\x0b\x12\x98\x8b\x01\x0b\x12\x92\x8b\x01\x0b\x12\x8c\x8b\x01\x0b\x12\x86\x8b\x01\x0b\x12\x80\x8b\x01\x0b\x12\xfa\x8a\x01\x0b\x12\xf4\x8a\x01\x0b\x12\xee\x8a\x01\x0b\x12\xe8\x8a\x01\x0b\x12\xe2\x8a\x01\x0b\x12\xdc\x8a\x01\x0b\x12\xd6\x8a\x01\x0b\x12\xd0\x8a\x01\x0b\x12\xca\x8a\x01\x0b\x12\xc4\x8a\x01\x0b\x12\xbe\x8a\x01\x0b\x12\xb8\x8a\x01\x0b\x12\xb2\x8a\x01\x0b\x12\xac\x8a\x01\x0b\x12\xa6\x8a\x01\x0b\x12\xa0\x8a\x01\x0b\x12\x9a\x8a\x01\x0b\x12\x94\x8a\x01\x0b\x12\x8e\x8a\x01\x0b\x12\x88\x8a\x01\x0b\x12\x82\x8a\x01\x0b\x12\xfc\x89\x01\x0b\x12\xf6\x89\x01\x0b\x12\xf0\x89\x01\x0b\x12\xea\x89\x01\x0b\x12\xe4\x89\x01\x0b\x12\xde\x89\x01\x0b\x12\xd8\x89\x01\x0b\x12\xd2\x89\x01\x0b\x12\xcc\x89\x01\x0b\x12\xc6\x89\x01\x0b\x12\xc0\x89\x01\x0b\x12\xba\x89\x01\x0b\x12\xb4\x89\x01\x0b\x12\xae\x89\x01\x0b\x12\xa8\x89\x01\x0b\x12\xa2\x89\x01\x0b\x12\x9c\x89\x01\x0b\x12\x96\x89\x01\x0b\x12\x90\x89\x01\x0b\x12\x8a\x89\x01\x0b\x12\x84\x89\x01\x0b\x12\xfe\x88\x01\x0b\x12\xf8\x88\x01\x0b\x12\xf2\x88\x01\x0b\x12\xec\x88\x01\x0b\x12\xe6\x88\x01\x0b\x12\xe0\x88\x01\x0b\x12\xda\x88\x01\x0b\x12\xd4\x88\x01\x0b\x12\xce\x88\x01\x0b\x12\xc8\x88\x01\x0b\x12\xc2\x88\x01\x0b\x12\xbc\x88\x01\x0b\x12\xb6\x88\x01\x0b\x12\xb0\x88\x01\x0b\x12\xaa\x88\x01\x0b\x12\xa4\x88\x01\x0b\x12\x9e\x88\x01\x0b\x12\x98\x88\x01\x0b\x12\x92\x88\x01\x0b\x12\x8c\x88\x01\x0b\x12\x86\x88\x01\x0b\x12\x80\x88\x01\x0b\x12\xfa\x87\x01\x0b\x12\xf4\x87\x01\x0b\x12\xee\x87\x01\x0b\x12\xe8\x87\x01\x0b\x12\xe2\x87\x01\x0b\x12\xdc\x87\x01\x0b\x12\xd6\x87\x01\x0b\x12\xd0\x87\x01\x0b\x12\xca\x87\x01\x0b\x12\xc4\x87\x01\x0b\x12\xbe\x87\x01\x0b\x12\xb8\x87\x01\x0b\x12\xb2\x87\x01\x0b\x12\xac\x87\x01\x0b\x12\xa6\x87\x01\x0b\x12\xa0\x87\x01\x0b\x12\x9a\x87\x01\x0b\x12\x94\x87\x01\x0b\x12\x8e\x87\x01\x0b\x12\x88\x87\x01\x0b\x12\x82\x87\x01\x0b\x12\xfc\x86\x01\x0b\x12\xf6\x86\x01\x0b\x12\xf0\x86\x01\x0b\x12\xea\x86\x01\x0b\x12\xe4\x86\x01\x0b\x12\xde\x86\x01\x0b\x12\xd8\x86\x01\x0b\x12\xd2\x86\x01\x0b\x12\xcc\x86\x01\x0b\x12\xc6\x86\x01\x0b\x12\xc0\x86\x01\x0b\x12\xba\x86\x01\x0b\x12\xb4\x86\x01\x0b\x12\xae\x86\x01\x0b\x12\xa8\x86\x01\x0b\x12\xa2\x86\x01\x0b\x12\x9c\x86\x01\x0b\x12\x96\x86\x01\x0b\x12\x90\x86\x01\x0b\x12\x8a\x86\x01\x0b\x12\x84\x86\x01\x0b\x12\xfe\x85\x01\x0b\x12\xf8\x85\x01\x0b\x12\xf2\x85\x01\x0b\x12\xec\x85\x01\x0b\x12\xe6\x85\x01\x0b\x12\xe0\x85\x01\x0b\x12\xda\x85\x01\x0b\x12\xd4\x85\x01\x0b\x12\xce\x85\x01\x0b\x12\xc8\x85\x01\x0b\x12\xc2\x85\x01\x0b\x12\xbc\x85\x01\x0b\x12\xb6\x85\x01\x0b\x12\xb0\x85\x01\x0b\x12\xaa\x85\x01\x0b\x12\xa4\x85\x01\x0b\x12\x9e\x85\x01\x0b\x12\x98\x85\x01\x0b\x12\x92\x85\x01\x0b\x12\x8c\x85\x01\x0b\x12\x86\x85\x01\x0b\x12\x80\x85\x01\x0b\x12\xfa\x84\x01\x0b\x12\xf4\x84\x01\x0b\x12\xee\x84\x01\x0b\x12\xe8\x84\x01\x0b\x12\xe2\x84\x01\x0b\x12\xdc\x84\x01\x0b\x12\xd6\x84\x01\x0b\x12\xd0\x84\x01\x0b\x12\xca\x84\x01\x0b\x12\xc4\x84\x01\x0b\x12\xbe\x84\x01\x0b\x12\xb8\x84\x01\x0b\x12\xb2\x84\x01\x0b\x12\xac\x84\x01\x0b\x12\xa6\x84\x01\x0b\x12\xa0\x84\x01\x0b\x12\x9a\x84\x01\x0b\x12\x94\x84\x01\x0b\x12\x8e\x84\x01\x0b\x12\x88\x84\x01\x0b\x12\x82\x84\x01\x0b\x12\xfc\x83\x01\x0b\x12\xf6\x83\x01\x0b\x12\xf0\x83\x01\x0b\x12\xea\x83\x01\x0b\x12\xe4\x83\x01\x0b\x12\xde\x83\x01\x0b\x12\xd8\x83\x01\x0b\x12\xd2\x83\x01\x0b\x12\xcc\x83\x01\x0b\x12\xc6\x83\x01\x0b\x12\xc0\x83\x01\x0b\x12\xba\x83\x01\x0b\x12\xb4\x83\x01\x0b\x12\xae\x83\x01\x0b\x12\xa8\x83\x01\x0b\x12\xa2\x83\x01\x0b\x12\x9c\x83\x01\x0b\x12\x96\x83\x01\x0b\x12\x90\x83\x01\x0b\x12\x8a\x83\x01\x0b\x12\x84\x83\x01\x0b\x12\xfe\x82\x01\x0b\x12\xf8\x82\x01\x0b\x12\xf2\x82\x01\x0b\x12\xec\x82\x01\x0b\x12\xe6\x82\x01\x0b\x12\xe0\x82\x01\x0b\x12\xda\x82\x01\x0b\x12\xd4\x82\x01\x0b\x12\xce\x82\x01\x0b\x12\xc8\x82\x01\x0b\x12\xc2\x82\x01\x0b\x12\xbc\x82\x01\x0b\x12\xb6\x82\x01\x0b\x12\xb0\x82\x01\x0b\x12\xaa\x82\x01\x0b\x12\xa4\x82\x01\x0b\x12\x9e\x82\x01\x0b\x12\x98\x82\x01\x0b\x12\x92\x82\x01\x0b\x12\x8c\x82\x01\x0b\x12\x86\x82\x01\x0b\x12\x80\x82\x01\x0b\x12\xfa\x81\x01\x0b\x12\xf4\x81\x01\x0b\x12\xee\x81\x01\x0b\x12\xe8\x81\x01\x0b\x12\xe2\x81\x01\x0b\x12\xdc\x81\x01\x0b\x12\xd6\x81\x01\x0b\x12\xd0\x81\x01\x0b\x12\xca\x81\x01\x0b\x12\xc4\x81\x01\x0b\x12\xbe\x81\x01\x0b\x12\xb8\x81\x01\x0b\x12\xb2\x81\x01\x0b\x12\xac\x81\x01\x0b\x12\xa6\x81\x01\x0b\x12\xa0\x81\x01\x0b\x12\x9a\x81\x01\x0b\x12\x94\x81\x01\x0b\x12\x8e\x81\x01\x0b\x12\x88\x81\x01\x0b\x12\x82\x81\x01\x0b\x12\xfc\x80\x01\x0b\x12\xf6\x80\x01\x0b\x12\xf0\x80\x01\x0b\x12\xea\x80\x01\x0b\x12\xe4\x80\x01\x0b\x12\xde\x80\x01\x0b\x12\xd8\x80\x01\x0b\x12\xd2\x80\x01\x0b\x12\xcc\x80\x01\x0b\x12\xc6\x80\x01\x0b\x12\xc0\x80\x01\x0b\x12\xba\x80\x01\x0b\x12\xb4\x80\x01\x0b\x12\xae\x80\x01\x0b\x12\xa8\x80\x01\x0b\x12\xa2\x80\x01\x0b\x12\x9c\x80\x01\x0b\x12\x96\x80\x01\x0b\x12\x90\x80\x01\x0b\x12\x8a\x80\x01\x0b\x12\x84\x80\x01\x0b\x12\xff\x7f\x0b\x12\xfa\x7f\x0b\x12\xf5\x7f\x0b\x12\xf0\x7f\x0b\x12\xeb\x7f\x0b\x12\xe6\x7f\x0b\x12\xe1\x7f\x0b\x12\xdc\x7f\x0b\x12\xd7\x7f\x0b\x12\xd2\x7f\x0b\x12\xcd\x7f\x0b\x12\xc8\x7f\x0b\x12\xc3\x7f\x0b\x12\xbe\x7f\x0b\x12\xb9\x7f\x0b\x12\xb4\x7f\x0b\x12\xaf\x7f\x0b\x12\xaa\x7f\x0b\x12\xa5\x7f\x0b\x12\xa0\x7f\x0b\x12\x9b\x7f\x0b\x12\x96\x7f\x0b\x12\x91\x7f\x0b\x12\x8c\x7f\x0b\x12\x87\x7f\x0b\x12\x82\x7f\x0b\x12\xfd~\x0b\x12\xf8~\x0b\x12\xf3~\x0b\x12\xee~\x0b\x12\xe9~\x0b\x12\xe4~\x0b\x12\xdf~\x0b\x12\xda~\x0b\x12\xd5~\x0b\x12\xd0~\x0b\x12\xcb~\x0b\x12\xc6~\x0b\x12\xc1~\x0b\x12\xbc~\x0b\x12\xb7~\x0b\x12\xb2~\x0b\x12\xad~\x0b\x12\xa8~\x0b\x12\xa3~\x0b\x12\x9e~\x0b\x12\x99~\x0b\x12\x94~\x0b\x12\x8f~\x0b\x12\x8a~\x0b\x12\x85~\x0b\x12\x80~\x0b\x12\xfb}\x0b\x12\xf6}\x0b\x12\xf1}\x0b\x12\xec}\x0b\x12\xe7}\x0b\x12\xe2}\x0b\x12\xdd}\x0b\x12\xd8}\x0b\x12\xd3}\x0b\x12\xce}\x0b\x12\xc9}\x0b\x12\xc4}\x0b\x12\xbf}\x0b\x12\xba}\x0b\x12\xb5}\x0b\x12\xb0}\x0b\x12\xab}\x0b\x12\xa6}\x0b\x12\xa1}\x0b\x12\x9c}\x0b\x12\x97}\x0b\x12\x92}\x0b\x12\x8d}\x0b\x12\x88}\x0b\x12\x83}\x0b\x12\xfe|\x0b\x12\xf9|\x0b\x12\xf4|\x0b\x12\xef|\x0b\x12\xea|\x0b\x12\xe5|\x0b\x12\xe0|\x0b\x12\xdb|\x0b\x12\xd6|\x0b\x12\xd1|\x0b\x12\xcc|\x0b\x12\xc7|\x0b\x12\xc2|\x0b\x12\xbd|\x0b\x12\xb8|\x0b\x12\xb3|\x0b\x12\xae|\x0b\x12\xa9|\x0b\x12\xa4|\x0b\x12\x9f|\x0b\x12\x9a|\x0b\x12\x95|\x0b\x12\x90|\x0b\x12\x8b|\x0b\x12\x86|\x0b\x12\x81|\x0b\x12\xfc{\x0b\x12\xf7{\x0b\x12\xf2{\x0b\x12\xed{\x0b\x12\xe8{\x0b\x12\xe3{\x0b\x12\xde{\x0b\x12\xd9{\x0b\x12\xd4{\x0b\x12\xcf{\x0b\x12\xca{\x0b\x12\xc5{\x0b\x12\xc0{\x0b\x12\xbb{\x0b\x12\xb6{\x0b\x12\xb1{\x0b\x12\xac{\x0b\x12\xa7{\x0b\x12\xa2{\x0b\x12\x9d{\x0b\x12\x98{\x0b\x12\x93{\x0b\x12\x8e{\x0b\x12\x89{\x0b\x12\x84{\x0b\x12\xffz\x0b\x12\xfaz\x0b\x12\xf5z\x0b\x12\xf0z\x0b\x12\xebz\x0b\x12\xe6z\x0b\x12\xe1z\x0b\x12\xdcz\x0b\x12\xd7z\x0b\x12\xd2z\x0b\x12\xcdz\x0b\x12\xc8z\x0b\x12\xc3z\x0b\x12\xbez\x0b\x12\xb9z\x0b\x12\xb4z\x0b\x12\xafz\x0b\x12\xaaz\x0b\x12\xa5z\x0b\x12\xa0z\x0b\x12\x9bz\x0b\x12\x96z\x0b\x12\x91z\x0b\x12\x8cz\x0b\x12\x87z\x0b\x12\x82z\x0b\x12\xfdy\x0b\x12\xf8y\x0b\x12\xf3y\x0b\x12\xeey\x0b\x12\xe9y\x0b\x12\xe4y\x0b\x12\xdfy\x0b\x12\xday\x0b\x12\xd5y\x0b\x12\xd0y\x0b\x12\xcby\x0b\x12\xc6y\x0b\x12\xc1y\x0b\x12\xbcy\x0b\x12\xb7y\x0b\x12\xb2y\x0b\x12\xady\x0b\x12\xa8y\x0b\x12\xa3y\x0b\x12\x9ey\x0b\x12\x99y\x0b\x12\x94y\x0b\x12\x8fy\x0b\x12\x8ay\x0b\x12\x85y\x0b\x12\x80y\x0b\x12\xfbx\x0b\x12\xf6x\x0b\x12\xf1x\x0b\x12\xecx\x0b\x12\xe7x\x0b\x12\xe2x\x0b\x12\xddx\x0b\x12\xd8x\x0b\x12\xd3x\x0b\x12\xcex\x0b\x12\xc9x\x0b\x12\xc4x\x0b\x12\xbfx\x0b\x12\xbax\x0b\x12\xb5x\x0b\x12\xb0x\x0b\x12\xabx\x0b\x12\xa6x\x0b\x12\xa1x\x0b\x12\x9cx\x0b\x12\x97x\x0b\x12\x92x\x0b\x12\x8dx\x0b\x12\x88x\x0b\x12\x83x\x0b\x12\xfew\x0b\x12\xf9w\x0b\x12\xf4w\x0b\x12\xefw\x0b\x12\xeaw\x0b\x12\xe5w\x0b\x12\xe0w\x0b\x12\xdbw\x0b\x12\xd6w\x0b\x12\xd1w\x0b\x12\xccw\x0b\x12\xc7w\x0b\x12\xc2w\x0b\x12\xbdw\x0b\x12\xb8w\x0b\x12\xb3w\x0b\x12\xaew\x0b\x12\xa9w\x0b\x12\xa4w\x0b\x12\x9fw\x0b\x12\x9aw\x0b\x12\x95w\x0b\x12\x90w\x0b\x12\x8bw\x0b\x12\x86w\x0b\x12\x81w\x0b\x12\xfcv\x0b\x12\xf7v\x0b\x12\xf2v\x0b\x12\xedv\x0b\x12\xe8v\x0b\x12\xe3v\x0b\x12\xdev\x0b\x12\xd9v\x0b\x12\xd4v\x0b\x12\xcfv\x0b\x12\xcav\x0b\x12\xc5v\x0b\x12\xc0v\x0b\x12\xbbv\x0b\x12\xb6v\x0b\x12\xb1v\x0b\x12\xacv\x0b\x12\xa7v\x0b\x12\xa2v\x0b\x12\x9dv\x0b\x12\x98v\x0b\x12\x93v\x0b\x12\x8ev\x0b\x12\x89v\x0b\x12\x84v\x0b\x12\xffu\x0b\x12\xfau\x0b\x12\xf5u\x0b\x12\xf0u\x0b\x12\xebu\x0b\x12\xe6u\x0b\x12\xe1u\x0b\x12\xdcu\x0b\x12\xd7u\x0b\x12\xd2u\x0b\x12\xcdu\x0b\x12\xc8u\x0b\x12\xc3u\x0b\x12\xbeu\x0b\x12\xb9u\x0b\x12\xb4u\x0b\x12\xafu\x0b\x12\xaau\x0b\x12\xa5u\x0b\x12\xa0u\x0b\x12\x9bu\x0b\x12\x96u\x0b\x12\x91u\x0b\x12\x8cu\x0b\x12\x87u\x0b\x12\x82u\x0b\x12\xfdt\x0b\x12\xf8t\x0b\x12\xf3t\x0b\x12\xeet\x0b\x12\xe9t\x0b\x12\xe4t\x0b\x12\xdft\x0b\x12\xdat\x0b\x12\xd5t\x0b\x12\xd0t\x0b\x12\xcbt\x0b\x12\xc6t\x0b\x12\xc1t\x0b\x12\xbct\x0b\x12\xb7t\x0b\x12\xb2t\x0b\x12\xadt\x0b\x12\xa8t\x0b\x12\xa3t\x0b\x12\x9et\x0b\x12\x99t\x0b\x12\x94t\x0b\x12\x8ft\x0b\x12\x8at\x0b\x12\x85t\x0b\x12\x80t\x0b\x12\xfbs\x0b\x12\xf6s\x0b\x12\xf1s\x0b\x12\xecs\x0b\x12\xe7s\x0b\x12\xe2s\x0b\x12\xdds\x0b\x12\xd8s\x0b\x12\xd3s\x0b\x12\xces\x0b\x12\xc9s\x0b\x12\xc4s\x0b\x12\xbfs\x0b\x12\xbas\x0b\x12\xb5s\x0b\x12\xb0s\x0b\x12\xabs\x0b\x12\xa6s\x0b\x12\xa1s\x0b\x12\x9cs\x0b\x12\x97s\x0b\x12\x92s\x0b\x12\x8ds\x0b\x12\x88s\x0b\x12\x83s\x0b\x12\xfer\x0b\x12\xf9r\x0b\x12\xf4r\x0b\x12\xefr\x0b\x12\xear\x0b\x12\xe5r\x0b\x12\xe0r\x0b\x12\xdbr\x0b\x12\xd6r\x0b\x12\xd1r\x0b\x12\xccr\x0b\x12\xc7r\x0b\x12\xc2r\x0b\x12\xbdr\x0b\x12\xb8r\x0b\x12\xb3r\x0b\x12\xaer\x0b\x12\xa9r\x0b\x12\xa4r\x0b\x12\x9fr\x0b\x12\x9ar\x0b\x12\x95r\x0b\x12\x90r\x0b\x12\x8br\x0b\x12\x86r\x0b\x12\x81r\x0b\x12\xfcq\x0b\x12\xf7q\x0b\x12\xf2q\x0b\x12\xedq\x0b\x12\xe8q\x0b\x12\xe3q\x0b\x12\xdeq\x0b\x12\xd9q\x0b\x12\xd4q\x0b\x12\xcfq\x0b\x12\xcaq\x0b\x12\xc5q\x0b\x12\xc0q\x0b\x12\xbbq\x0b\x12\xb6q\x0b\x12\xb1q\x0b\x12\xacq\x0b\x12\xa7q\x0b\x12\xa2q\x0b\x12\x9dq\x0b\x12\x98q\x0b\x12\x93q\x0b\x12\x8eq\x0b\x12\x89q\x0b\x12\x84q\x0b\x12\xffp\x0b\x12\xfap\x0b\x12\xf5p\x0b\x12\xf0p\x0b\x12\xebp\x0b\x12\xe6p\x0b\x12\xe1p\x0b\x12\xdcp\x0b\x12\xd7p\x0b\x12\xd2p\x0b\x12\xcdp\x0b\x12\xc8p\x0b\x12\xc3p\x0b\x12\xbep\x0b\x12\xb9p\x0b\x12\xb4p\x0b\x12\xafp\x0b\x12\xaap\x0b\x12\xa5p\x0b\x12\xa0p\x0b\x12\x9bp\x0b\x12\x96p\x0b\x12\x91p\x0b\x12\x8cp\x0b\x12\x87p\x0b\x12\x82p\x0b\x12\xfdo\x0b\x12\xf8o\x0b\x12\xf3o\x0b\x12\xeeo\x0b\x12\xe9o\x0b\x12\xe4o\x0b\x12\xdfo\x0b\x12\xdao\x0b\x12\xd5o\x0b\x12\xd0o\x0b\x12\xcbo\x0b\x12\xc6o\x0b\x12\xc1o\x0b\x12\xbco\x0b\x12\xb7o\x0b\x12\xb2o\x0b\x12\xado\x0b\x12\xa8o\x0b\x12\xa3o\x0b\x12\x9eo\x0b\x12\x99o\x0b\x12\x94o\x0b\x12\x8fo\x0b\x12\x8ao\x0b\x12\x85o\x0b\x12\x80o\x0b\x12\xfbn\x0b\x12\xf6n\x0b\x12\xf1n\x0b\x12\xecn\x0b\x12\xe7n\x0b\x12\xe2n\x0b\x12\xddn\x0b\x12\xd8n\x0b\x12\xd3n\x0b\x12\xcen\x0b\x12\xc9n\x0b\x12\xc4n\x0b\x12\xbfn\x0b\x12\xban\x0b\x12\xb5n\x0b\x12\xb0n\x0b\x12\xabn\x0b\x12\xa6n\x0b\x12\xa1n\x0b\x12\x9cn\x0b\x12\x97n\x0b\x12\x92n\x0b\x12\x8dn\x0b\x12\x88n\x0b\x12\x83n\x0b\x12\xfem\x0b\x12\xf9m\x0b\x12\xf4m\x0b\x12\xefm\x0b\x12\xeam\x0b\x12\xe5m\x0b\x12\xe0m\x0b\x12\xdbm\x0b\x12\xd6m\x0b\x12\xd1m\x0b\x12\xccm\x0b\x12\xc7m\x0b\x12\xc2m\x0b\x12\xbdm\x0b\x12\xb8m\x0b\x12\xb3m\x0b\x12\xaem\x0b\x12\xa9m\x0b\x12\xa4m\x0b\x12\x9fm\x0b\x12\x9am\x0b\x12\x95m\x0b\x12\x90m\x0b\x12\x8bm\x0b\x12\x86m\x0b\x12\x81m\x0b\x12\xfcl\x0b\x12\xf7l\x0b\x12\xf2l\x0b\x12\xedl\x0b\x12\xe8l\x0b\x12\xe3l\x0b\x12\xdel\x0b\x12\xd9l\x0b\x12\xd4l\x0b\x12\xcfl\x0b\x12\xcal\x0b\x12\xc5l\x0b\x12\xc0l\x0b\x12\xbbl\x0b\x12\xb6l\x0b\x12\xb1l\x0b\x12\xacl\x0b\x12\xa7l\x0b\x12\xa2l\x0b\x12\x9dl\x0b\x12\x98l\x0b\x12\x93l\x0b\x12\x8el\x0b\x12\x89l\x0b\x12\x84l\x0b\x12\xffk\x0b\x12\xfak\x0b\x12\xf5k\x0b\x12\xf0k\x0b\x12\xebk\x0b\x12\xe6k\x0b\x12\xe1k\x0b\x12\xdck\x0b\x12\xd7k\x0b\x12\xd2k\x0b\x12\xcdk\x0b\x12\xc8k\x0b\x12\xc3k\x0b\x12\xbek\x0b\x12\xb9k\x0b\x12\xb4k\x0b\x12\xafk\x0b\x12\xaak\x0b\x12\xa5k\x0b\x12\xa0k\x0b\x12\x9bk\x0b\x12\x96k\x0b\x12\x91k\x0b\x12\x8ck\x0b\x12\x87k\x0b\x12\x82k\x0b\x12\xfdj\x0b\x12\xf8j\x0b\x12\xf3j\x0b\x12\xeej\x0b\x12\xe9j\x0b\x12\xe4j\x0b\x12\xdfj\x0b\x12\xdaj\x0b\x12\xd5j\x0b\x12\xd0j\x0b\x12\xcbj\x0b\x12\xc6j\x0b\x12\xc1j\x0b\x12\xbcj\x0b\x12\xb7j\x0b\x12\xb2j\x0b\x12\xadj\x0b\x12\xa8j\x0b\x12\xa3j\x0b\x12\x9ej\x0b\x12\x99j\x0b\x12\x94j\x0b\x12\x8fj\x0b\x12\x8aj\x0b\x12\x85j\x0b\x12\x80j\x0b\x12\xfbi\x0b\x12\xf6i\x0b\x12\xf1i\x0b\x12\xeci\x0b\x12\xe7i\x0b\x12\xe2i\x0b\x12\xddi\x0b\x12\xd8i\x0b\x12\xd3i\x0b\x12\xcei\x0b\x12\xc9i\x0b\x12\xc4i\x0b\x12\xbfi\x0b\x12\xbai\x0b\x12\xb5i\x0b\x12\xb0i\x0b\x12\xabi\x0b\x12\xa6i\x0b\x12\xa1i\x0b\x12\x9ci\x0b\x12\x97i\x0b\x12\x92i\x0b\x12\x8di\x0b\x12\x88i\x0b\x12\x83i\x0b\x12\xfeh\x0b\x12\xf9h\x0b\x12\xf4h\x0b\x12\xefh\x0b\x12\xeah\x0b\x12\xe5h\x0b\x12\xe0h\x0b\x12\xdbh\x0b\x12\xd6h\x0b\x12\xd1h\x0b\x12\xcch\x0b\x12\xc7h\x0b\x12\xc2h\x0b\x12\xbdh\x0b\x12\xb8h\x0b\x12\xb3h\x0b\x12\xaeh\x0b\x12\xa9h\x0b\x12\xa4h\x0b\x12\x9fh\x0b\x12\x9ah\x0b\x12\x95h\x0b\x12\x90h\x0b\x12\x8bh\x0b\x12\x86h\x0b\x12\x81h\x0b\x12\xfcg\x0b\x12\xf7g\x0b\x12\xf2g\x0b\x12\xedg\x0b\x12\xe8g\x0b\x12\xe3g\x0b\x12\xdeg\x0b\x12\xd9g\x0b\x12\xd4g\x0b\x12\xcfg\x0b\x12\xcag\x0b\x12\xc5g\x0b\x12\xc0g\x0b\x12\xbbg\x0b\x12\xb6g\x0b\x12\xb1g\x0b\x12\xacg\x0b\x12\xa7g\x0b\x12\xa2g\x0b\x12\x9dg\x0b\x12\x98g\x0b\x12\x93g\x0b\x12\x8eg\x0b\x12\x89g\x0b\x12\x84g\x0b\x12\xfff\x0b\x12\xfaf\x0b\x12\xf5f\x0b\x12\xf0f\x0b\x12\xebf\x0b\x12\xe6f\x0b\x12\xe1f\x0b\x12\xdcf\x0b\x12\xd7f\x0b\x12\xd2f\x0b\x12\xcdf\x0b\x12\xc8f\x0b\x12\xc3f\x0b\x12\xbef\x0b\x12\xb9f\x0b\x12\xb4f\x0b\x12\xaff\x0b\x12\xaaf\x0b\x12\xa5f\x0b\x12\xa0f\x0b\x12\x9bf\x0b\x12\x96f\x0b\x12\x91f\x0b\x12\x8cf\x0b\x12\x87f\x0b\x12\x82f\x0b\x12\xfde\x0b\x12\xf8e\x0b\x12\xf3e\x0b\x12\xeee\x0b\x12\xe9e\x0b\x12\xe4e\x0b\x12\xdfe\x0b\x12\xdae\x0b\x12\xd5e\x0b\x12\xd0e\x0b\x12\xcbe\x0b\x12\xc6e\x0b\x12\xc1e\x0b\x12\xbce\x0b\x12\xb7e\x0b\x12\xb2e\x0b\x12\xade\x0b\x12\xa8e\x0b\x12\xa3e\x0b\x12\x9ee\x0b\x12\x99e\x0b\x12\x94e\x0b\x12\x8fe\x0b\x12\x8ae\x0b\x12\x85e\x0b\x12\x80e\x0b\x12\xfbd\x0b\x12\xf6d\x0b\x12\xf1d\x0b\x12\xecd\x0b\x12\xe7d\x0b\x12\xe2d\x0b\x12\xddd\x0b\x12\xd8d\x0b\x12\xd3d\x0b\x12\xced\x0b\x12\xc9d\x0b\x12\xc4d\x0b\x12\xbfd\x0b\x12\xbad\x0b\x12\xb5d\x0b\x12\xb0d\x0b\x12\xabd\x0b\x12\xa6d\x0b\x12\xa1d\x0b\x12\x9cd\x0b\x12\x97d\x0b\x12\x92d\x0b\x12\x8dd\x0b\x12\x88d\x0b\x12\x83d\x0b\x12\xfec\x0b\x12\xf9c\x0b\x12\xf4c\x0b\x12\xefc\x0b\x12\xeac\x0b\x12\xe5c\x0b\x12\xe0c\x0b\x12\xdbc\x0b\x12\xd6c\x0b\x12\xd1c\x0b\x12\xccc\x0b\x12\xc7c\x0b\x12\xc2c\x0b\x12\xbdc\x0b\x12\xb8c\x0b\x12\xb3c\x0b\x12\xaec\x0b\x12\xa9c\x0b\x12\xa4c\x0b\x12\x9fc\x0b\x12\x9ac\x0b\x12\x95c\x0b\x12\x90c\x0b\x12\x8bc\x0b\x12\x86c\x0b\x12\x81c\x0b\x12\xfcb\x0b\x12\xf7b\x0b\x12\xf2b\x0b\x12\xedb\x0b\x12\xe8b\x0b\x12\xe3b\x0b\x12\xdeb\x0b\x12\xd9b\x0b\x12\xd4b\x0b\x12\xcfb\x0b\x12\xcab\x0b\x12\xc5b\x0b\x12\xc0b\x0b\x12\xbbb\x0b\x12\xb6b\x0b\x12\xb1b\x0b\x12\xacb\x0b\x12\xa7b\x0b\x12\xa2b\x0b\x12\x9db\x0b\x12\x98b\x0b\x12\x93b\x0b\x12\x8eb\x0b\x12\x89b\x0b\x12\x84b\x0b\x12\xffa\x0b\x12\xfaa\x0b\x12\xf5a\x0b\x12\xf0a\x0b\x12\xeba\x0b\x12\xe6a\x0b\x12\xe1a\x0b\x12\xdca\x0b\x12\xd7a\x0b\x12\xd2a\x0b\x12\xcda\x0b\x12\xc8a\x0b\x12\xc3a\x0b\x12\xbea\x0b\x12\xb9a\x0b\x12\xb4a\x0b\x12\xafa\x0b\x12\xaaa\x0b\x12\xa5a\x0b\x12\xa0a\x0b\x12\x9ba\x0b\x12\x96a\x0b\x12\x91a\x0b\x12\x8ca\x0b\x12\x87a\x0b\x12\x82a\x0b\x12\xfd`\x0b\x12\xf8`\x0b\x12\xf3`\x0b\x12\xee`\x0b\x12\xe9`\x0b\x12\xe4`\x0b\x12\xdf`\x0b\x12\xda`\x0b\x12\xd5`\x0b\x12\xd0`\x0b\x12\xcb`\x0b\x12\xc6`\x0b\x12\xc1`\x0b\x12\xbc`\x0b\x12\xb7`\x0b\x12\xb2`\x0b\x12\xad`\x0b\x12\xa8`\x0b\x12\xa3`\x0b\x12\x9e`\x0b\x12\x99`\x0b\x12\x94`\x0b\x12\x8f`\x0b\x12\x8a`\x0b\x12\x85`\x0b\x12\x80`\x0b\x12\xfb_\x0b\x12\xf6_\x0b\x12\xf1_\x0b\x12\xec_\x0b\x12\xe7_\x0b\x12\xe2_\x0b\x12\xdd_\x0b\x12\xd8_\x0b\x12\xd3_\x0b\x12\xce_\x0b\x12\xc9_\x0b\x12\xc4_\x0b\x12\xbf_\x0b\x12\xba_\x0b\x12\xb5_\x0b\x12\xb0_\x0b\x12\xab_\x0b\x12\xa6_\x0b\x12\xa1_\x0b\x12\x9c_\x0b\x12\x97_\x0b\x12\x92_\x0b\x12\x8d_\x0b\x12\x88_\x0b\x12\x83_\x0b\x12\xfe^\x0b\x12\xf9^\x0b\x12\xf4^\x0b\x12\xef^\x0b\x12\xea^\x0b\x12\xe5^\x0b\x12\xe0^\x0b\x12\xdb^\x0b\x12\xd6^\x0b\x12\xd1^\x0b\x12\xcc^\x0b\x12\xc7^\x0b\x12\xc2^\x0b\x12\xbd^\x0b\x12\xb8^\x0b\x12\xb3^\x0b\x12\xae^\x0b\x12\xa9^\x0b\x12\xa4^\x0b\x12\x9f^\x0b\x12\x9a^\x0b\x12\x95^\x0b\x12\x90^\x0b\x12\x8b^\x0b\x12\x86^\x0b\x12\x81^\x0b\x12\xfc]\x0b\x12\xf7]\x0b\x12\xf2]\x0b\x12\xed]\x0b\x12\xe8]\x0b\x12\xe3]\x0b\x12\xde]\x0b\x12\xd9]\x0b\x12\xd4]\x0b\x12\xcf]\x0b\x12\xca]\x0b\x12\xc5]\x0b\x12\xc0]\x0b\x12\xbb]\x0b\x12\xb6]\x0b\x12\xb1]\x0b\x12\xac]\x0b\x12\xa7]\x0b\x12\xa2]\x0b\x12\x9d]\x0b\x12\x98]\x0b\x12\x93]\x0b\x12\x8e]\x0b\x12\x89]\x0b\x12\x84]\x0b\x12\xff\\\x0b\x12\xfa\\\x0b\x12\xf5\\\x0b\x12\xf0\\\x0b\x12\xeb\\\x0b\x12\xe6\\\x0b\x12\xe1\\\x0b\x12\xdc\\\x0b\x12\xd7\\\x0b\x12\xd2\\\x0b\x12\xcd\\\x0b\x12\xc8\\\x0b\x12\xc3\\\x0b\x12\xbe\\\x0b\x12\xb9\\\x0b\x12\xb4\\\x0b\x12\xaf\\\x0b\x12\xaa\\\x0b\x12\xa5\\\x0b\x12\xa0\\\x0b\x12\x9b\\\x0b\x12\x96\\\x0b\x12\x91\\\x0b\x12\x8c\\\x0b\x12\x87\\\x0b\x12\x82\\\x0b\x12\xfd[\x0b\x12\xf8[\x0b\x12\xf3[\x0b\x12\xee[\x0b\x12\xe9[\x0b\x12\xe4[\x0b\x12\xdf[\x0b\x12\xda[\x0b\x12\xd5[\x0b\x12\xd0[\x0b\x12\xcb[\x0b\x12\xc6[\x0b\x12\xc1[\x0b\x12\xbc[\x0b\x12\xb7[\x0b\x12\xb2[\x0b\x12\xad[\x0b\x12\xa8[\x0b\x12\xa3[\x0b\x12\x9e[\x0b\x12\x99[\x0b\x12\x94[\x0b\x12\x8f[\x0b\x12\x8a[\x0b\x12\x85[\x0b\x12\x80[\x0b\x12\xfbZ\x0b\x12\xf6Z\x0b\x12\xf1Z\x0b\x12\xecZ\x0b\x12\xe7Z\x0b\x12\xe2Z\x0b\x12\xddZ\x0b\x12\xd8Z\x0b\x12\xd3Z\x0b\x12\xceZ\x0b\x12\xc9Z\x0b\x12\xc4Z\x0b\x12\xbfZ\x0b\x12\xbaZ\x0b\x12\xb5Z\x0b\x12\xb0Z\x0b\x12\xabZ\x0b\x12\xa6Z\x0b\x12\xa1Z\x0b\x12\x9cZ\x0b\x12\x97Z\x0b\x12\x92Z\x0b\x12\x8dZ\x0b\x12\x88Z\x0b\x12\x83Z\x0b\x12\xfeY\x0b\x12\xf9Y\x0b\x12\xf4Y\x0b\x12\xefY\x0b\x12\xeaY\x0b\x12\xe5Y\x0b\x12\xe0Y\x0b\x12\xdbY\x0b\x12\xd6Y\x0b\x12\xd1Y\x0b\x12\xccY\x0b\x12\xc7Y\x0b\x12\xc2Y\x0b\x12\xbdY\x0b\x12\xb8Y\x0b\x12\xb3Y\x0b\x12\xaeY\x0b\x12\xa9Y\x0b\x12\xa4Y\x0b\x12\x9fY\x0b\x12\x9aY\x0b\x12\x95Y\x0b\x12\x90Y\x0b\x12\x8bY\x0b\x12\x86Y\x0b\x12\x81Y\x0b\x12\xfcX\x0b\x12\xf7X\x0b\x12\xf2X\x0b\x12\xedX\x0b\x12\xe8X\x0b\x12\xe3X\x0b\x12\xdeX\x0b\x12\xd9X\x0b\x12\xd4X\x0b\x12\xcfX\x0b\x12\xcaX\x0b\x12\xc5X\x0b\x12\xc0X\x0b\x12\xbbX\x0b\x12\xb6X\x0b\x12\xb1X\x0b\x12\xacX\x0b\x12\xa7X\x0b\x12\xa2X\x0b\x12\x9dX\x0b\x12\x98X\x0b\x12\x93X\x0b\x12\x8eX\x0b\x12\x89X\x0b\x12\x84X\x0b\x12\xffW\x0b\x12\xfaW\x0b\x12\xf5W\x0b\x12\xf0W\x0b\x12\xebW\x0b\x12\xe6W\x0b\x12\xe1W\x0b\x12\xdcW\x0b\x12\xd7W\x0b\x12\xd2W\x0b\x12\xcdW\x0b\x12\xc8W\x0b\x12\xc3W\x0b\x12\xbeW\x0b\x12\xb9W\x0b\x12\xb4W\x0b\x12\xafW\x0b\x12\xaaW\x0b\x12\xa5W\x0b\x12\xa0W\x0b\x12\x9bW\x0b\x12\x96W\x0b\x12\x91W\x0b\x12\x8cW\x0b\x12\x87W\x0b\x12\x82W\x0b\x12\xfdV\x0b\x12\xf8V\x0b\x12\xf3V\x0b\x12\xeeV\x0b\x12\xe9V\x0b\x12\xe4V\x0b\x12\xdfV\x0b\x12\xdaV\x0b\x12\xd5V\x0b\x12\xd0V\x0b\x12\xcbV\x0b\x12\xc6V\x0b\x12\xc1V\x0b\x12\xbcV\x0b\x12\xb7V\x0b\x12\xb2V\x0b\x12\xadV\x0b\x12\xa8V\x0b\x12\xa3V\x0b\x12\x9eV\x0b\x12\x99V\x0b\x12\x94V\x0b\x12\x8fV\x0b\x12\x8aV\x0b\x12\x85V\x0b\x12\x80V\x0b\x12\xfbU\x0b\x12\xf6U\x0b\x12\xf1U\x0b\x12\xecU\x0b\x12\xe7U\x0b\x12\xe2U\x0b\x12\xddU\x0b\x12\xd8U\x0b\x12\xd3U\x0b\x12\xceU\x0b\x12\xc9U\x0b\x12\xc4U\x0b\x12\xbfU\x0b\x12\xbaU\x0b\x12\xb5U\x0b\x12\xb0U\x0b\x12\xabU\x0b\x12\xa6U\x0b\x12\xa1U\x0b\x12\x9cU\x0b\x12\x97U\x0b\x12\x92U\x0b\x12\x8dU\x0b\x12\x88U\x0b\x12\x83U\x0b\x12\xfeT\x0b\x12\xf9T\x0b\x12\xf4T\x0b\x12\xefT\x0b\x12\xeaT\x0b\x12\xe5T\x0b\x12\xe0T\x0b\x12\xdbT\x0b\x12\xd6T\x0b\x12\xd1T\x0b\x12\xccT\x0b\x12\xc7T\x0b\x12\xc2T\x0b\x12\xbdT\x0b\x12\xb8T\x0b\x12\xb3T\x0b\x12\xaeT\x0b\x12\xa9T\x0b\x12\xa4T\x0b\x12\x9fT\x0b\x12\x9aT\x0b\x12\x95T\x0b\x12\x90T\x0b\x12\x8bT\x0b\x12\x86T\x0b\x12\x81T\x0b\x12\xfcS\x0b\x12\xf7S\x0b\x12\xf2S\x0b\x12\xedS\x0b\x12\xe8S\x0b\x12\xe3S\x0b\x12\xdeS\x0b\x12\xd9S\x0b\x12\xd4S\x0b\x12\xcfS\x0b\x12\xcaS\x0b\x12\xc5S\x0b\x12\xc0S\x0b\x12\xbbS\x0b\x12\xb6S\x0b\x12\xb1S\x0b\x12\xacS\x0b\x12\xa7S\x0b\x12\xa2S\x0b\x12\x9dS\x0b\x12\x98S\x0b\x12\x93S\x0b\x12\x8eS\x0b\x12\x89S\x0b\x12\x84S\x0b\x12\xffR\x0b\x12\xfaR\x0b\x12\xf5R\x0b\x12\xf0R\x0b\x12\xebR\x0b\x12\xe6R\x0b\x12\xe1R\x0b\x12\xdcR\x0b\x12\xd7R\x0b\x12\xd2R\x0b\x12\xcdR\x0b\x12\xc8R\x0b\x12\xc3R\x0b\x12\xbeR\x0b\x12\xb9R\x0b\x12\xb4R\x0b\x12\xafR\x0b\x12\xaaR\x0b\x12\xa5R\x0b\x12\xa0R\x0b\x12\x9bR\x0b\x12\x96R\x0b\x12\x91R\x0b\x12\x8cR\x0b\x12\x87R\x0b\x12\x82R\x0b\x12\xfdQ\x0b\x12\xf8Q\x0b\x12\xf3Q\x0b\x12\xeeQ\x0b\x12\xe9Q\x0b\x12\xe4Q\x0b\x12\xdfQ\x0b\x12\xdaQ\x0b\x12\xd5Q\x0b\x12\xd0Q\x0b\x12\xcbQ\x0b\x12\xc6Q\x0b\x12\xc1Q\x0b\x12\xbcQ\x0b\x12\xb7Q\x0b\x12\xb2Q\x0b\x12\xadQ\x0b\x12\xa8Q\x0b\x12\xa3Q\x0b\x12\x9eQ\x0b\x12\x99Q\x0b\x12\x94Q\x0b\x12\x8fQ\x0b\x12\x8aQ\x0b\x12\x85Q\x0b\x12\x80Q\x0b\x12\xfbP\x0b\x12\xf6P\x0b\x12\xf1P\x0b\x12\xecP\x0b\x12\xe7P\x0b\x12\xe2P\x0b\x12\xddP\x0b\x12\xd8P\x0b\x12\xd3P\x0b\x12\xceP\x0b\x12\xc9P\x0b\x12\xc4P\x0b\x12\xbfP\x0b\x12\xbaP\x0b\x12\xb5P\x0b\x12\xb0P\x0b\x12\xabP\x0b\x12\xa6P\x0b\x12\xa1P\x0b\x12\x9cP\x0b\x12\x97P\x0b\x12\x92P\x0b\x12\x8dP\x0b\x12\x88P\x0b\x12\x83P\x0b\x12\xfeO\x0b\x12\xf9O\x0b\x12\xf4O\x0b\x12\xefO\x0b\x12\xeaO\x0b\x12\xe5O\x0b\x12\xe0O\x0b\x12\xdbO\x0b\x12\xd6O\x0b\x12\xd1O\x0b\x12\xccO\x0b\x12\xc7O\x0b\x12\xc2O\x0b\x12\xbdO\x0b\x12\xb8O\x0b\x12\xb3O\x0b\x12\xaeO\x0b\x12\xa9O\x0b\x12\xa4O\x0b\x12\x9fO\x0b\x12\x9aO\x0b\x12\x95O\x0b\x12\x90O\x0b\x12\x8bO\x0b\x12\x86O\x0b\x12\x81O\x0b\x12\xfcN\x0b\x12\xf7N\x0b\x12\xf2N\x0b\x12\xedN\x0b\x12\xe8N\x0b\x12\xe3N\x0b\x12\xdeN\x0b\x12\xd9N\x0b\x12\xd4N\x0b\x12\xcfN\x0b\x12\xcaN\x0b\x12\xc5N\x0b\x12\xc0N\x0b\x12\xbbN\x0b\x12\xb6N\x0b\x12\xb1N\x0b\x12\xacN\x0b\x12\xa7N\x0b\x12\xa2N\x0b\x12\x9dN\x0b\x12\x98N\x0b\x12\x93N\x0b\x12\x8eN\x0b\x12\x89N\x0b\x12\x84N\x0b\x12\xffM\x0b\x12\xfaM\x0b\x12\xf5M\x0b\x12\xf0M\x0b\x12\xebM\x0b\x12\xe6M\x0b\x12\xe1M\x0b\x12\xdcM\x0b\x12\xd7M\x0b\x12\xd2M\x0b\x12\xcdM\x0b\x12\xc8M\x0b\x12\xc3M\x0b\x12\xbeM\x0b\x12\xb9M\x0b\x12\xb4M\x0b\x12\xafM\x0b\x12\xaaM\x0b\x12\xa5M\x0b\x12\xa0M\x0b\x12\x9bM\x0b\x12\x96M\x0b\x12\x91M\x0b\x12\x8cM\x0b\x12\x87M\x0b\x12\x82M\x0b\x12\xfdL\x0b\x12\xf8L\x0b\x12\xf3L\x0b\x12\xeeL\x0b\x12\xe9L\x0b\x12\xe4L\x0b\x12\xdfL\x0b\x12\xdaL\x0b\x12\xd5L\x0b\x12\xd0L\x0b\x12\xcbL\x0b\x12\xc6L\x0b\x12\xc1L\x0b\x12\xbcL\x0b\x12\xb7L\x0b\x12\xb2L\x0b\x12\xadL\x0b\x12\xa8L\x0b\x12\xa3L\x0b\x12\x9eL\x0b\x12\x99L\x0b\x12\x94L\x0b\x12\x8fL\x0b\x12\x8aL\x0b\x12\x85L\x0b\x12\x80L\x0b\x12\xfbK\x0b\x12\xf6K\x0b\x12\xf1K\x0b\x12\xecK\x0b\x12\xe7K\x0b\x12\xe2K\x0b\x12\xddK\x0b\x12\xd8K\x0b\x12\xd3K\x0b\x12\xceK\x0b\x12\xc9K\x0b\x12\xc4K\x0b\x12\xbfK\x0b\x12\xbaK\x0b\x12\xb5K\x0b\x12\xb0K\x0b\x12\xabK\x0b\x12\xa6K\x0b\x12\xa1K\x0b\x12\x9cK\x0b\x12\x97K\x0b\x12\x92K\x0b\x12\x8dK\x0b\x12\x88K\x0b\x12\x83K\x0b\x12\xfeJ\x0b\x12\xf9J\x0b\x12\xf4J\x0b\x12\xefJ\x0b\x12\xeaJ\x0b\x12\xe5J\x0b\x12\xe0J\x0b\x12\xdbJ\x0b\x12\xd6J\x0b\x12\xd1J\x0b\x12\xccJ\x0b\x12\xc7J\x0b\x12\xc2J\x0b\x12\xbdJ\x0b\x12\xb8J\x0b\x12\xb3J\x0b\x12\xaeJ\x0b\x12\xa9J\x0b\x12\xa4J\x0b\x12\x9fJ\x0b\x12\x9aJ\x0b\x12\x95J\x0b\x12\x90J\x0b\x12\x8bJ\x0b\x12\x86J\x0b\x12\x81J\x0b\x12\xfcI\x0b\x12\xf7I\x0b\x12\xf2I\x0b\x12\xedI\x0b\x12\xe8I\x0b\x12\xe3I\x0b\x12\xdeI\x0b\x12\xd9I\x0b\x12\xd4I\x0b\x12\xcfI\x0b\x12\xcaI\x0b\x12\xc5I\x0b\x12\xc0I\x0b\x12\xbbI\x0b\x12\xb6I\x0b\x12\xb1I\x0b\x12\xacI\x0b\x12\xa7I\x0b\x12\xa2I\x0b\x12\x9dI\x0b\x12\x98I\x0b\x12\x93I\x0b\x12\x8eI\x0b\x12\x89I\x0b\x12\x84I\x0b\x12\xffH\x0b\x12\xfaH\x0b\x12\xf5H\x0b\x12\xf0H\x0b\x12\xebH\x0b\x12\xe6H\x0b\x12\xe1H\x0b\x12\xdcH\x0b\x12\xd7H\x0b\x12\xd2H\x0b\x12\xcdH\x0b\x12\xc8H\x0b\x12\xc3H\x0b\x12\xbeH\x0b\x12\xb9H\x0b\x12\xb4H\x0b\x12\xafH\x0b\x12\xaaH\x0b\x12\xa5H\x0b\x12\xa0H\x0b\x12\x9bH\x0b\x12\x96H\x0b\x12\x91H\x0b\x12\x8cH\x0b\x12\x87H\x0b\x12\x82H\x0b\x12\xfdG\x0b\x12\xf8G\x0b\x12\xf3G\x0b\x12\xeeG\x0b\x12\xe9G\x0b\x12\xe4G\x0b\x12\xdfG\x0b\x12\xdaG\x0b\x12\xd5G\x0b\x12\xd0G\x0b\x12\xcbG\x0b\x12\xc6G\x0b\x12\xc1G\x0b\x12\xbcG\x0b\x12\xb7G\x0b\x12\xb2G\x0b\x12\xadG\x0b\x12\xa8G\x0b\x12\xa3G\x0b\x12\x9eG\x0b\x12\x99G\x0b\x12\x94G\x0b\x12\x8fG\x0b\x12\x8aG\x0b\x12\x85G\x0b\x12\x80G\x0b\x12\xfbF\x0b\x12\xf6F\x0b\x12\xf1F\x0b\x12\xecF\x0b\x12\xe7F\x0b\x12\xe2F\x0b\x12\xddF\x0b\x12\xd8F\x0b\x12\xd3F\x0b\x12\xceF\x0b\x12\xc9F\x0b\x12\xc4F\x0b\x12\xbfF\x0b\x12\xbaF\x0b\x12\xb5F\x0b\x12\xb0F\x0b\x12\xabF\x0b\x12\xa6F\x0b\x12\xa1F\x0b\x12\x9cF\x0b\x12\x97F\x0b\x12\x92F\x0b\x12\x8dF\x0b\x12\x88F\x0b\x12\x83F\x0b\x12\xfeE\x0b\x12\xf9E\x0b\x12\xf4E\x0b\x12\xefE\x0b\x12\xeaE\x0b\x12\xe5E\x0b\x12\xe0E\x0b\x12\xdbE\x0b\x12\xd6E\x0b\x12\xd1E\x0b\x12\xccE\x0b\x12\xc7E\x0b\x12\xc2E\x0b\x12\xbdE\x0b\x12\xb8E\x0b\x12\xb3E\x0b\x12\xaeE\x0b\x12\xa9E\x0b\x12\xa4E\x0b\x12\x9fE\x0b\x12\x9aE\x0b\x12\x95E\x0b\x12\x90E\x0b\x12\x8bE\x0b\x12\x86E\x0b\x12\x81E\x0b\x12\xfcD\x0b\x12\xf7D\x0b\x12\xf2D\x0b\x12\xedD\x0b\x12\xe8D\x0b\x12\xe3D\x0b\x12\xdeD\x0b\x12\xd9D\x0b\x12\xd4D\x0b\x12\xcfD\x0b\x12\xcaD\x0b\x12\xc5D\x0b\x12\xc0D\x0b\x12\xbbD\x0b\x12\xb6D\x0b\x12\xb1D\x0b\x12\xacD\x0b\x12\xa7D\x0b\x12\xa2D\x0b\x12\x9dD\x0b\x12\x98D\x0b\x12\x93D\x0b\x12\x8eD\x0b\x12\x89D\x0b\x12\x84D\x0b\x12\xffC\x0b\x12\xfaC\x0b\x12\xf5C\x0b\x12\xf0C\x0b\x12\xebC\x0b\x12\xe6C\x0b\x12\xe1C\x0b\x12\xdcC\x0b\x12\xd7C\x0b\x12\xd2C\x0b\x12\xcdC\x0b\x12\xc8C\x0b\x12\xc3C\x0b\x12\xbeC\x0b\x12\xb9C\x0b\x12\xb4C\x0b\x12\xafC\x0b\x12\xaaC\x0b\x12\xa5C\x0b\x12\xa0C\x0b\x12\x9bC\x0b\x12\x96C\x0b\x12\x91C\x0b\x12\x8cC\x0b\x12\x87C\x0b\x12\x82C\x0b\x12\xfdB\x0b\x12\xf8B\x0b\x12\xf3B\x0b\x12\xeeB\x0b\x12\xe9B\x0b\x12\xe4B\x0b\x12\xdfB\x0b\x12\xdaB\x0b\x12\xd5B\x0b\x12\xd0B\x0b\x12\xcbB\x0b\x12\xc6B\x0b\x12\xc1B\x0b\x12\xbcB\x0b\x12\xb7B\x0b\x12\xb2B\x0b\x12\xadB\x0b\x12\xa8B\x0b\x12\xa3B\x0b\x12\x9eB\x0b\x12\x99B\x0b\x12\x94B\x0b\x12\x8fB\x0b\x12\x8aB\x0b\x12\x85B\x0b\x12\x80B\x0b\x12\xfbA\x0b\x12\xf6A\x0b\x12\xf1A\x0b\x12\xecA\x0b\x12\xe7A\x0b\x12\xe2A\x0b\x12\xddA\x0b\x12\xd8A\x0b\x12\xd3A\x0b\x12\xceA\x0b\x12\xc9A\x0b\x12\xc4A\x0b\x12\xbfA\x0b\x12\xbaA\x0b\x12\xb5A\x0b\x12\xb0A\x0b\x12\xabA\x0b\x12\xa6A\x0b\x12\xa1A\x0b\x12\x9cA\x0b\x12\x97A\x0b\x12\x92A\x0b\x12\x8dA\x0b\x12\x88A\x0b\x12\x83A\x0b\x12\xfe@\x0b\x12\xf9@\x0b\x12\xf4@\x0b\x12\xef@\x0b\x12\xea@\x0b\x12\xe5@\x0b\x12\xe0@\x0b\x12\xdb@\x0b\x12\xd6@\x0b\x12\xd1@\x0b\x12\xcc@\x0b\x12\xc7@\x0b\x12\xc2@\x0b\x12\xbd@\x0b\x12\xb8@\x0b\x12\xb3@\x0b\x12\xae@\x0b\x12\xa9@\x0b\x12\xa4@\x0b\x12\x9f@\x0b\x12\x9a@\x0b\x12\x95@\x0b\x12\x90@\x0b\x12\x8b@\x0b\x12\x86@\x0b\x12\x81@\x0b\x12\xfc?\x0b\x12\xf7?\x0b\x12\xf2?\x0b\x12\xed?\x0b\x12\xe8?\x0b\x12\xe3?\x0b\x12\xde?\x0b\x12\xd9?\x0b\x12\xd4?\x0b\x12\xcf?\x0b\x12\xca?\x0b\x12\xc5?\x0b\x12\xc0?\x0b\x12\xbb?\x0b\x12\xb6?\x0b\x12\xb1?\x0b\x12\xac?\x0b\x12\xa7?\x0b\x12\xa2?\x0b\x12\x9d?\x0b\x12\x98?\x0b\x12\x93?\x0b\x12\x8e?\x0b\x12\x89?\x0b\x12\x84?\x0b\x12\xff>\x0b\x12\xfa>\x0b\x12\xf5>\x0b\x12\xf0>\x0b\x12\xeb>\x0b\x12\xe6>\x0b\x12\xe1>\x0b\x12\xdc>\x0b\x12\xd7>\x0b\x12\xd2>\x0b\x12\xcd>\x0b\x12\xc8>\x0b\x12\xc3>\x0b\x12\xbe>\x0b\x12\xb9>\x0b\x12\xb4>\x0b\x12\xaf>\x0b\x12\xaa>\x0b\x12\xa5>\x0b\x12\xa0>\x0b\x12\x9b>\x0b\x12\x96>\x0b\x12\x91>\x0b\x12\x8c>\x0b\x12\x87>\x0b\x12\x82>\x0b\x12\xfd=\x0b\x12\xf8=\x0b\x12\xf3=\x0b\x12\xee=\x0b\x12\xe9=\x0b\x12\xe4=\x0b\x12\xdf=\x0b\x12\xda=\x0b\x12\xd5=\x0b\x12\xd0=\x0b\x12\xcb=\x0b\x12\xc6=\x0b\x12\xc1=\x0b\x12\xbc=\x0b\x12\xb7=\x0b\x12\xb2=\x0b\x12\xad=\x0b\x12\xa8=\x0b\x12\xa3=\x0b\x12\x9e=\x0b\x12\x99=\x0b\x12\x94=\x0b\x12\x8f=\x0b\x12\x8a=\x0b\x12\x85=\x0b\x12\x80=\x0b\x12\xfb<\x0b\x12\xf6<\x0b\x12\xf1<\x0b\x12\xec<\x0b\x12\xe7<\x0b\x12\xe2<\x0b\x12\xdd<\x0b\x12\xd8<\x0b\x12\xd3<\x0b\x12\xce<\x0b\x12\xc9<\x0b\x12\xc4<\x0b\x12\xbf<\x0b\x12\xba<\x0b\x12\xb5<\x0b\x12\xb0<\x0b\x12\xab<\x0b\x12\xa6<\x0b\x12\xa1<\x0b\x12\x9c<\x0b\x12\x97<\x0b\x12\x92<\x0b\x12\x8d<\x0b\x12\x88<\x0b\x12\x83<\x0b\x12\xfe;\x0b\x12\xf9;\x0b\x12\xf4;\x0b\x12\xef;\x0b\x12\xea;\x0b\x12\xe5;\x0b\x12\xe0;\x0b\x12\xdb;\x0b\x12\xd6;\x0b\x12\xd1;\x0b\x12\xcc;\x0b\x12\xc7;\x0b\x12\xc2;\x0b\x12\xbd;\x0b\x12\xb8;\x0b\x12\xb3;\x0b\x12\xae;\x0b\x12\xa9;\x0b\x12\xa4;\x0b\x12\x9f;\x0b\x12\x9a;\x0b\x12\x95;\x0b\x12\x90;\x0b\x12\x8b;\x0b\x12\x86;\x0b\x12\x81;\x0b\x12\xfc:\x0b\x12\xf7:\x0b\x12\xf2:\x0b\x12\xed:\x0b\x12\xe8:\x0b\x12\xe3:\x0b\x12\xde:\x0b\x12\xd9:\x0b\x12\xd4:\x0b\x12\xcf:\x0b\x12\xca:\x0b\x12\xc5:\x0b\x12\xc0:\x0b\x12\xbb:\x0b\x12\xb6:\x0b\x12\xb1:\x0b\x12\xac:\x0b\x12\xa7:\x0b\x12\xa2:\x0b\x12\x9d:\x0b\x12\x98:\x0b\x12\x93:\x0b\x12\x8e:\x0b\x12\x89:\x0b\x12\x84:\x0b\x12\xff9\x0b\x12\xfa9\x0b\x12\xf59\x0b\x12\xf09\x0b\x12\xeb9\x0b\x12\xe69\x0b\x12\xe19\x0b\x12\xdc9\x0b\x12\xd79\x0b\x12\xd29\x0b\x12\xcd9\x0b\x12\xc89\x0b\x12\xc39\x0b\x12\xbe9\x0b\x12\xb99\x0b\x12\xb49\x0b\x12\xaf9\x0b\x12\xaa9\x0b\x12\xa59\x0b\x12\xa09\x0b\x12\x9b9\x0b\x12\x969\x0b\x12\x919\x0b\x12\x8c9\x0b\x12\x879\x0b\x12\x829\x0b\x12\xfd8\x0b\x12\xf88\x0b\x12\xf38\x0b\x12\xee8\x0b\x12\xe98\x0b\x12\xe48\x0b\x12\xdf8\x0b\x12\xda8\x0b\x12\xd58\x0b\x12\xd08\x0b\x12\xcb8\x0b\x12\xc68\x0b\x12\xc18\x0b\x12\xbc8\x0b\x12\xb78\x0b\x12\xb28\x0b\x12\xad8\x0b\x12\xa88\x0b\x12\xa38\x0b\x12\x9e8\x0b\x12\x998\x0b\x12\x948\x0b\x12\x8f8\x0b\x12\x8a8\x0b\x12\x858\x0b\x12\x808\x0b\x12\xfb7\x0b\x12\xf67\x0b\x12\xf17\x0b\x12\xec7\x0b\x12\xe77\x0b\x12\xe27\x0b\x12\xdd7\x0b\x12\xd87\x0b\x12\xd37\x0b\x12\xce7\x0b\x12\xc97\x0b\x12\xc47\x0b\x12\xbf7\x0b\x12\xba7\x0b\x12\xb57\x0b\x12\xb07\x0b\x12\xab7\x0b\x12\xa67\x0b\x12\xa17\x0b\x12\x9c7\x0b\x12\x977\x0b\x12\x927\x0b\x12\x8d7\x0b\x12\x887\x0b\x12\x837\x0b\x12\xfe6\x0b\x12\xf96\x0b\x12\xf46\x0b\x12\xef6\x0b\x12\xea6\x0b\x12\xe56\x0b\x12\xe06\x0b\x12\xdb6\x0b\x12\xd66\x0b\x12\xd16\x0b\x12\xcc6\x0b\x12\xc76\x0b\x12\xc26\x0b\x12\xbd6\x0b\x12\xb86\x0b\x12\xb36\x0b\x12\xae6\x0b\x12\xa96\x0b\x12\xa46\x0b\x12\x9f6\x0b\x12\x9a6\x0b\x12\x956\x0b\x12\x906\x0b\x12\x8b6\x0b\x12\x866\x0b\x12\x816\x0b\x12\xfc5\x0b\x12\xf75\x0b\x12\xf25\x0b\x12\xed5\x0b\x12\xe85\x0b\x12\xe35\x0b\x12\xde5\x0b\x12\xd95\x0b\x12\xd45\x0b\x12\xcf5\x0b\x12\xca5\x0b\x12\xc55\x0b\x12\xc05\x0b\x12\xbb5\x0b\x12\xb65\x0b\x12\xb15\x0b\x12\xac5\x0b\x12\xa75\x0b\x12\xa25\x0b\x12\x9d5\x0b\x12\x985\x0b\x12\x935\x0b\x12\x8e5\x0b\x12\x895\x0b\x12\x845\x0b\x12\xff4\x0b\x12\xfa4\x0b\x12\xf54\x0b\x12\xf04\x0b\x12\xeb4\x0b\x12\xe64\x0b\x12\xe14\x0b\x12\xdc4\x0b\x12\xd74\x0b\x12\xd24\x0b\x12\xcd4\x0b\x12\xc84\x0b\x12\xc34\x0b\x12\xbe4\x0b\x12\xb94\x0b\x12\xb44\x0b\x12\xaf4\x0b\x12\xaa4\x0b\x12\xa54\x0b\x12\xa04\x0b\x12\x9b4\x0b\x12\x964\x0b\x12\x914\x0b\x12\x8c4\x0b\x12\x874\x0b\x12\x824\x0b\x12\xfd3\x0b\x12\xf83\x0b\x12\xf33\x0b\x12\xee3\x0b\x12\xe93\x0b\x12\xe43\x0b\x12\xdf3\x0b\x12\xda3\x0b\x12\xd53\x0b\x12\xd03\x0b\x12\xcb3\x0b\x12\xc63\x0b\x12\xc13\x0b\x12\xbc3\x0b\x12\xb73\x0b\x12\xb23\x0b\x12\xad3\x0b\x12\xa83\x0b\x12\xa33\x0b\x12\x9e3\x0b\x12\x993\x0b\x12\x943\x0b\x12\x8f3\x0b\x12\x8a3\x0b\x12\x853\x0b\x12\x803\x0b\x12\xfb2\x0b\x12\xf62\x0b\x12\xf12\x0b\x12\xec2\x0b\x12\xe72\x0b\x12\xe22\x0b\x12\xdd2\x0b\x12\xd82\x0b\x12\xd32\x0b\x12\xce2\x0b\x12\xc92\x0b\x12\xc42\x0b\x12\xbf2\x0b\x12\xba2\x0b\x12\xb52\x0b\x12\xb02\x0b\x12\xab2\x0b\x12\xa62\x0b\x12\xa12\x0b\x12\x9c2\x0b\x12\x972\x0b\x12\x922\x0b\x12\x8d2\x0b\x12\x882\x0b\x12\x832\x0b\x12\xfe1\x0b\x12\xf91\x0b\x12\xf41\x0b\x12\xef1\x0b\x12\xea1\x0b\x12\xe51\x0b\x12\xe01\x0b\x12\xdb1\x0b\x12\xd61\x0b\x12\xd11\x0b\x12\xcc1\x0b\x12\xc71\x0b\x12\xc21\x0b\x12\xbd1\x0b\x12\xb81\x0b\x12\xb31\x0b\x12\xae1\x0b\x12\xa91\x0b\x12\xa41\x0b\x12\x9f1\x0b\x12\x9a1\x0b\x12\x951\x0b\x12\x901\x0b\x12\x8b1\x0b\x12\x861\x0b\x12\x811\x0b\x12\xfc0\x0b\x12\xf70\x0b\x12\xf20\x0b\x12\xed0\x0b\x12\xe80\x0b\x12\xe30\x0b\x12\xde0\x0b\x12\xd90\x0b\x12\xd40\x0b\x12\xcf0\x0b\x12\xca0\x0b\x12\xc50\x0b\x12\xc00\x0b\x12\xbb0\x0b\x12\xb60\x0b\x12\xb10\x0b\x12\xac0\x0b\x12\xa70\x0b\x12\xa20\x0b\x12\x9d0\x0b\x12\x980\x0b\x12\x930\x0b\x12\x8e0\x0b\x12\x890\x0b\x12\x840\x0b\x12\xff/\x0b\x12\xfa/\x0b\x12\xf5/\x0b\x12\xf0/\x0b\x12\xeb/\x0b\x12\xe6/\x0b\x12\xe1/\x0b\x12\xdc/\x0b\x12\xd7/\x0b\x12\xd2/\x0b\x12\xcd/\x0b\x12\xc8/\x0b\x12\xc3/\x0b\x12\xbe/\x0b\x12\xb9/\x0b\x12\xb4/\x0b\x12\xaf/\x0b\x12\xaa/\x0b\x12\xa5/\x0b\x12\xa0/\x0b\x12\x9b/\x0b\x12\x96/\x0b\x12\x91/\x0b\x12\x8c/\x0b\x12\x87/\x0b\x12\x82/\x0b\x12\xfd.\x0b\x12\xf8.\x0b\x12\xf3.\x0b\x12\xee.\x0b\x12\xe9.\x0b\x12\xe4.\x0b\x12\xdf.\x0b\x12\xda.\x0b\x12\xd5.\x0b\x12\xd0.\x0b\x12\xcb.\x0b\x12\xc6.\x0b\x12\xc1.\x0b\x12\xbc.\x0b\x12\xb7.\x0b\x12\xb2.\x0b\x12\xad.\x0b\x12\xa8.\x0b\x12\xa3.\x0b\x12\x9e.\x0b\x12\x99.\x0b\x12\x94.\x0b\x12\x8f.\x0b\x12\x8a.\x0b\x12\x85.\x0b\x12\x80.\x0b\x12\xfb-\x0b\x12\xf6-\x0b\x12\xf1-\x0b\x12\xec-\x0b\x12\xe7-\x0b\x12\xe2-\x0b\x12\xdd-\x0b\x12\xd8-\x0b\x12\xd3-\x0b\x12\xce-\x0b\x12\xc9-\x0b\x12\xc4-\x0b\x12\xbf-\x0b\x12\xba-\x0b\x12\xb5-\x0b\x12\xb0-\x0b\x12\xab-\x0b\x12\xa6-\x0b\x12\xa1-\x0b\x12\x9c-\x0b\x12\x97-\x0b\x12\x92-\x0b\x12\x8d-\x0b\x12\x88-\x0b\x12\x83-\x0b\x12\xfe,\x0b\x12\xf9,\x0b\x12\xf4,\x0b\x12\xef,\x0b\x12\xea,\x0b\x12\xe5,\x0b\x12\xe0,\x0b\x12\xdb,\x0b\x12\xd6,\x0b\x12\xd1,\x0b\x12\xcc,\x0b\x12\xc7,\x0b\x12\xc2,\x0b\x12\xbd,\x0b\x12\xb8,\x0b\x12\xb3,\x0b\x12\xae,\x0b\x12\xa9,\x0b\x12\xa4,\x0b\x12\x9f,\x0b\x12\x9a,\x0b\x12\x95,\x0b\x12\x90,\x0b\x12\x8b,\x0b\x12\x86,\x0b\x12\x81,\x0b\x12\xfc+\x0b\x12\xf7+\x0b\x12\xf2+\x0b\x12\xed+\x0b\x12\xe8+\x0b\x12\xe3+\x0b\x12\xde+\x0b\x12\xd9+\x0b\x12\xd4+\x0b\x12\xcf+\x0b\x12\xca+\x0b\x12\xc5+\x0b\x12\xc0+\x0b\x12\xbb+\x0b\x12\xb6+\x0b\x12\xb1+\x0b\x12\xac+\x0b\x12\xa7+\x0b\x12\xa2+\x0b\x12\x9d+\x0b\x12\x98+\x0b\x12\x93+\x0b\x12\x8e+\x0b\x12\x89+\x0b\x12\x84+\x0b\x12\xff*\x0b\x12\xfa*\x0b\x12\xf5*\x0b\x12\xf0*\x0b\x12\xeb*\x0b\x12\xe6*\x0b\x12\xe1*\x0b\x12\xdc*\x0b\x12\xd7*\x0b\x12\xd2*\x0b\x12\xcd*\x0b\x12\xc8*\x0b\x12\xc3*\x0b\x12\xbe*\x0b\x12\xb9*\x0b\x12\xb4*\x0b\x12\xaf*\x0b\x12\xaa*\x0b\x12\xa5*\x0b\x12\xa0*\x0b\x12\x9b*\x0b\x12\x96*\x0b\x12\x91*\x0b\x12\x8c*\x0b\x12\x87*\x0b\x12\x82*\x0b\x12\xfd)\x0b\x12\xf8)\x0b\x12\xf3)\x0b\x12\xee)\x0b\x12\xe9)\x0b\x12\xe4)\x0b\x12\xdf)\x0b\x12\xda)\x0b\x12\xd5)\x0b\x12\xd0)\x0b\x12\xcb)\x0b\x12\xc6)\x0b\x12\xc1)\x0b\x12\xbc)\x0b\x12\xb7)\x0b\x12\xb2)\x0b\x12\xad)\x0b\x12\xa8)\x0b\x12\xa3)\x0b\x12\x9e)\x0b\x12\x99)\x0b\x12\x94)\x0b\x12\x8f)\x0b\x12\x8a)\x0b\x12\x85)\x0b\x12\x80)\x0b\x12\xfb(\x0b\x12\xf6(\x0b\x12\xf1(\x0b\x12\xec(\x0b\x12\xe7(\x0b\x12\xe2(\x0b\x12\xdd(\x0b\x12\xd8(\x0b\x12\xd3(\x0b\x12\xce(\x0b\x12\xc9(\x0b\x12\xc4(\x0b\x12\xbf(\x0b\x12\xba(\x0b\x12\xb5(\x0b\x12\xb0(\x0b\x12\xab(\x0b\x12\xa6(\x0b\x12\xa1(\x0b\x12\x9c(\x0b\x12\x97(\x0b\x12\x92(\x0b\x12\x8d(\x0b\x12\x88(\x0b\x12\x83(\x0b\x12\xfe\'\x0b\x12\xf9\'\x0b\x12\xf4\'\x0b\x12\xef\'\x0b\x12\xea\'\x0b\x12\xe5\'\x0b\x12\xe0\'\x0b\x12\xdb\'\x0b\x12\xd6\'\x0b\x12\xd1\'\x0b\x12\xcc\'\x0b\x12\xc7\'\x0b\x12\xc2\'\x0b\x12\xbd\'\x0b\x12\xb8\'\x0b\x12\xb3\'\x0b\x12\xae\'\x0b\x12\xa9\'\x0b\x12\xa4\'\x0b\x12\x9f\'\x0b\x12\x9a\'\x0b\x12\x95\'\x0b\x12\x90\'\x0b\x12\x8b\'\x0b\x12\x86\'\x0b\x12\x81\'\x0b\x12\xfc&\x0b\x12\xf7&\x0b\x12\xf2&\x0b\x12\xed&\x0b\x12\xe8&\x0b\x12\xe3&\x0b\x12\xde&\x0b\x12\xd9&\x0b\x12\xd4&\x0b\x12\xcf&\x0b\x12\xca&\x0b\x12\xc5&\x0b\x12\xc0&\x0b\x12\xbb&\x0b\x12\xb6&\x0b\x12\xb1&\x0b\x12\xac&\x0b\x12\xa7&\x0b\x12\xa2&\x0b\x12\x9d&\x0b\x12\x98&\x0b\x12\x93&\x0b\x12\x8e&\x0b\x12\x89&\x0b\x12\x84&\x0b\x12\xff%\x0b\x12\xfa%\x0b\x12\xf5%\x0b\x12\xf0%\x0b\x12\xeb%\x0b\x12\xe6%\x0b\x12\xe1%\x0b\x12\xdc%\x0b\x12\xd7%\x0b\x12\xd2%\x0b\x12\xcd%\x0b\x12\xc8%\x0b\x12\xc3%\x0b\x12\xbe%\x0b\x12\xb9%\x0b\x12\xb4%\x0b\x12\xaf%\x0b\x12\xaa%\x0b\x12\xa5%\x0b\x12\xa0%\x0b\x12\x9b%\x0b\x12\x96%\x0b\x12\x91%\x0b\x12\x8c%\x0b\x12\x87%\x0b\x12\x82%\x0b\x12\xfd$\x0b\x12\xf8$\x0b\x12\xf3$\x0b\x12\xee$\x0b\x12\xe9$\x0b\x12\xe4$\x0b\x12\xdf$\x0b\x12\xda$\x0b\x12\xd5$\x0b\x12\xd0$\x0b\x12\xcb$\x0b\x12\xc6$\x0b\x12\xc1$\x0b\x12\xbc$\x0b\x12\xb7$\x0b\x12\xb2$\x0b\x12\xad$\x0b\x12\xa8$\x0b\x12\xa3$\x0b\x12\x9e$\x0b\x12\x99$\x0b\x12\x94$\x0b\x12\x8f$\x0b\x12\x8a$\x0b\x12\x85$\x0b\x12\x80$\x0b\x12\xfb#\x0b\x12\xf6#\x0b\x12\xf1#\x0b\x12\xec#\x0b\x12\xe7#\x0b\x12\xe2#\x0b\x12\xdd#\x0b\x12\xd8#\x0b\x12\xd3#\x0b\x12\xce#\x0b\x12\xc9#\x0b\x12\xc4#\x0b\x12\xbf#\x0b\x12\xba#\x0b\x12\xb5#\x0b\x12\xb0#\x0b\x12\xab#\x0b\x12\xa6#\x0b\x12\xa1#\x0b\x12\x9c#\x0b\x12\x97#\x0b\x12\x92#\x0b\x12\x8d#\x0b\x12\x88#\x0b\x12\x83#\x0b\x12\xfe"\x0b\x12\xf9"\x0b\x12\xf4"\x0b\x12\xef"\x0b\x12\xea"\x0b\x12\xe5"\x0b\x12\xe0"\x0b\x12\xdb"\x0b\x12\xd6"\x0b\x12\xd1"\x0b\x12\xcc"\x0b\x12\xc7"\x0b\x12\xc2"\x0b\x12\xbd"\x0b\x12\xb8"\x0b\x12\xb3"\x0b\x12\xae"\x0b\x12\xa9"\x0b\x12\xa4"\x0b\x12\x9f"\x0b\x12\x9a"\x0b\x12\x95"\x0b\x12\x90"\x0b\x12\x8b"\x0b\x12\x86"\x0b\x12\x81"\x0b\x12\xfc!\x0b\x12\xf7!\x0b\x12\xf2!\x0b\x12\xed!\x0b\x12\xe8!\x0b\x12\xe3!\x0b\x12\xde!\x0b\x12\xd9!\x0b\x12\xd4!\x0b\x12\xcf!\x0b\x12\xca!\x0b\x12\xc5!\x0b\x12\xc0!\x0b\x12\xbb!\x0b\x12\xb6!\x0b\x12\xb1!\x0b\x12\xac!\x0b\x12\xa7!\x0b\x12\xa2!\x0b\x12\x9d!\x0b\x12\x98!\x0b\x12\x93!\x0b\x12\x8e!\x0b\x12\x89!\x0b\x12\x84!\x0b\x12\xff \x0b\x12\xfa \x0b\x12\xf5 \x0b\x12\xf0 \x0b\x12\xeb \x0b\x12\xe6 \x0b\x12\xe1 \x0b\x12\xdc \x0b\x12\xd7 \x0b\x12\xd2 \x0b\x12\xcd \x0b\x12\xc8 \x0b\x12\xc3 \x0b\x12\xbe \x0b\x12\xb9 \x0b\x12\xb4 \x0b\x12\xaf \x0b\x12\xaa \x0b\x12\xa5 \x0b\x12\xa0 \x0b\x12\x9b \x0b\x12\x96 \x0b\x12\x91 \x0b\x12\x8c \x0b\x12\x87 \x0b\x12\x82 \x0b\x12\xfd\x1f\x0b\x12\xf8\x1f\x0b\x12\xf3\x1f\x0b\x12\xee\x1f\x0b\x12\xe9\x1f\x0b\x12\xe4\x1f\x0b\x12\xdf\x1f\x0b\x12\xda\x1f\x0b\x12\xd5\x1f\x0b\x12\xd0\x1f\x0b\x12\xcb\x1f\x0b\x12\xc6\x1f\x0b\x12\xc1\x1f\x0b\x12\xbc\x1f\x0b\x12\xb7\x1f\x0b\x12\xb2\x1f\x0b\x12\xad\x1f\x0b\x12\xa8\x1f\x0b\x12\xa3\x1f\x0b\x12\x9e\x1f\x0b\x12\x99\x1f\x0b\x12\x94\x1f\x0b\x12\x8f\x1f\x0b\x12\x8a\x1f\x0b\x12\x85\x1f\x0b\x12\x80\x1f\x0b\x12\xfb\x1e\x0b\x12\xf6\x1e\x0b\x12\xf1\x1e\x0b\x12\xec\x1e\x0b\x12\xe7\x1e\x0b\x12\xe2\x1e\x0b\x12\xdd\x1e\x0b\x12\xd8\x1e\x0b\x12\xd3\x1e\x0b\x12\xce\x1e\x0b\x12\xc9\x1e\x0b\x12\xc4\x1e\x0b\x12\xbf\x1e\x0b\x12\xba\x1e\x0b\x12\xb5\x1e\x0b\x12\xb0\x1e\x0b\x12\xab\x1e\x0b\x12\xa6\x1e\x0b\x12\xa1\x1e\x0b\x12\x9c\x1e\x0b\x12\x97\x1e\x0b\x12\x92\x1e\x0b\x12\x8d\x1e\x0b\x12\x88\x1e\x0b\x12\x83\x1e\x0b\x12\xfe\x1d\x0b\x12\xf9\x1d\x0b\x12\xf4\x1d\x0b\x12\xef\x1d\x0b\x12\xea\x1d\x0b\x12\xe5\x1d\x0b\x12\xe0\x1d\x0b\x12\xdb\x1d\x0b\x12\xd6\x1d\x0b\x12\xd1\x1d\x0b\x12\xcc\x1d\x0b\x12\xc7\x1d\x0b\x12\xc2\x1d\x0b\x12\xbd\x1d\x0b\x12\xb8\x1d\x0b\x12\xb3\x1d\x0b\x12\xae\x1d\x0b\x12\xa9\x1d\x0b\x12\xa4\x1d\x0b\x12\x9f\x1d\x0b\x12\x9a\x1d\x0b\x12\x95\x1d\x0b\x12\x90\x1d\x0b\x12\x8b\x1d\x0b\x12\x86\x1d\x0b\x12\x81\x1d\x0b\x12\xfc\x1c\x0b\x12\xf7\x1c\x0b\x12\xf2\x1c\x0b\x12\xed\x1c\x0b\x12\xe8\x1c\x0b\x12\xe3\x1c\x0b\x12\xde\x1c\x0b\x12\xd9\x1c\x0b\x12\xd4\x1c\x0b\x12\xcf\x1c\x0b\x12\xca\x1c\x0b\x12\xc5\x1c\x0b\x12\xc0\x1c\x0b\x12\xbb\x1c\x0b\x12\xb6\x1c\x0b\x12\xb1\x1c\x0b\x12\xac\x1c\x0b\x12\xa7\x1c\x0b\x12\xa2\x1c\x0b\x12\x9d\x1c\x0b\x12\x98\x1c\x0b\x12\x93\x1c\x0b\x12\x8e\x1c\x0b\x12\x89\x1c\x0b\x12\x84\x1c\x0b\x12\xff\x1b\x0b\x12\xfa\x1b\x0b\x12\xf5\x1b\x0b\x12\xf0\x1b\x0b\x12\xeb\x1b\x0b\x12\xe6\x1b\x0b\x12\xe1\x1b\x0b\x12\xdc\x1b\x0b\x12\xd7\x1b\x0b\x12\xd2\x1b\x0b\x12\xcd\x1b\x0b\x12\xc8\x1b\x0b\x12\xc3\x1b\x0b\x12\xbe\x1b\x0b\x12\xb9\x1b\x0b\x12\xb4\x1b\x0b\x12\xaf\x1b\x0b\x12\xaa\x1b\x0b\x12\xa5\x1b\x0b\x12\xa0\x1b\x0b\x12\x9b\x1b\x0b\x12\x96\x1b\x0b\x12\x91\x1b\x0b\x12\x8c\x1b\x0b\x12\x87\x1b\x0b\x12\x82\x1b\x0b\x12\xfd\x1a\x0b\x12\xf8\x1a\x0b\x12\xf3\x1a\x0b\x12\xee\x1a\x0b\x12\xe9\x1a\x0b\x12\xe4\x1a\x0b\x12\xdf\x1a\x0b\x12\xda\x1a\x0b\x12\xd5\x1a\x0b\x12\xd0\x1a\x0b\x12\xcb\x1a\x0b\x12\xc6\x1a\x0b\x12\xc1\x1a\x0b\x12\xbc\x1a\x0b\x12\xb7\x1a\x0b\x12\xb2\x1a\x0b\x12\xad\x1a\x0b\x12\xa8\x1a\x0b\x12\xa3\x1a\x0b\x12\x9e\x1a\x0b\x12\x99\x1a\x0b\x12\x94\x1a\x0b\x12\x8f\x1a\x0b\x12\x8a\x1a\x0b\x12\x85\x1a\x0b\x12\x80\x1a\x0b\x12\xfb\x19\x0b\x12\xf6\x19\x0b\x12\xf1\x19\x0b\x12\xec\x19\x0b\x12\xe7\x19\x0b\x12\xe2\x19\x0b\x12\xdd\x19\x0b\x12\xd8\x19\x0b\x12\xd3\x19\x0b\x12\xce\x19\x0b\x12\xc9\x19\x0b\x12\xc4\x19\x0b\x12\xbf\x19\x0b\x12\xba\x19\x0b\x12\xb5\x19\x0b\x12\xb0\x19\x0b\x12\xab\x19\x0b\x12\xa6\x19\x0b\x12\xa1\x19\x0b\x12\x9c\x19\x0b\x12\x97\x19\x0b\x12\x92\x19\x0b\x12\x8d\x19\x0b\x12\x88\x19\x0b\x12\x83\x19\x0b\x12\xfe\x18\x0b\x12\xf9\x18\x0b\x12\xf4\x18\x0b\x12\xef\x18\x0b\x12\xea\x18\x0b\x12\xe5\x18\x0b\x12\xe0\x18\x0b\x12\xdb\x18\x0b\x12\xd6\x18\x0b\x12\xd1\x18\x0b\x12\xcc\x18\x0b\x12\xc7\x18\x0b\x12\xc2\x18\x0b\x12\xbd\x18\x0b\x12\xb8\x18\x0b\x12\xb3\x18\x0b\x12\xae\x18\x0b\x12\xa9\x18\x0b\x12\xa4\x18\x0b\x12\x9f\x18\x0b\x12\x9a\x18\x0b\x12\x95\x18\x0b\x12\x90\x18\x0b\x12\x8b\x18\x0b\x12\x86\x18\x0b\x12\x81\x18\x0b\x12\xfc\x17\x0b\x12\xf7\x17\x0b\x12\xf2\x17\x0b\x12\xed\x17\x0b\x12\xe8\x17\x0b\x12\xe3\x17\x0b\x12\xde\x17\x0b\x12\xd9\x17\x0b\x12\xd4\x17\x0b\x12\xcf\x17\x0b\x12\xca\x17\x0b\x12\xc5\x17\x0b\x12\xc0\x17\x0b\x12\xbb\x17\x0b\x12\xb6\x17\x0b\x12\xb1\x17\x0b\x12\xac\x17\x0b\x12\xa7\x17\x0b\x12\xa2\x17\x0b\x12\x9d\x17\x0b\x12\x98\x17\x0b\x12\x93\x17\x0b\x12\x8e\x17\x0b\x12\x89\x17\x0b\x12\x84\x17\x0b\x12\xff\x16\x0b\x12\xfa\x16\x0b\x12\xf5\x16\x0b\x12\xf0\x16\x0b\x12\xeb\x16\x0b\x12\xe6\x16\x0b\x12\xe1\x16\x0b\x12\xdc\x16\x0b\x12\xd7\x16\x0b\x12\xd2\x16\x0b\x12\xcd\x16\x0b\x12\xc8\x16\x0b\x12\xc3\x16\x0b\x12\xbe\x16\x0b\x12\xb9\x16\x0b\x12\xb4\x16\x0b\x12\xaf\x16\x0b\x12\xaa\x16\x0b\x12\xa5\x16\x0b\x12\xa0\x16\x0b\x12\x9b\x16\x0b\x12\x96\x16\x0b\x12\x91\x16\x0b\x12\x8c\x16\x0b\x12\x87\x16\x0b\x12\x82\x16\x0b\x12\xfd\x15\x0b\x12\xf8\x15\x0b\x12\xf3\x15\x0b\x12\xee\x15\x0b\x12\xe9\x15\x0b\x12\xe4\x15\x0b\x12\xdf\x15\x0b\x12\xda\x15\x0b\x12\xd5\x15\x0b\x12\xd0\x15\x0b\x12\xcb\x15\x0b\x12\xc6\x15\x0b\x12\xc1\x15\x0b\x12\xbc\x15\x0b\x12\xb7\x15\x0b\x12\xb2\x15\x0b\x12\xad\x15\x0b\x12\xa8\x15\x0b\x12\xa3\x15\x0b\x12\x9e\x15\x0b\x12\x99\x15\x0b\x12\x94\x15\x0b\x12\x8f\x15\x0b\x12\x8a\x15\x0b\x12\x85\x15\x0b\x12\x80\x15\x0b\x12\xfb\x14\x0b\x12\xf6\x14\x0b\x12\xf1\x14\x0b\x12\xec\x14\x0b\x12\xe7\x14\x0b\x12\xe2\x14\x0b\x12\xdd\x14\x0b\x12\xd8\x14\x0b\x12\xd3\x14\x0b\x12\xce\x14\x0b\x12\xc9\x14\x0b\x12\xc4\x14\x0b\x12\xbf\x14\x0b\x12\xba\x14\x0b\x12\xb5\x14\x0b\x12\xb0\x14\x0b\x12\xab\x14\x0b\x12\xa6\x14\x0b\x12\xa1\x14\x0b\x12\x9c\x14\x0b\x12\x97\x14\x0b\x12\x92\x14\x0b\x12\x8d\x14\x0b\x12\x88\x14\x0b\x12\x83\x14\x0b\x12\xfe\x13\x0b\x12\xf9\x13\x0b\x12\xf4\x13\x0b\x12\xef\x13\x0b\x12\xea\x13\x0b\x12\xe5\x13\x0b\x12\xe0\x13\x0b\x12\xdb\x13\x0b\x12\xd6\x13\x0b\x12\xd1\x13\x0b\x12\xcc\x13\x0b\x12\xc7\x13\x0b\x12\xc2\x13\x0b\x12\xbd\x13\x0b\x12\xb8\x13\x0b\x12\xb3\x13\x0b\x12\xae\x13\x0b\x12\xa9\x13\x0b\x12\xa4\x13\x0b\x12\x9f\x13\x0b\x12\x9a\x13\x0b\x12\x95\x13\x0b\x12\x90\x13\x0b\x12\x8b\x13\x0b\x12\x86\x13\x0b\x12\x81\x13\x0b\x12\xfc\x12\x0b\x12\xf7\x12\x0b\x12\xf2\x12\x0b\x12\xed\x12\x0b\x12\xe8\x12\x0b\x12\xe3\x12\x0b\x12\xde\x12\x0b\x12\xd9\x12\x0b\x12\xd4\x12\x0b\x12\xcf\x12\x0b\x12\xca\x12\x0b\x12\xc5\x12\x0b\x12\xc0\x12\x0b\x12\xbb\x12\x0b\x12\xb6\x12\x0b\x12\xb1\x12\x0b\x12\xac\x12\x0b\x12\xa7\x12\x0b\x12\xa2\x12\x0b\x12\x9d\x12\x0b\x12\x98\x12\x0b\x12\x93\x12\x0b\x12\x8e\x12\x0b\x12\x89\x12\x0b\x12\x84\x12\x0b\x12\xff\x11\x0b\x12\xfa\x11\x0b\x12\xf5\x11\x0b\x12\xf0\x11\x0b\x12\xeb\x11\x0b\x12\xe6\x11\x0b\x12\xe1\x11\x0b\x12\xdc\x11\x0b\x12\xd7\x11\x0b\x12\xd2\x11\x0b\x12\xcd\x11\x0b\x12\xc8\x11\x0b\x12\xc3\x11\x0b\x12\xbe\x11\x0b\x12\xb9\x11\x0b\x12\xb4\x11\x0b\x12\xaf\x11\x0b\x12\xaa\x11\x0b\x12\xa5\x11\x0b\x12\xa0\x11\x0b\x12\x9b\x11\x0b\x12\x96\x11\x0b\x12\x91\x11\x0b\x12\x8c\x11\x0b\x12\x87\x11\x0b\x12\x82\x11\x0b\x12\xfd\x10\x0b\x12\xf8\x10\x0b\x12\xf3\x10\x0b\x12\xee\x10\x0b\x12\xe9\x10\x0b\x12\xe4\x10\x0b\x12\xdf\x10\x0b\x12\xda\x10\x0b\x12\xd5\x10\x0b\x12\xd0\x10\x0b\x12\xcb\x10\x0b\x12\xc6\x10\x0b\x12\xc1\x10\x0b\x12\xbc\x10\x0b\x12\xb7\x10\x0b\x12\xb2\x10\x0b\x12\xad\x10\x0b\x12\xa8\x10\x0b\x12\xa3\x10\x0b\x12\x9e\x10\x0b\x12\x99\x10\x0b\x12\x94\x10\x0b\x12\x8f\x10\x0b\x12\x8a\x10\x0b\x12\x85\x10\x0b\x12\x80\x10\x0b\x12\xfb\x0f\x0b\x12\xf6\x0f\x0b\x12\xf1\x0f\x0b\x12\xec\x0f\x0b\x12\xe7\x0f\x0b\x12\xe2\x0f\x0b\x12\xdd\x0f\x0b\x12\xd8\x0f\x0b\x12\xd3\x0f\x0b\x12\xce\x0f\x0b\x12\xc9\x0f\x0b\x12\xc4\x0f\x0b\x12\xbf\x0f\x0b\x12\xba\x0f\x0b\x12\xb5\x0f\x0b\x12\xb0\x0f\x0b\x12\xab\x0f\x0b\x12\xa6\x0f\x0b\x12\xa1\x0f\x0b\x12\x9c\x0f\x0b\x12\x97\x0f\x0b\x12\x92\x0f\x0b\x12\x8d\x0f\x0b\x12\x88\x0f\x0b\x12\x83\x0f\x0b\x12\xfe\x0e\x0b\x12\xf9\x0e\x0b\x12\xf4\x0e\x0b\x12\xef\x0e\x0b\x12\xea\x0e\x0b\x12\xe5\x0e\x0b\x12\xe0\x0e\x0b\x12\xdb\x0e\x0b\x12\xd6\x0e\x0b\x12\xd1\x0e\x0b\x12\xcc\x0e\x0b\x12\xc7\x0e\x0b\x12\xc2\x0e\x0b\x12\xbd\x0e\x0b\x12\xb8\x0e\x0b\x12\xb3\x0e\x0b\x12\xae\x0e\x0b\x12\xa9\x0e\x0b\x12\xa4\x0e\x0b\x12\x9f\x0e\x0b\x12\x9a\x0e\x0b\x12\x95\x0e\x0b\x12\x90\x0e\x0b\x12\x8b\x0e\x0b\x12\x86\x0e\x0b\x12\x81\x0e\x0b\x12\xfc\r\x0b\x12\xf7\r\x0b\x12\xf2\r\x0b\x12\xed\r\x0b\x12\xe8\r\x0b\x12\xe3\r\x0b\x12\xde\r\x0b\x12\xd9\r\x0b\x12\xd4\r\x0b\x12\xcf\r\x0b\x12\xca\r\x0b\x12\xc5\r\x0b\x12\xc0\r\x0b\x12\xbb\r\x0b\x12\xb6\r\x0b\x12\xb1\r\x0b\x12\xac\r\x0b\x12\xa7\r\x0b\x12\xa2\r\x0b\x12\x9d\r\x0b\x12\x98\r\x0b\x12\x93\r\x0b\x12\x8e\r\x0b\x12\x89\r\x0b\x12\x84\r\x0b\x12\xff\x0c\x0b\x12\xfa\x0c\x0b\x12\xf5\x0c\x0b\x12\xf0\x0c\x0b\x12\xeb\x0c\x0b\x12\xe6\x0c\x0b\x12\xe1\x0c\x0b\x12\xdc\x0c\x0b\x12\xd7\x0c\x0b\x12\xd2\x0c\x0b\x12\xcd\x0c\x0b\x12\xc8\x0c\x0b\x12\xc3\x0c\x0b\x12\xbe\x0c\x0b\x12\xb9\x0c\x0b\x12\xb4\x0c\x0b\x12\xaf\x0c\x0b\x12\xaa\x0c\x0b\x12\xa5\x0c\x0b\x12\xa0\x0c\x0b\x12\x9b\x0c\x0b\x12\x96\x0c\x0b\x12\x91\x0c\x0b\x12\x8c\x0c\x0b\x12\x87\x0c\x0b\x12\x82\x0c\x0b\x12\xfd\x0b\x0b\x12\xf8\x0b\x0b\x12\xf3\x0b\x0b\x12\xee\x0b\x0b\x12\xe9\x0b\x0b\x12\xe4\x0b\x0b\x12\xdf\x0b\x0b\x12\xda\x0b\x0b\x12\xd5\x0b\x0b\x12\xd0\x0b\x0b\x12\xcb\x0b\x0b\x12\xc6\x0b\x0b\x12\xc1\x0b\x0b\x12\xbc\x0b\x0b\x12\xb7\x0b\x0b\x12\xb2\x0b\x0b\x12\xad\x0b\x0b\x12\xa8\x0b\x0b\x12\xa3\x0b\x0b\x12\x9e\x0b\x0b\x12\x99\x0b\x0b\x12\x94\x0b\x0b\x12\x8f\x0b\x0b\x12\x8a\x0b\x0b\x12\x85\x0b\x0b\x12\x80\x0b\x0b\x12\xfb\n\x0b\x12\xf6\n\x0b\x12\xf1\n\x0b\x12\xec\n\x0b\x12\xe7\n\x0b\x12\xe2\n\x0b\x12\xdd\n\x0b\x12\xd8\n\x0b\x12\xd3\n\x0b\x12\xce\n\x0b\x12\xc9\n\x0b\x12\xc4\n\x0b\x12\xbf\n\x0b\x12\xba\n\x0b\x12\xb5\n\x0b\x12\xb0\n\x0b\x12\xab\n\x0b\x12\xa6\n\x0b\x12\xa1\n\x0b\x12\x9c\n\x0b\x12\x97\n\x0b\x12\x92\n\x0b\x12\x8d\n\x0b\x12\x88\n\x0b\x12\x83\n\x0b\x12\xfe\t\x0b\x12\xf9\t\x0b\x12\xf4\t\x0b\x12\xef\t\x0b\x12\xea\t\x0b\x12\xe5\t\x0b\x12\xe0\t\x0b\x12\xdb\t\x0b\x12\xd6\t\x0b\x12\xd1\t\x0b\x12\xcc\t\x0b\x12\xc7\t\x0b\x12\xc2\t\x0b\x12\xbd\t\x0b\x12\xb8\t\x0b\x12\xb3\t\x0b\x12\xae\t\x0b\x12\xa9\t\x0b\x12\xa4\t\x0b\x12\x9f\t\x0b\x12\x9a\t\x0b\x12\x95\t\x0b\x12\x90\t\x0b\x12\x8b\t\x0b\x12\x86\t\x0b\x12\x81\t\x0b\x12\xfc\x08\x0b\x12\xf7\x08\x0b\x12\xf2\x08\x0b\x12\xed\x08\x0b\x12\xe8\x08\x0b\x12\xe3\x08\x0b\x12\xde\x08\x0b\x12\xd9\x08\x0b\x12\xd4\x08\x0b\x12\xcf\x08\x0b\x12\xca\x08\x0b\x12\xc5\x08\x0b\x12\xc0\x08\x0b\x12\xbb\x08\x0b\x12\xb6\x08\x0b\x12\xb1\x08\x0b\x12\xac\x08\x0b\x12\xa7\x08\x0b\x12\xa2\x08\x0b\x12\x9d\x08\x0b\x12\x98\x08\x0b\x12\x93\x08\x0b\x12\x8e\x08\x0b\x12\x89\x08\x0b\x12\x84\x08\x0b\x12\xff\x07\x0b\x12\xfa\x07\x0b\x12\xf5\x07\x0b\x12\xf0\x07\x0b\x12\xeb\x07\x0b\x12\xe6\x07\x0b\x12\xe1\x07\x0b\x12\xdc\x07\x0b\x12\xd7\x07\x0b\x12\xd2\x07\x0b\x12\xcd\x07\x0b\x12\xc8\x07\x0b\x12\xc3\x07\x0b\x12\xbe\x07\x0b\x12\xb9\x07\x0b\x12\xb4\x07\x0b\x12\xaf\x07\x0b\x12\xaa\x07\x0b\x12\xa5\x07\x0b\x12\xa0\x07\x0b\x12\x9b\x07\x0b\x12\x96\x07\x0b\x12\x91\x07\x0b\x12\x8c\x07\x0b\x12\x87\x07\x0b\x12\x82\x07\x0b\x12\xfd\x06\x0b\x12\xf8\x06\x0b\x12\xf3\x06\x0b\x12\xee\x06\x0b\x12\xe9\x06\x0b\x12\xe4\x06\x0b\x12\xdf\x06\x0b\x12\xda\x06\x0b\x12\xd5\x06\x0b\x12\xd0\x06\x0b\x12\xcb\x06\x0b\x12\xc6\x06\x0b\x12\xc1\x06\x0b\x12\xbc\x06\x0b\x12\xb7\x06\x0b\x12\xb2\x06\x0b\x12\xad\x06\x0b\x12\xa8\x06\x0b\x12\xa3\x06\x0b\x12\x9e\x06\x0b\x12\x99\x06\x0b\x12\x94\x06\x0b\x12\x8f\x06\x0b\x12\x8a\x06\x0b\x12\x85\x06\x0b\x12\x80\x06\x0b\x12\xfb\x05\x0b\x12\xf6\x05\x0b\x12\xf1\x05\x0b\x12\xec\x05\x0b\x12\xe7\x05\x0b\x12\xe2\x05\x0b\x12\xdd\x05\x0b\x12\xd8\x05\x0b\x12\xd3\x05\x0b\x12\xce\x05\x0b\x12\xc9\x05\x0b\x12\xc4\x05\x0b\x12\xbf\x05\x0b\x12\xba\x05\x0b\x12\xb5\x05\x0b\x12\xb0\x05\x0b\x12\xab\x05\x0b\x12\xa6\x05\x0b\x12\xa1\x05\x0b\x12\x9c\x05\x0b\x12\x97\x05\x0b\x12\x92\x05\x0b\x12\x8d\x05\x0b\x12\x88\x05\x0b\x12\x83\x05\x0b\x12\xfe\x04\x0b\x12\xf9\x04\x0b\x12\xf4\x04\x0b\x12\xef\x04\x0b\x12\xea\x04\x0b\x12\xe5\x04\x0b\x12\xe0\x04\x0b\x12\xdb\x04\x0b\x12\xd6\x04\x0b\x12\xd1\x04\x0b\x12\xcc\x04\x0b\x12\xc7\x04\x0b\x12\xc2\x04\x0b\x12\xbd\x04\x0b\x12\xb8\x04\x0b\x12\xb3\x04\x0b\x12\xae\x04\x0b\x12\xa9\x04\x0b\x12\xa4\x04\x0b\x12\x9f\x04\x0b\x12\x9a\x04\x0b\x12\x95\x04\x0b\x12\x90\x04\x0b\x12\x8b\x04\x0b\x12\x86\x04\x0b\x12\x81\x04\x0b\x12\xfc\x03\x0b\x12\xf7\x03\x0b\x12\xf2\x03\x0b\x12\xed\x03\x0b\x12\xe8\x03\x0b\x12\xe3\x03\x0b\x12\xde\x03\x0b\x12\xd9\x03\x0b\x12\xd4\x03\x0b\x12\xcf\x03\x0b\x12\xca\x03\x0b\x12\xc5\x03\x0b\x12\xc0\x03\x0b\x12\xbb\x03\x0b\x12\xb6\x03\x0b\x12\xb1\x03\x0b\x12\xac\x03\x0b\x12\xa7\x03\x0b\x12\xa2\x03\x0b\x12\x9d\x03\x0b\x12\x98\x03\x0b\x12\x93\x03\x0b\x12\x8e\x03\x0b\x12\x89\x03\x0b\x12\x84\x03\x0b\x12\xff\x02\x0b\x12\xfa\x02\x0b\x12\xf5\x02\x0b\x12\xf0\x02\x0b\x12\xeb\x02\x0b\x12\xe6\x02\x0b\x12\xe1\x02\x0b\x12\xdc\x02\x0b\x12\xd7\x02\x0b\x12\xd2\x02\x0b\x12\xcd\x02\x0b\x12\xc8\x02\x0b\x12\xc3\x02\x0b\x12\xbe\x02\x0b\x12\xb9\x02\x0b\x12\xb4\x02\x0b\x12\xaf\x02\x0b\x12\xaa\x02\x0b\x12\xa5\x02\x0b\x12\xa0\x02\x0b\x12\x9b\x02\x0b\x12\x96\x02\x0b\x12\x91\x02\x0b\x12\x8c\x02\x0b\x12\x87\x02\x0b\x12\x82\x02\x0b\x12\xfd\x01\x0b\x12\xf8\x01\x0b\x12\xf3\x01\x0b\x12\xee\x01\x0b\x12\xe9\x01\x0b\x12\xe4\x01\x0b\x12\xdf\x01\x0b\x12\xda\x01\x0b\x12\xd5\x01\x0b\x12\xd0\x01\x0b\x12\xcb\x01\x0b\x12\xc6\x01\x0b\x12\xc1\x01\x0b\x12\xbc\x01\x0b\x12\xb7\x01\x0b\x12\xb2\x01\x0b\x12\xad\x01\x0b\x12\xa8\x01\x0b\x12\xa3\x01\x0b\x12\x9e\x01\x0b\x12\x99\x01\x0b\x12\x94\x01\x0b\x12\x8f\x01\x0b\x12\x8a\x01\x0b\x12\x85\x01\x0b\x12\x80\x01\x0b\x12|\x0b\x12x\x0b\x12t\x0b\x12p\x0b\x12l\x0b\x12h\x0b\x12d\x0b\x12`\x0b\x12\\\x0b\x12X\x0b\x12T\x0b\x12P\x0b\x12L\x0b\x12H\x0b\x12D\x0b\x12@\x0b\x12<\x0b\x128\x0b\x124\x0b\x120\x0b\x12,\x0b\x12(\x0b\x12$\x0b\x12 \x0b\x12\x1c\x0b\x12\x18\x0b\x12\x14\x0b\x12\x10\x0b\x12\x0c\x0b\x12\x08\x0b\x12\x04\x0b\x12\x00\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c', 'example2.RecursiveGroup', typedefs_43);
+  SET failed = FALSE;
+EXCEPTION WHEN ERROR THEN
+  SET failed = TRUE;
+END;
+ASSERT failed AS 'group: recursion at the limit';
+
+-- want error: Maximum call stack size exceeded
+BEGIN
+  SET result = parseProtobuf(b'\x0b\x12\xb6\xd0\x01\x0b\x12\xb0\xd0\x01\x0b\x12\xaa\xd0\x01\x0b\x12\xa4\xd0\x01\x0b\x12\x9e\xd0\x01\x0b\x12\x98\xd0\x01\x0b\x12\x92\xd0\x01\x0b\x12\x8c\xd0\x01\x0b\x12\x86\xd0\x01\x0b\x12\x80\xd0\x01\x0b\x12\xfa\xcf\x01\x0b\x12\xf4\xcf\x01\x0b\x12\xee\xcf\x01\x0b\x12\xe8\xcf\x01\x0b\x12\xe2\xcf\x01\x0b\x12\xdc\xcf\x01\x0b\x12\xd6\xcf\x01\x0b\x12\xd0\xcf\x01\x0b\x12\xca\xcf\x01\x0b\x12\xc4\xcf\x01\x0b\x12\xbe\xcf\x01\x0b\x12\xb8\xcf\x01\x0b\x12\xb2\xcf\x01\x0b\x12\xac\xcf\x01\x0b\x12\xa6\xcf\x01\x0b\x12\xa0\xcf\x01\x0b\x12\x9a\xcf\x01\x0b\x12\x94\xcf\x01\x0b\x12\x8e\xcf\x01\x0b\x12\x88\xcf\x01\x0b\x12\x82\xcf\x01\x0b\x12\xfc\xce\x01\x0b\x12\xf6\xce\x01\x0b\x12\xf0\xce\x01\x0b\x12\xea\xce\x01\x0b\x12\xe4\xce\x01\x0b\x12\xde\xce\x01\x0b\x12\xd8\xce\x01\x0b\x12\xd2\xce\x01\x0b\x12\xcc\xce\x01\x0b\x12\xc6\xce\x01\x0b\x12\xc0\xce\x01\x0b\x12\xba\xce\x01\x0b\x12\xb4\xce\x01\x0b\x12\xae\xce\x01\x0b\x12\xa8\xce\x01\x0b\x12\xa2\xce\x01\x0b\x12\x9c\xce\x01\x0b\x12\x96\xce\x01\x0b\x12\x90\xce\x01\x0b\x12\x8a\xce\x01\x0b\x12\x84\xce\x01\x0b\x12\xfe\xcd\x01\x0b\x12\xf8\xcd\x01\x0b\x12\xf2\xcd\x01\x0b\x12\xec\xcd\x01\x0b\x12\xe6\xcd\x01\x0b\x12\xe0\xcd\x01\x0b\x12\xda\xcd\x01\x0b\x12\xd4\xcd\x01\x0b\x12\xce\xcd\x01\x0b\x12\xc8\xcd\x01\x0b\x12\xc2\xcd\x01\x0b\x12\xbc\xcd\x01\x0b\x12\xb6\xcd\x01\x0b\x12\xb0\xcd\x01\x0b\x12\xaa\xcd\x01\x0b\x12\xa4\xcd\x01\x0b\x12\x9e\xcd\x01\x0b\x12\x98\xcd\x01\x0b\x12\x92\xcd\x01\x0b\x12\x8c\xcd\x01\x0b\x12\x86\xcd\x01\x0b\x12\x80\xcd\x01\x0b\x12\xfa\xcc\x01\x0b\x12\xf4\xcc\x01\x0b\x12\xee\xcc\x01\x0b\x12\xe8\xcc\x01\x0b\x12\xe2\xcc\x01\x0b\x12\xdc\xcc\x01\x0b\x12\xd6\xcc\x01\x0b\x12\xd0\xcc\x01\x0b\x12\xca\xcc\x01\x0b\x12\xc4\xcc\x01\x0b\x12\xbe\xcc\x01\x0b\x12\xb8\xcc\x01\x0b\x12\xb2\xcc\x01\x0b\x12\xac\xcc\x01\x0b\x12\xa6\xcc\x01\x0b\x12\xa0\xcc\x01\x0b\x12\x9a\xcc\x01\x0b\x12\x94\xcc\x01\x0b\x12\x8e\xcc\x01\x0b\x12\x88\xcc\x01\x0b\x12\x82\xcc\x01\x0b\x12\xfc\xcb\x01\x0b\x12\xf6\xcb\x01\x0b\x12\xf0\xcb\x01\x0b\x12\xea\xcb\x01\x0b\x12\xe4\xcb\x01\x0b\x12\xde\xcb\x01\x0b\x12\xd8\xcb\x01\x0b\x12\xd2\xcb\x01\x0b\x12\xcc\xcb\x01\x0b\x12\xc6\xcb\x01\x0b\x12\xc0\xcb\x01\x0b\x12\xba\xcb\x01\x0b\x12\xb4\xcb\x01\x0b\x12\xae\xcb\x01\x0b\x12\xa8\xcb\x01\x0b\x12\xa2\xcb\x01\x0b\x12\x9c\xcb\x01\x0b\x12\x96\xcb\x01\x0b\x12\x90\xcb\x01\x0b\x12\x8a\xcb\x01\x0b\x12\x84\xcb\x01\x0b\x12\xfe\xca\x01\x0b\x12\xf8\xca\x01\x0b\x12\xf2\xca\x01\x0b\x12\xec\xca\x01\x0b\x12\xe6\xca\x01\x0b\x12\xe0\xca\x01\x0b\x12\xda\xca\x01\x0b\x12\xd4\xca\x01\x0b\x12\xce\xca\x01\x0b\x12\xc8\xca\x01\x0b\x12\xc2\xca\x01\x0b\x12\xbc\xca\x01\x0b\x12\xb6\xca\x01\x0b\x12\xb0\xca\x01\x0b\x12\xaa\xca\x01\x0b\x12\xa4\xca\x01\x0b\x12\x9e\xca\x01\x0b\x12\x98\xca\x01\x0b\x12\x92\xca\x01\x0b\x12\x8c\xca\x01\x0b\x12\x86\xca\x01\x0b\x12\x80\xca\x01\x0b\x12\xfa\xc9\x01\x0b\x12\xf4\xc9\x01\x0b\x12\xee\xc9\x01\x0b\x12\xe8\xc9\x01\x0b\x12\xe2\xc9\x01\x0b\x12\xdc\xc9\x01\x0b\x12\xd6\xc9\x01\x0b\x12\xd0\xc9\x01\x0b\x12\xca\xc9\x01\x0b\x12\xc4\xc9\x01\x0b\x12\xbe\xc9\x01\x0b\x12\xb8\xc9\x01\x0b\x12\xb2\xc9\x01\x0b\x12\xac\xc9\x01\x0b\x12\xa6\xc9\x01\x0b\x12\xa0\xc9\x01\x0b\x12\x9a\xc9\x01\x0b\x12\x94\xc9\x01\x0b\x12\x8e\xc9\x01\x0b\x12\x88\xc9\x01\x0b\x12\x82\xc9\x01\x0b\x12\xfc\xc8\x01\x0b\x12\xf6\xc8\x01\x0b\x12\xf0\xc8\x01\x0b\x12\xea\xc8\x01\x0b\x12\xe4\xc8\x01\x0b\x12\xde\xc8\x01\x0b\x12\xd8\xc8\x01\x0b\x12\xd2\xc8\x01\x0b\x12\xcc\xc8\x01\x0b\x12\xc6\xc8\x01\x0b\x12\xc0\xc8\x01\x0b\x12\xba\xc8\x01\x0b\x12\xb4\xc8\x01\x0b\x12\xae\xc8\x01\x0b\x12\xa8\xc8\x01\x0b\x12\xa2\xc8\x01\x0b\x12\x9c\xc8\x01\x0b\x12\x96\xc8\x01\x0b\x12\x90\xc8\x01\x0b\x12\x8a\xc8\x01\x0b\x12\x84\xc8\x01\x0b\x12\xfe\xc7\x01\x0b\x12\xf8\xc7\x01\x0b\x12\xf2\xc7\x01\x0b\x12\xec\xc7\x01\x0b\x12\xe6\xc7\x01\x0b\x12\xe0\xc7\x01\x0b\x12\xda\xc7\x01\x0b\x12\xd4\xc7\x01\x0b\x12\xce\xc7\x01\x0b\x12\xc8\xc7\x01\x0b\x12\xc2\xc7\x01\x0b\x12\xbc\xc7\x01\x0b\x12\xb6\xc7\x01\x0b\x12\xb0\xc7\x01\x0b\x12\xaa\xc7\x01\x0b\x12\xa4\xc7\x01\x0b\x12\x9e\xc7\x01\x0b\x12\x98\xc7\x01\x0b\x12\x92\xc7\x01\x0b\x12\x8c\xc7\x01\x0b\x12\x86\xc7\x01\x0b\x12\x80\xc7\x01\x0b\x12\xfa\xc6\x01\x0b\x12\xf4\xc6\x01\x0b\x12\xee\xc6\x01\x0b\x12\xe8\xc6\x01\x0b\x12\xe2\xc6\x01\x0b\x12\xdc\xc6\x01\x0b\x12\xd6\xc6\x01\x0b\x12\xd0\xc6\x01\x0b\x12\xca\xc6\x01\x0b\x12\xc4\xc6\x01\x0b\x12\xbe\xc6\x01\x0b\x12\xb8\xc6\x01\x0b\x12\xb2\xc6\x01\x0b\x12\xac\xc6\x01\x0b\x12\xa6\xc6\x01\x0b\x12\xa0\xc6\x01\x0b\x12\x9a\xc6\x01\x0b\x12\x94\xc6\x01\x0b\x12\x8e\xc6\x01\x0b\x12\x88\xc6\x01\x0b\x12\x82\xc6\x01\x0b\x12\xfc\xc5\x01\x0b\x12\xf6\xc5\x01\x0b\x12\xf0\xc5\x01\x0b\x12\xea\xc5\x01\x0b\x12\xe4\xc5\x01\x0b\x12\xde\xc5\x01\x0b\x12\xd8\xc5\x01\x0b\x12\xd2\xc5\x01\x0b\x12\xcc\xc5\x01\x0b\x12\xc6\xc5\x01\x0b\x12\xc0\xc5\x01\x0b\x12\xba\xc5\x01\x0b\x12\xb4\xc5\x01\x0b\x12\xae\xc5\x01\x0b\x12\xa8\xc5\x01\x0b\x12\xa2\xc5\x01\x0b\x12\x9c\xc5\x01\x0b\x12\x96\xc5\x01\x0b\x12\x90\xc5\x01\x0b\x12\x8a\xc5\x01\x0b\x12\x84\xc5\x01\x0b\x12\xfe\xc4\x01\x0b\x12\xf8\xc4\x01\x0b\x12\xf2\xc4\x01\x0b\x12\xec\xc4\x01\x0b\x12\xe6\xc4\x01\x0b\x12\xe0\xc4\x01\x0b\x12\xda\xc4\x01\x0b\x12\xd4\xc4\x01\x0b\x12\xce\xc4\x01\x0b\x12\xc8\xc4\x01\x0b\x12\xc2\xc4\x01\x0b\x12\xbc\xc4\x01\x0b\x12\xb6\xc4\x01\x0b\x12\xb0\xc4\x01\x0b\x12\xaa\xc4\x01\x0b\x12\xa4\xc4\x01\x0b\x12\x9e\xc4\x01\x0b\x12\x98\xc4\x01\x0b\x12\x92\xc4\x01\x0b\x12\x8c\xc4\x01\x0b\x12\x86\xc4\x01\x0b\x12\x80\xc4\x01\x0b\x12\xfa\xc3\x01\x0b\x12\xf4\xc3\x01\x0b\x12\xee\xc3\x01\x0b\x12\xe8\xc3\x01\x0b\x12\xe2\xc3\x01\x0b\x12\xdc\xc3\x01\x0b\x12\xd6\xc3\x01\x0b\x12\xd0\xc3\x01\x0b\x12\xca\xc3\x01\x0b\x12\xc4\xc3\x01\x0b\x12\xbe\xc3\x01\x0b\x12\xb8\xc3\x01\x0b\x12\xb2\xc3\x01\x0b\x12\xac\xc3\x01\x0b\x12\xa6\xc3\x01\x0b\x12\xa0\xc3\x01\x0b\x12\x9a\xc3\x01\x0b\x12\x94\xc3\x01\x0b\x12\x8e\xc3\x01\x0b\x12\x88\xc3\x01\x0b\x12\x82\xc3\x01\x0b\x12\xfc\xc2\x01\x0b\x12\xf6\xc2\x01\x0b\x12\xf0\xc2\x01\x0b\x12\xea\xc2\x01\x0b\x12\xe4\xc2\x01\x0b\x12\xde\xc2\x01\x0b\x12\xd8\xc2\x01\x0b\x12\xd2\xc2\x01\x0b\x12\xcc\xc2\x01\x0b\x12\xc6\xc2\x01\x0b\x12\xc0\xc2\x01\x0b\x12\xba\xc2\x01\x0b\x12\xb4\xc2\x01\x0b\x12\xae\xc2\x01\x0b\x12\xa8\xc2\x01\x0b\x12\xa2\xc2\x01\x0b\x12\x9c\xc2\x01\x0b\x12\x96\xc2\x01\x0b\x12\x90\xc2\x01\x0b\x12\x8a\xc2\x01\x0b\x12\x84\xc2\x01\x0b\x12\xfe\xc1\x01\x0b\x12\xf8\xc1\x01\x0b\x12\xf2\xc1\x01\x0b\x12\xec\xc1\x01\x0b\x12\xe6\xc1\x01\x0b\x12\xe0\xc1\x01\x0b\x12\xda\xc1\x01\x0b\x12\xd4\xc1\x01\x0b\x12\xce\xc1\x01\x0b\x12\xc8\xc1\x01\x0b\x12\xc2\xc1\x01\x0b\x12\xbc\xc1\x01\x0b\x12\xb6\xc1\x01\x0b\x12\xb0\xc1\x01\x0b\x12\xaa\xc1\x01\x0b\x12\xa4\xc1\x01\x0b\x12\x9e\xc1\x01\x0b\x12\x98\xc1\x01\x0b\x12\x92\xc1\x01\x0b\x12\x8c\xc1\x01\x0b\x12\x86\xc1\x01\x0b\x12\x80\xc1\x01\x0b\x12\xfa\xc0\x01\x0b\x12\xf4\xc0\x01\x0b\x12\xee\xc0\x01\x0b\x12\xe8\xc0\x01\x0b\x12\xe2\xc0\x01\x0b\x12\xdc\xc0\x01\x0b\x12\xd6\xc0\x01\x0b\x12\xd0\xc0\x01\x0b\x12\xca\xc0\x01\x0b\x12\xc4\xc0\x01\x0b\x12\xbe\xc0\x01\x0b\x12\xb8\xc0\x01\x0b\x12\xb2\xc0\x01\x0b\x12\xac\xc0\x01\x0b\x12\xa6\xc0\x01\x0b\x12\xa0\xc0\x01\x0b\x12\x9a\xc0\x01\x0b\x12\x94\xc0\x01\x0b\x12\x8e\xc0\x01\x0b\x12\x88\xc0\x01\x0b\x12\x82\xc0\x01\x0b\x12\xfc\xbf\x01\x0b\x12\xf6\xbf\x01\x0b\x12\xf0\xbf\x01\x0b\x12\xea\xbf\x01\x0b\x12\xe4\xbf\x01\x0b\x12\xde\xbf\x01\x0b\x12\xd8\xbf\x01\x0b\x12\xd2\xbf\x01\x0b\x12\xcc\xbf\x01\x0b\x12\xc6\xbf\x01\x0b\x12\xc0\xbf\x01\x0b\x12\xba\xbf\x01\x0b\x12\xb4\xbf\x01\x0b\x12\xae\xbf\x01\x0b\x12\xa8\xbf\x01\x0b\x12\xa2\xbf\x01\x0b\x12\x9c\xbf\x01\x0b\x12\x96\xbf\x01\x0b\x12\x90\xbf\x01\x0b\x12\x8a\xbf\x01\x0b\x12\x84\xbf\x01\x0b\x12\xfe\xbe\x01\x0b\x12\xf8\xbe\x01\x0b\x12\xf2\xbe\x01\x0b\x12\xec\xbe\x01\x0b\x12\xe6\xbe\x01\x0b\x12\xe0\xbe\x01\x0b\x12\xda\xbe\x01\x0b\x12\xd4\xbe\x01\x0b\x12\xce\xbe\x01\x0b\x12\xc8\xbe\x01\x0b\x12\xc2\xbe\x01\x0b\x12\xbc\xbe\x01\x0b\x12\xb6\xbe\x01\x0b\x12\xb0\xbe\x01\x0b\x12\xaa\xbe\x01\x0b\x12\xa4\xbe\x01\x0b\x12\x9e\xbe\x01\x0b\x12\x98\xbe\x01\x0b\x12\x92\xbe\x01\x0b\x12\x8c\xbe\x01\x0b\x12\x86\xbe\x01\x0b\x12\x80\xbe\x01\x0b\x12\xfa\xbd\x01\x0b\x12\xf4\xbd\x01\x0b\x12\xee\xbd\x01\x0b\x12\xe8\xbd\x01\x0b\x12\xe2\xbd\x01\x0b\x12\xdc\xbd\x01\x0b\x12\xd6\xbd\x01\x0b\x12\xd0\xbd\x01\x0b\x12\xca\xbd\x01\x0b\x12\xc4\xbd\x01\x0b\x12\xbe\xbd\x01\x0b\x12\xb8\xbd\x01\x0b\x12\xb2\xbd\x01\x0b\x12\xac\xbd\x01\x0b\x12\xa6\xbd\x01\x0b\x12\xa0\xbd\x01\x0b\x12\x9a\xbd\x01\x0b\x12\x94\xbd\x01\x0b\x12\x8e\xbd\x01\x0b\x12\x88\xbd\x01\x0b\x12\x82\xbd\x01\x0b\x12\xfc\xbc\x01\x0b\x12\xf6\xbc\x01\x0b\x12\xf0\xbc\x01\x0b\x12\xea\xbc\x01\x0b\x12\xe4\xbc\x01\x0b\x12\xde\xbc\x01\x0b\x12\xd8\xbc\x01\x0b\x12\xd2\xbc\x01\x0b\x12\xcc\xbc\x01\x0b\x12\xc6\xbc\x01\x0b\x12\xc0\xbc\x01\x0b\x12\xba\xbc\x01\x0b\x12\xb4\xbc\x01\x0b\x12\xae\xbc\x01\x0b\x12\xa8\xbc\x01\x0b\x12\xa2\xbc\x01\x0b\x12\x9c\xbc\x01\x0b\x12\x96\xbc\x01\x0b\x12\x90\xbc\x01\x0b\x12\x8a\xbc\x01\x0b\x12\x84\xbc\x01\x0b\x12\xfe\xbb\x01\x0b\x12\xf8\xbb\x01\x0b\x12\xf2\xbb\x01\x0b\x12\xec\xbb\x01\x0b\x12\xe6\xbb\x01\x0b\x12\xe0\xbb\x01\x0b\x12\xda\xbb\x01\x0b\x12\xd4\xbb\x01\x0b\x12\xce\xbb\x01\x0b\x12\xc8\xbb\x01\x0b\x12\xc2\xbb\x01\x0b\x12\xbc\xbb\x01\x0b\x12\xb6\xbb\x01\x0b\x12\xb0\xbb\x01\x0b\x12\xaa\xbb\x01\x0b\x12\xa4\xbb\x01\x0b\x12\x9e\xbb\x01\x0b\x12\x98\xbb\x01\x0b\x12\x92\xbb\x01\x0b\x12\x8c\xbb\x01\x0b\x12\x86\xbb\x01\x0b\x12\x80\xbb\x01\x0b\x12\xfa\xba\x01\x0b\x12\xf4\xba\x01\x0b\x12\xee\xba\x01\x0b\x12\xe8\xba\x01\x0b\x12\xe2\xba\x01\x0b\x12\xdc\xba\x01\x0b\x12\xd6\xba\x01\x0b\x12\xd0\xba\x01\x0b\x12\xca\xba\x01\x0b\x12\xc4\xba\x01\x0b\x12\xbe\xba\x01\x0b\x12\xb8\xba\x01\x0b\x12\xb2\xba\x01\x0b\x12\xac\xba\x01\x0b\x12\xa6\xba\x01\x0b\x12\xa0\xba\x01\x0b\x12\x9a\xba\x01\x0b\x12\x94\xba\x01\x0b\x12\x8e\xba\x01\x0b\x12\x88\xba\x01\x0b\x12\x82\xba\x01\x0b\x12\xfc\xb9\x01\x0b\x12\xf6\xb9\x01\x0b\x12\xf0\xb9\x01\x0b\x12\xea\xb9\x01\x0b\x12\xe4\xb9\x01\x0b\x12\xde\xb9\x01\x0b\x12\xd8\xb9\x01\x0b\x12\xd2\xb9\x01\x0b\x12\xcc\xb9\x01\x0b\x12\xc6\xb9\x01\x0b\x12\xc0\xb9\x01\x0b\x12\xba\xb9\x01\x0b\x12\xb4\xb9\x01\x0b\x12\xae\xb9\x01\x0b\x12\xa8\xb9\x01\x0b\x12\xa2\xb9\x01\x0b\x12\x9c\xb9\x01\x0b\x12\x96\xb9\x01\x0b\x12\x90\xb9\x01\x0b\x12\x8a\xb9\x01\x0b\x12\x84\xb9\x01\x0b\x12\xfe\xb8\x01\x0b\x12\xf8\xb8\x01\x0b\x12\xf2\xb8\x01\x0b\x12\xec\xb8\x01\x0b\x12\xe6\xb8\x01\x0b\x12\xe0\xb8\x01\x0b\x12\xda\xb8\x01\x0b\x12\xd4\xb8\x01\x0b\x12\xce\xb8\x01\x0b\x12\xc8\xb8\x01\x0b\x12\xc2\xb8\x01\x0b\x12\xbc\xb8\x01\x0b\x12\xb6\xb8\x01\x0b\x12\xb0\xb8\x01\x0b\x12\xaa\xb8\x01\x0b\x12\xa4\xb8\x01\x0b\x12\x9e\xb8\x01\x0b\x12\x98\xb8\x01\x0b\x12\x92\xb8\x01\x0b\x12\x8c\xb8\x01\x0b\x12\x86\xb8\x01\x0b\x12\x80\xb8\x01\x0b\x12\xfa\xb7\x01\x0b\x12\xf4\xb7\x01\x0b\x12\xee\xb7\x01\x0b\x12\xe8\xb7\x01\x0b\x12\xe2\xb7\x01\x0b\x12\xdc\xb7\x01\x0b\x12\xd6\xb7\x01\x0b\x12\xd0\xb7\x01\x0b\x12\xca\xb7\x01\x0b\x12\xc4\xb7\x01\x0b\x12\xbe\xb7\x01\x0b\x12\xb8\xb7\x01\x0b\x12\xb2\xb7\x01\x0b\x12\xac\xb7\x01\x0b\x12\xa6\xb7\x01\x0b\x12\xa0\xb7\x01\x0b\x12\x9a\xb7\x01\x0b\x12\x94\xb7\x01\x0b\x12\x8e\xb7\x01\x0b\x12\x88\xb7\x01\x0b\x12\x82\xb7\x01\x0b\x12\xfc\xb6\x01\x0b\x12\xf6\xb6\x01\x0b\x12\xf0\xb6\x01\x0b\x12\xea\xb6\x01\x0b\x12\xe4\xb6\x01\x0b\x12\xde\xb6\x01\x0b\x12\xd8\xb6\x01\x0b\x12\xd2\xb6\x01\x0b\x12\xcc\xb6\x01\x0b\x12\xc6\xb6\x01\x0b\x12\xc0\xb6\x01\x0b\x12\xba\xb6\x01\x0b\x12\xb4\xb6\x01\x0b\x12\xae\xb6\x01\x0b\x12\xa8\xb6\x01\x0b\x12\xa2\xb6\x01\x0b\x12\x9c\xb6\x01\x0b\x12\x96\xb6\x01\x0b\x12\x90\xb6\x01\x0b\x12\x8a\xb6\x01\x0b\x12\x84\xb6\x01\x0b\x12\xfe\xb5\x01\x0b\x12\xf8\xb5\x01\x0b\x12\xf2\xb5\x01\x0b\x12\xec\xb5\x01\x0b\x12\xe6\xb5\x01\x0b\x12\xe0\xb5\x01\x0b\x12\xda\xb5\x01\x0b\x12\xd4\xb5\x01\x0b\x12\xce\xb5\x01\x0b\x12\xc8\xb5\x01\x0b\x12\xc2\xb5\x01\x0b\x12\xbc\xb5\x01\x0b\x12\xb6\xb5\x01\x0b\x12\xb0\xb5\x01\x0b\x12\xaa\xb5\x01\x0b\x12\xa4\xb5\x01\x0b\x12\x9e\xb5\x01\x0b\x12\x98\xb5\x01\x0b\x12\x92\xb5\x01\x0b\x12\x8c\xb5\x01\x0b\x12\x86\xb5\x01\x0b\x12\x80\xb5\x01\x0b\x12\xfa\xb4\x01\x0b\x12\xf4\xb4\x01\x0b\x12\xee\xb4\x01\x0b\x12\xe8\xb4\x01\x0b\x12\xe2\xb4\x01\x0b\x12\xdc\xb4\x01\x0b\x12\xd6\xb4\x01\x0b\x12\xd0\xb4\x01\x0b\x12\xca\xb4\x01\x0b\x12\xc4\xb4\x01\x0b\x12\xbe\xb4\x01\x0b\x12\xb8\xb4\x01\x0b\x12\xb2\xb4\x01\x0b\x12\xac\xb4\x01\x0b\x12\xa6\xb4\x01\x0b\x12\xa0\xb4\x01\x0b\x12\x9a\xb4\x01\x0b\x12\x94\xb4\x01\x0b\x12\x8e\xb4\x01\x0b\x12\x88\xb4\x01\x0b\x12\x82\xb4\x01\x0b\x12\xfc\xb3\x01\x0b\x12\xf6\xb3\x01\x0b\x12\xf0\xb3\x01\x0b\x12\xea\xb3\x01\x0b\x12\xe4\xb3\x01\x0b\x12\xde\xb3\x01\x0b\x12\xd8\xb3\x01\x0b\x12\xd2\xb3\x01\x0b\x12\xcc\xb3\x01\x0b\x12\xc6\xb3\x01\x0b\x12\xc0\xb3\x01\x0b\x12\xba\xb3\x01\x0b\x12\xb4\xb3\x01\x0b\x12\xae\xb3\x01\x0b\x12\xa8\xb3\x01\x0b\x12\xa2\xb3\x01\x0b\x12\x9c\xb3\x01\x0b\x12\x96\xb3\x01\x0b\x12\x90\xb3\x01\x0b\x12\x8a\xb3\x01\x0b\x12\x84\xb3\x01\x0b\x12\xfe\xb2\x01\x0b\x12\xf8\xb2\x01\x0b\x12\xf2\xb2\x01\x0b\x12\xec\xb2\x01\x0b\x12\xe6\xb2\x01\x0b\x12\xe0\xb2\x01\x0b\x12\xda\xb2\x01\x0b\x12\xd4\xb2\x01\x0b\x12\xce\xb2\x01\x0b\x12\xc8\xb2\x01\x0b\x12\xc2\xb2\x01\x0b\x12\xbc\xb2\x01\x0b\x12\xb6\xb2\x01\x0b\x12\xb0\xb2\x01\x0b\x12\xaa\xb2\x01\x0b\x12\xa4\xb2\x01\x0b\x12\x9e\xb2\x01\x0b\x12\x98\xb2\x01\x0b\x12\x92\xb2\x01\x0b\x12\x8c\xb2\x01\x0b\x12\x86\xb2\x01\x0b\x12\x80\xb2\x01\x0b\x12\xfa\xb1\x01\x0b\x12\xf4\xb1\x01\x0b\x12\xee\xb1\x01\x0b\x12\xe8\xb1\x01\x0b\x12\xe2\xb1\x01\x0b\x12\xdc\xb1\x01\x0b\x12\xd6\xb1\x01\x0b\x12\xd0\xb1\x01\x0b\x12\xca\xb1\x01\x0b\x12\xc4\xb1\x01\x0b\x12\xbe\xb1\x01\x0b\x12\xb8\xb1\x01\x0b\x12\xb2\xb1\x01\x0b\x12\xac\xb1\x01\x0b\x12\xa6\xb1\x01\x0b\x12\xa0\xb1\x01\x0b\x12\x9a\xb1\x01\x0b\x12\x94\xb1\x01\x0b\x12\x8e\xb1\x01\x0b\x12\x88\xb1\x01\x0b\x12\x82\xb1\x01\x0b\x12\xfc\xb0\x01\x0b\x12\xf6\xb0\x01\x0b\x12\xf0\xb0\x01\x0b\x12\xea\xb0\x01\x0b\x12\xe4\xb0\x01\x0b\x12\xde\xb0\x01\x0b\x12\xd8\xb0\x01\x0b\x12\xd2\xb0\x01\x0b\x12\xcc\xb0\x01\x0b\x12\xc6\xb0\x01\x0b\x12\xc0\xb0\x01\x0b\x12\xba\xb0\x01\x0b\x12\xb4\xb0\x01\x0b\x12\xae\xb0\x01\x0b\x12\xa8\xb0\x01\x0b\x12\xa2\xb0\x01\x0b\x12\x9c\xb0\x01\x0b\x12\x96\xb0\x01\x0b\x12\x90\xb0\x01\x0b\x12\x8a\xb0\x01\x0b\x12\x84\xb0\x01\x0b\x12\xfe\xaf\x01\x0b\x12\xf8\xaf\x01\x0b\x12\xf2\xaf\x01\x0b\x12\xec\xaf\x01\x0b\x12\xe6\xaf\x01\x0b\x12\xe0\xaf\x01\x0b\x12\xda\xaf\x01\x0b\x12\xd4\xaf\x01\x0b\x12\xce\xaf\x01\x0b\x12\xc8\xaf\x01\x0b\x12\xc2\xaf\x01\x0b\x12\xbc\xaf\x01\x0b\x12\xb6\xaf\x01\x0b\x12\xb0\xaf\x01\x0b\x12\xaa\xaf\x01\x0b\x12\xa4\xaf\x01\x0b\x12\x9e\xaf\x01\x0b\x12\x98\xaf\x01\x0b\x12\x92\xaf\x01\x0b\x12\x8c\xaf\x01\x0b\x12\x86\xaf\x01\x0b\x12\x80\xaf\x01\x0b\x12\xfa\xae\x01\x0b\x12\xf4\xae\x01\x0b\x12\xee\xae\x01\x0b\x12\xe8\xae\x01\x0b\x12\xe2\xae\x01\x0b\x12\xdc\xae\x01\x0b\x12\xd6\xae\x01\x0b\x12\xd0\xae\x01\x0b\x12\xca\xae\x01\x0b\x12\xc4\xae\x01\x0b\x12\xbe\xae\x01\x0b\x12\xb8\xae\x01\x0b\x12\xb2\xae\x01\x0b\x12\xac\xae\x01\x0b\x12\xa6\xae\x01\x0b\x12\xa0\xae\x01\x0b\x12\x9a\xae\x01\x0b\x12\x94\xae\x01\x0b\x12\x8e\xae\x01\x0b\x12\x88\xae\x01\x0b\x12\x82\xae\x01\x0b\x12\xfc\xad\x01\x0b\x12\xf6\xad\x01\x0b\x12\xf0\xad\x01\x0b\x12\xea\xad\x01\x0b\x12\xe4\xad\x01\x0b\x12\xde\xad\x01\x0b\x12\xd8\xad\x01\x0b\x12\xd2\xad\x01\x0b\x12\xcc\xad\x01\x0b\x12\xc6\xad\x01\x0b\x12\xc0\xad\x01\x0b\x12\xba\xad\x01\x0b\x12\xb4\xad\x01\x0b\x12\xae\xad\x01\x0b\x12\xa8\xad\x01\x0b\x12\xa2\xad\x01\x0b\x12\x9c\xad\x01\x0b\x12\x96\xad\x01\x0b\x12\x90\xad\x01\x0b\x12\x8a\xad\x01\x0b\x12\x84\xad\x01\x0b\x12\xfe\xac\x01\x0b\x12\xf8\xac\x01\x0b\x12\xf2\xac\x01\x0b\x12\xec\xac\x01\x0b\x12\xe6\xac\x01\x0b\x12\xe0\xac\x01\x0b\x12\xda\xac\x01\x0b\x12\xd4\xac\x01\x0b\x12\xce\xac\x01\x0b\x12\xc8\xac\x01\x0b\x12\xc2\xac\x01\x0b\x12\xbc\xac\x01\x0b\x12\xb6\xac\x01\x0b\x12\xb0\xac\x01\x0b\x12\xaa\xac\x01\x0b\x12\xa4\xac\x01\x0b\x12\x9e\xac\x01\x0b\x12\x98\xac\x01\x0b\x12\x92\xac\x01\x0b\x12\x8c\xac\x01\x0b\x12\x86\xac\x01\x0b\x12\x80\xac\x01\x0b\x12\xfa\xab\x01\x0b\x12\xf4\xab\x01\x0b\x12\xee\xab\x01\x0b\x12\xe8\xab\x01\x0b\x12\xe2\xab\x01\x0b\x12\xdc\xab\x01\x0b\x12\xd6\xab\x01\x0b\x12\xd0\xab\x01\x0b\x12\xca\xab\x01\x0b\x12\xc4\xab\x01\x0b\x12\xbe\xab\x01\x0b\x12\xb8\xab\x01\x0b\x12\xb2\xab\x01\x0b\x12\xac\xab\x01\x0b\x12\xa6\xab\x01\x0b\x12\xa0\xab\x01\x0b\x12\x9a\xab\x01\x0b\x12\x94\xab\x01\x0b\x12\x8e\xab\x01\x0b\x12\x88\xab\x01\x0b\x12\x82\xab\x01\x0b\x12\xfc\xaa\x01\x0b\x12\xf6\xaa\x01\x0b\x12\xf0\xaa\x01\x0b\x12\xea\xaa\x01\x0b\x12\xe4\xaa\x01\x0b\x12\xde\xaa\x01\x0b\x12\xd8\xaa\x01\x0b\x12\xd2\xaa\x01\x0b\x12\xcc\xaa\x01\x0b\x12\xc6\xaa\x01\x0b\x12\xc0\xaa\x01\x0b\x12\xba\xaa\x01\x0b\x12\xb4\xaa\x01\x0b\x12\xae\xaa\x01\x0b\x12\xa8\xaa\x01\x0b\x12\xa2\xaa\x01\x0b\x12\x9c\xaa\x01\x0b\x12\x96\xaa\x01\x0b\x12\x90\xaa\x01\x0b\x12\x8a\xaa\x01\x0b\x12\x84\xaa\x01\x0b\x12\xfe\xa9\x01\x0b\x12\xf8\xa9\x01\x0b\x12\xf2\xa9\x01\x0b\x12\xec\xa9\x01\x0b\x12\xe6\xa9\x01\x0b\x12\xe0\xa9\x01\x0b\x12\xda\xa9\x01\x0b\x12\xd4\xa9\x01\x0b\x12\xce\xa9\x01\x0b\x12\xc8\xa9\x01\x0b\x12\xc2\xa9\x01\x0b\x12\xbc\xa9\x01\x0b\x12\xb6\xa9\x01\x0b\x12\xb0\xa9\x01\x0b\x12\xaa\xa9\x01\x0b\x12\xa4\xa9\x01\x0b\x12\x9e\xa9\x01\x0b\x12\x98\xa9\x01\x0b\x12\x92\xa9\x01\x0b\x12\x8c\xa9\x01\x0b\x12\x86\xa9\x01\x0b\x12\x80\xa9\x01\x0b\x12\xfa\xa8\x01\x0b\x12\xf4\xa8\x01\x0b\x12\xee\xa8\x01\x0b\x12\xe8\xa8\x01\x0b\x12\xe2\xa8\x01\x0b\x12\xdc\xa8\x01\x0b\x12\xd6\xa8\x01\x0b\x12\xd0\xa8\x01\x0b\x12\xca\xa8\x01\x0b\x12\xc4\xa8\x01\x0b\x12\xbe\xa8\x01\x0b\x12\xb8\xa8\x01\x0b\x12\xb2\xa8\x01\x0b\x12\xac\xa8\x01\x0b\x12\xa6\xa8\x01\x0b\x12\xa0\xa8\x01\x0b\x12\x9a\xa8\x01\x0b\x12\x94\xa8\x01\x0b\x12\x8e\xa8\x01\x0b\x12\x88\xa8\x01\x0b\x12\x82\xa8\x01\x0b\x12\xfc\xa7\x01\x0b\x12\xf6\xa7\x01\x0b\x12\xf0\xa7\x01\x0b\x12\xea\xa7\x01\x0b\x12\xe4\xa7\x01\x0b\x12\xde\xa7\x01\x0b\x12\xd8\xa7\x01\x0b\x12\xd2\xa7\x01\x0b\x12\xcc\xa7\x01\x0b\x12\xc6\xa7\x01\x0b\x12\xc0\xa7\x01\x0b\x12\xba\xa7\x01\x0b\x12\xb4\xa7\x01\x0b\x12\xae\xa7\x01\x0b\x12\xa8\xa7\x01\x0b\x12\xa2\xa7\x01\x0b\x12\x9c\xa7\x01\x0b\x12\x96\xa7\x01\x0b\x12\x90\xa7\x01\x0b\x12\x8a\xa7\x01\x0b\x12\x84\xa7\x01\x0b\x12\xfe\xa6\x01\x0b\x12\xf8\xa6\x01\x0b\x12\xf2\xa6\x01\x0b\x12\xec\xa6\x01\x0b\x12\xe6\xa6\x01\x0b\x12\xe0\xa6\x01\x0b\x12\xda\xa6\x01\x0b\x12\xd4\xa6\x01\x0b\x12\xce\xa6\x01\x0b\x12\xc8\xa6\x01\x0b\x12\xc2\xa6\x01\x0b\x12\xbc\xa6\x01\x0b\x12\xb6\xa6\x01\x0b\x12\xb0\xa6\x01\x0b\x12\xaa\xa6\x01\x0b\x12\xa4\xa6\x01\x0b\x12\x9e\xa6\x01\x0b\x12\x98\xa6\x01\x0b\x12\x92\xa6\x01\x0b\x12\x8c\xa6\x01\x0b\x12\x86\xa6\x01\x0b\x12\x80\xa6\x01\x0b\x12\xfa\xa5\x01\x0b\x12\xf4\xa5\x01\x0b\x12\xee\xa5\x01\x0b\x12\xe8\xa5\x01\x0b\x12\xe2\xa5\x01\x0b\x12\xdc\xa5\x01\x0b\x12\xd6\xa5\x01\x0b\x12\xd0\xa5\x01\x0b\x12\xca\xa5\x01\x0b\x12\xc4\xa5\x01\x0b\x12\xbe\xa5\x01\x0b\x12\xb8\xa5\x01\x0b\x12\xb2\xa5\x01\x0b\x12\xac\xa5\x01\x0b\x12\xa6\xa5\x01\x0b\x12\xa0\xa5\x01\x0b\x12\x9a\xa5\x01\x0b\x12\x94\xa5\x01\x0b\x12\x8e\xa5\x01\x0b\x12\x88\xa5\x01\x0b\x12\x82\xa5\x01\x0b\x12\xfc\xa4\x01\x0b\x12\xf6\xa4\x01\x0b\x12\xf0\xa4\x01\x0b\x12\xea\xa4\x01\x0b\x12\xe4\xa4\x01\x0b\x12\xde\xa4\x01\x0b\x12\xd8\xa4\x01\x0b\x12\xd2\xa4\x01\x0b\x12\xcc\xa4\x01\x0b\x12\xc6\xa4\x01\x0b\x12\xc0\xa4\x01\x0b\x12\xba\xa4\x01\x0b\x12\xb4\xa4\x01\x0b\x12\xae\xa4\x01\x0b\x12\xa8\xa4\x01\x0b\x12\xa2\xa4\x01\x0b\x12\x9c\xa4\x01\x0b\x12\x96\xa4\x01\x0b\x12\x90\xa4\x01\x0b\x12\x8a\xa4\x01\x0b\x12\x84\xa4\x01\x0b\x12\xfe\xa3\x01\x0b\x12\xf8\xa3\x01\x0b\x12\xf2\xa3\x01\x0b\x12\xec\xa3\x01\x0b\x12\xe6\xa3\x01\x0b\x12\xe0\xa3\x01\x0b\x12\xda\xa3\x01\x0b\x12\xd4\xa3\x01\x0b\x12\xce\xa3\x01\x0b\x12\xc8\xa3\x01\x0b\x12\xc2\xa3\x01\x0b\x12\xbc\xa3\x01\x0b\x12\xb6\xa3\x01\x0b\x12\xb0\xa3\x01\x0b\x12\xaa\xa3\x01\x0b\x12\xa4\xa3\x01\x0b\x12\x9e\xa3\x01\x0b\x12\x98\xa3\x01\x0b\x12\x92\xa3\x01\x0b\x12\x8c\xa3\x01\x0b\x12\x86\xa3\x01\x0b\x12\x80\xa3\x01\x0b\x12\xfa\xa2\x01\x0b\x12\xf4\xa2\x01\x0b\x12\xee\xa2\x01\x0b\x12\xe8\xa2\x01\x0b\x12\xe2\xa2\x01\x0b\x12\xdc\xa2\x01\x0b\x12\xd6\xa2\x01\x0b\x12\xd0\xa2\x01\x0b\x12\xca\xa2\x01\x0b\x12\xc4\xa2\x01\x0b\x12\xbe\xa2\x01\x0b\x12\xb8\xa2\x01\x0b\x12\xb2\xa2\x01\x0b\x12\xac\xa2\x01\x0b\x12\xa6\xa2\x01\x0b\x12\xa0\xa2\x01\x0b\x12\x9a\xa2\x01\x0b\x12\x94\xa2\x01\x0b\x12\x8e\xa2\x01\x0b\x12\x88\xa2\x01\x0b\x12\x82\xa2\x01\x0b\x12\xfc\xa1\x01\x0b\x12\xf6\xa1\x01\x0b\x12\xf0\xa1\x01\x0b\x12\xea\xa1\x01\x0b\x12\xe4\xa1\x01\x0b\x12\xde\xa1\x01\x0b\x12\xd8\xa1\x01\x0b\x12\xd2\xa1\x01\x0b\x12\xcc\xa1\x01\x0b\x12\xc6\xa1\x01\x0b\x12\xc0\xa1\x01\x0b\x12\xba\xa1\x01\x0b\x12\xb4\xa1\x01\x0b\x12\xae\xa1\x01\x0b\x12\xa8\xa1\x01\x0b\x12\xa2\xa1\x01\x0b\x12\x9c\xa1\x01\x0b\x12\x96\xa1\x01\x0b\x12\x90\xa1\x01\x0b\x12\x8a\xa1\x01\x0b\x12\x84\xa1\x01\x0b\x12\xfe\xa0\x01\x0b\x12\xf8\xa0\x01\x0b\x12\xf2\xa0\x01\x0b\x12\xec\xa0\x01\x0b\x12\xe6\xa0\x01\x0b\x12\xe0\xa0\x01\x0b\x12\xda\xa0\x01\x0b\x12\xd4\xa0\x01\x0b\x12\xce\xa0\x01\x0b\x12\xc8\xa0\x01\x0b\x12\xc2\xa0\x01\x0b\x12\xbc\xa0\x01\x0b\x12\xb6\xa0\x01\x0b\x12\xb0\xa0\x01\x0b\x12\xaa\xa0\x01\x0b\x12\xa4\xa0\x01\x0b\x12\x9e\xa0\x01\x0b\x12\x98\xa0\x01\x0b\x12\x92\xa0\x01\x0b\x12\x8c\xa0\x01\x0b\x12\x86\xa0\x01\x0b\x12\x80\xa0\x01\x0b\x12\xfa\x9f\x01\x0b\x12\xf4\x9f\x01\x0b\x12\xee\x9f\x01\x0b\x12\xe8\x9f\x01\x0b\x12\xe2\x9f\x01\x0b\x12\xdc\x9f\x01\x0b\x12\xd6\x9f\x01\x0b\x12\xd0\x9f\x01\x0b\x12\xca\x9f\x01\x0b\x12\xc4\x9f\x01\x0b\x12\xbe\x9f\x01\x0b\x12\xb8\x9f\x01\x0b\x12\xb2\x9f\x01\x0b\x12\xac\x9f\x01\x0b\x12\xa6\x9f\x01\x0b\x12\xa0\x9f\x01\x0b\x12\x9a\x9f\x01\x0b\x12\x94\x9f\x01\x0b\x12\x8e\x9f\x01\x0b\x12\x88\x9f\x01\x0b\x12\x82\x9f\x01\x0b\x12\xfc\x9e\x01\x0b\x12\xf6\x9e\x01\x0b\x12\xf0\x9e\x01\x0b\x12\xea\x9e\x01\x0b\x12\xe4\x9e\x01\x0b\x12\xde\x9e\x01\x0b\x12\xd8\x9e\x01\x0b\x12\xd2\x9e\x01\x0b\x12\xcc\x9e\x01\x0b\x12\xc6\x9e\x01\x0b\x12\xc0\x9e\x01\x0b\x12\xba\x9e\x01\x0b\x12\xb4\x9e\x01\x0b\x12\xae\x9e\x01\x0b\x12\xa8\x9e\x01\x0b\x12\xa2\x9e\x01\x0b\x12\x9c\x9e\x01\x0b\x12\x96\x9e\x01\x0b\x12\x90\x9e\x01\x0b\x12\x8a\x9e\x01\x0b\x12\x84\x9e\x01\x0b\x12\xfe\x9d\x01\x0b\x12\xf8\x9d\x01\x0b\x12\xf2\x9d\x01\x0b\x12\xec\x9d\x01\x0b\x12\xe6\x9d\x01\x0b\x12\xe0\x9d\x01\x0b\x12\xda\x9d\x01\x0b\x12\xd4\x9d\x01\x0b\x12\xce\x9d\x01\x0b\x12\xc8\x9d\x01\x0b\x12\xc2\x9d\x01\x0b\x12\xbc\x9d\x01\x0b\x12\xb6\x9d\x01\x0b\x12\xb0\x9d\x01\x0b\x12\xaa\x9d\x01\x0b\x12\xa4\x9d\x01\x0b\x12\x9e\x9d\x01\x0b\x12\x98\x9d\x01\x0b\x12\x92\x9d\x01\x0b\x12\x8c\x9d\x01\x0b\x12\x86\x9d\x01\x0b\x12\x80\x9d\x01\x0b\x12\xfa\x9c\x01\x0b\x12\xf4\x9c\x01\x0b\x12\xee\x9c\x01\x0b\x12\xe8\x9c\x01\x0b\x12\xe2\x9c\x01\x0b\x12\xdc\x9c\x01\x0b\x12\xd6\x9c\x01\x0b\x12\xd0\x9c\x01\x0b\x12\xca\x9c\x01\x0b\x12\xc4\x9c\x01\x0b\x12\xbe\x9c\x01\x0b\x12\xb8\x9c\x01\x0b\x12\xb2\x9c\x01\x0b\x12\xac\x9c\x01\x0b\x12\xa6\x9c\x01\x0b\x12\xa0\x9c\x01\x0b\x12\x9a\x9c\x01\x0b\x12\x94\x9c\x01\x0b\x12\x8e\x9c\x01\x0b\x12\x88\x9c\x01\x0b\x12\x82\x9c\x01\x0b\x12\xfc\x9b\x01\x0b\x12\xf6\x9b\x01\x0b\x12\xf0\x9b\x01\x0b\x12\xea\x9b\x01\x0b\x12\xe4\x9b\x01\x0b\x12\xde\x9b\x01\x0b\x12\xd8\x9b\x01\x0b\x12\xd2\x9b\x01\x0b\x12\xcc\x9b\x01\x0b\x12\xc6\x9b\x01\x0b\x12\xc0\x9b\x01\x0b\x12\xba\x9b\x01\x0b\x12\xb4\x9b\x01\x0b\x12\xae\x9b\x01\x0b\x12\xa8\x9b\x01\x0b\x12\xa2\x9b\x01\x0b\x12\x9c\x9b\x01\x0b\x12\x96\x9b\x01\x0b\x12\x90\x9b\x01\x0b\x12\x8a\x9b\x01\x0b\x12\x84\x9b\x01\x0b\x12\xfe\x9a\x01\x0b\x12\xf8\x9a\x01\x0b\x12\xf2\x9a\x01\x0b\x12\xec\x9a\x01\x0b\x12\xe6\x9a\x01\x0b\x12\xe0\x9a\x01\x0b\x12\xda\x9a\x01\x0b\x12\xd4\x9a\x01\x0b\x12\xce\x9a\x01\x0b\x12\xc8\x9a\x01\x0b\x12\xc2\x9a\x01\x0b\x12\xbc\x9a\x01\x0b\x12\xb6\x9a\x01\x0b\x12\xb0\x9a\x01\x0b\x12\xaa\x9a\x01\x0b\x12\xa4\x9a\x01\x0b\x12\x9e\x9a\x01\x0b\x12\x98\x9a\x01\x0b\x12\x92\x9a\x01\x0b\x12\x8c\x9a\x01\x0b\x12\x86\x9a\x01\x0b\x12\x80\x9a\x01\x0b\x12\xfa\x99\x01\x0b\x12\xf4\x99\x01\x0b\x12\xee\x99\x01\x0b\x12\xe8\x99\x01\x0b\x12\xe2\x99\x01\x0b\x12\xdc\x99\x01\x0b\x12\xd6\x99\x01\x0b\x12\xd0\x99\x01\x0b\x12\xca\x99\x01\x0b\x12\xc4\x99\x01\x0b\x12\xbe\x99\x01\x0b\x12\xb8\x99\x01\x0b\x12\xb2\x99\x01\x0b\x12\xac\x99\x01\x0b\x12\xa6\x99\x01\x0b\x12\xa0\x99\x01\x0b\x12\x9a\x99\x01\x0b\x12\x94\x99\x01\x0b\x12\x8e\x99\x01\x0b\x12\x88\x99\x01\x0b\x12\x82\x99\x01\x0b\x12\xfc\x98\x01\x0b\x12\xf6\x98\x01\x0b\x12\xf0\x98\x01\x0b\x12\xea\x98\x01\x0b\x12\xe4\x98\x01\x0b\x12\xde\x98\x01\x0b\x12\xd8\x98\x01\x0b\x12\xd2\x98\x01\x0b\x12\xcc\x98\x01\x0b\x12\xc6\x98\x01\x0b\x12\xc0\x98\x01\x0b\x12\xba\x98\x01\x0b\x12\xb4\x98\x01\x0b\x12\xae\x98\x01\x0b\x12\xa8\x98\x01\x0b\x12\xa2\x98\x01\x0b\x12\x9c\x98\x01\x0b\x12\x96\x98\x01\x0b\x12\x90\x98\x01\x0b\x12\x8a\x98\x01\x0b\x12\x84\x98\x01\x0b\x12\xfe\x97\x01\x0b\x12\xf8\x97\x01\x0b\x12\xf2\x97\x01\x0b\x12\xec\x97\x01\x0b\x12\xe6\x97\x01\x0b\x12\xe0\x97\x01\x0b\x12\xda\x97\x01\x0b\x12\xd4\x97\x01\x0b\x12\xce\x97\x01\x0b\x12\xc8\x97\x01\x0b\x12\xc2\x97\x01\x0b\x12\xbc\x97\x01\x0b\x12\xb6\x97\x01\x0b\x12\xb0\x97\x01\x0b\x12\xaa\x97\x01\x0b\x12\xa4\x97\x01\x0b\x12\x9e\x97\x01\x0b\x12\x98\x97\x01\x0b\x12\x92\x97\x01\x0b\x12\x8c\x97\x01\x0b\x12\x86\x97\x01\x0b\x12\x80\x97\x01\x0b\x12\xfa\x96\x01\x0b\x12\xf4\x96\x01\x0b\x12\xee\x96\x01\x0b\x12\xe8\x96\x01\x0b\x12\xe2\x96\x01\x0b\x12\xdc\x96\x01\x0b\x12\xd6\x96\x01\x0b\x12\xd0\x96\x01\x0b\x12\xca\x96\x01\x0b\x12\xc4\x96\x01\x0b\x12\xbe\x96\x01\x0b\x12\xb8\x96\x01\x0b\x12\xb2\x96\x01\x0b\x12\xac\x96\x01\x0b\x12\xa6\x96\x01\x0b\x12\xa0\x96\x01\x0b\x12\x9a\x96\x01\x0b\x12\x94\x96\x01\x0b\x12\x8e\x96\x01\x0b\x12\x88\x96\x01\x0b\x12\x82\x96\x01\x0b\x12\xfc\x95\x01\x0b\x12\xf6\x95\x01\x0b\x12\xf0\x95\x01\x0b\x12\xea\x95\x01\x0b\x12\xe4\x95\x01\x0b\x12\xde\x95\x01\x0b\x12\xd8\x95\x01\x0b\x12\xd2\x95\x01\x0b\x12\xcc\x95\x01\x0b\x12\xc6\x95\x01\x0b\x12\xc0\x95\x01\x0b\x12\xba\x95\x01\x0b\x12\xb4\x95\x01\x0b\x12\xae\x95\x01\x0b\x12\xa8\x95\x01\x0b\x12\xa2\x95\x01\x0b\x12\x9c\x95\x01\x0b\x12\x96\x95\x01\x0b\x12\x90\x95\x01\x0b\x12\x8a\x95\x01\x0b\x12\x84\x95\x01\x0b\x12\xfe\x94\x01\x0b\x12\xf8\x94\x01\x0b\x12\xf2\x94\x01\x0b\x12\xec\x94\x01\x0b\x12\xe6\x94\x01\x0b\x12\xe0\x94\x01\x0b\x12\xda\x94\x01\x0b\x12\xd4\x94\x01\x0b\x12\xce\x94\x01\x0b\x12\xc8\x94\x01\x0b\x12\xc2\x94\x01\x0b\x12\xbc\x94\x01\x0b\x12\xb6\x94\x01\x0b\x12\xb0\x94\x01\x0b\x12\xaa\x94\x01\x0b\x12\xa4\x94\x01\x0b\x12\x9e\x94\x01\x0b\x12\x98\x94\x01\x0b\x12\x92\x94\x01\x0b\x12\x8c\x94\x01\x0b\x12\x86\x94\x01\x0b\x12\x80\x94\x01\x0b\x12\xfa\x93\x01\x0b\x12\xf4\x93\x01\x0b\x12\xee\x93\x01\x0b\x12\xe8\x93\x01\x0b\x12\xe2\x93\x01\x0b\x12\xdc\x93\x01\x0b\x12\xd6\x93\x01\x0b\x12\xd0\x93\x01\x0b\x12\xca\x93\x01\x0b\x12\xc4\x93\x01\x0b\x12\xbe\x93\x01\x0b\x12\xb8\x93\x01\x0b\x12\xb2\x93\x01\x0b\x12\xac\x93\x01\x0b\x12\xa6\x93\x01\x0b\x12\xa0\x93\x01\x0b\x12\x9a\x93\x01\x0b\x12\x94\x93\x01\x0b\x12\x8e\x93\x01\x0b\x12\x88\x93\x01\x0b\x12\x82\x93\x01\x0b\x12\xfc\x92\x01\x0b\x12\xf6\x92\x01\x0b\x12\xf0\x92\x01\x0b\x12\xea\x92\x01\x0b\x12\xe4\x92\x01\x0b\x12\xde\x92\x01\x0b\x12\xd8\x92\x01\x0b\x12\xd2\x92\x01\x0b\x12\xcc\x92\x01\x0b\x12\xc6\x92\x01\x0b\x12\xc0\x92\x01\x0b\x12\xba\x92\x01\x0b\x12\xb4\x92\x01\x0b\x12\xae\x92\x01\x0b\x12\xa8\x92\x01\x0b\x12\xa2\x92\x01\x0b\x12\x9c\x92\x01\x0b\x12\x96\x92\x01\x0b\x12\x90\x92\x01\x0b\x12\x8a\x92\x01\x0b\x12\x84\x92\x01\x0b\x12\xfe\x91\x01\x0b\x12\xf8\x91\x01\x0b\x12\xf2\x91\x01\x0b\x12\xec\x91\x01\x0b\x12\xe6\x91\x01\x0b\x12\xe0\x91\x01\x0b\x12\xda\x91\x01\x0b\x12\xd4\x91\x01\x0b\x12\xce\x91\x01\x0b\x12\xc8\x91\x01\x0b\x12\xc2\x91\x01\x0b\x12\xbc\x91\x01\x0b\x12\xb6\x91\x01\x0b\x12\xb0\x91\x01\x0b\x12\xaa\x91\x01\x0b\x12\xa4\x91\x01\x0b\x12\x9e\x91\x01\x0b\x12\x98\x91\x01\x0b\x12\x92\x91\x01\x0b\x12\x8c\x91\x01\x0b\x12\x86\x91\x01\x0b\x12\x80\x91\x01\x0b\x12\xfa\x90\x01\x0b\x12\xf4\x90\x01\x0b\x12\xee\x90\x01\x0b\x12\xe8\x90\x01\x0b\x12\xe2\x90\x01\x0b\x12\xdc\x90\x01\x0b\x12\xd6\x90\x01\x0b\x12\xd0\x90\x01\x0b\x12\xca\x90\x01\x0b\x12\xc4\x90\x01\x0b\x12\xbe\x90\x01\x0b\x12\xb8\x90\x01\x0b\x12\xb2\x90\x01\x0b\x12\xac\x90\x01\x0b\x12\xa6\x90\x01\x0b\x12\xa0\x90\x01\x0b\x12\x9a\x90\x01\x0b\x12\x94\x90\x01\x0b\x12\x8e\x90\x01\x0b\x12\x88\x90\x01\x0b\x12\x82\x90\x01\x0b\x12\xfc\x8f\x01\x0b\x12\xf6\x8f\x01\x0b\x12\xf0\x8f\x01\x0b\x12\xea\x8f\x01\x0b\x12\xe4\x8f\x01\x0b\x12\xde\x8f\x01\x0b\x12\xd8\x8f\x01\x0b\x12\xd2\x8f\x01\x0b\x12\xcc\x8f\x01\x0b\x12\xc6\x8f\x01\x0b\x12\xc0\x8f\x01\x0b\x12\xba\x8f\x01\x0b\x12\xb4\x8f\x01\x0b\x12\xae\x8f\x01\x0b\x12\xa8\x8f\x01\x0b\x12\xa2\x8f\x01\x0b\x12\x9c\x8f\x01\x0b\x12\x96\x8f\x01\x0b\x12\x90\x8f\x01\x0b\x12\x8a\x8f\x01\x0b\x12\x84\x8f\x01\x0b\x12\xfe\x8e\x01\x0b\x12\xf8\x8e\x01\x0b\x12\xf2\x8e\x01\x0b\x12\xec\x8e\x01\x0b\x12\xe6\x8e\x01\x0b\x12\xe0\x8e\x01\x0b\x12\xda\x8e\x01\x0b\x12\xd4\x8e\x01\x0b\x12\xce\x8e\x01\x0b\x12\xc8\x8e\x01\x0b\x12\xc2\x8e\x01\x0b\x12\xbc\x8e\x01\x0b\x12\xb6\x8e\x01\x0b\x12\xb0\x8e\x01\x0b\x12\xaa\x8e\x01\x0b\x12\xa4\x8e\x01\x0b\x12\x9e\x8e\x01\x0b\x12\x98\x8e\x01\x0b\x12\x92\x8e\x01\x0b\x12\x8c\x8e\x01\x0b\x12\x86\x8e\x01\x0b\x12\x80\x8e\x01\x0b\x12\xfa\x8d\x01\x0b\x12\xf4\x8d\x01\x0b\x12\xee\x8d\x01\x0b\x12\xe8\x8d\x01\x0b\x12\xe2\x8d\x01\x0b\x12\xdc\x8d\x01\x0b\x12\xd6\x8d\x01\x0b\x12\xd0\x8d\x01\x0b\x12\xca\x8d\x01\x0b\x12\xc4\x8d\x01\x0b\x12\xbe\x8d\x01\x0b\x12\xb8\x8d\x01\x0b\x12\xb2\x8d\x01\x0b\x12\xac\x8d\x01\x0b\x12\xa6\x8d\x01\x0b\x12\xa0\x8d\x01\x0b\x12\x9a\x8d\x01\x0b\x12\x94\x8d\x01\x0b\x12\x8e\x8d\x01\x0b\x12\x88\x8d\x01\x0b\x12\x82\x8d\x01\x0b\x12\xfc\x8c\x01\x0b\x12\xf6\x8c\x01\x0b\x12\xf0\x8c\x01\x0b\x12\xea\x8c\x01\x0b\x12\xe4\x8c\x01\x0b\x12\xde\x8c\x01\x0b\x12\xd8\x8c\x01\x0b\x12\xd2\x8c\x01\x0b\x12\xcc\x8c\x01\x0b\x12\xc6\x8c\x01\x0b\x12\xc0\x8c\x01\x0b\x12\xba\x8c\x01\x0b\x12\xb4\x8c\x01\x0b\x12\xae\x8c\x01\x0b\x12\xa8\x8c\x01\x0b\x12\xa2\x8c\x01\x0b\x12\x9c\x8c\x01\x0b\x12\x96\x8c\x01\x0b\x12\x90\x8c\x01\x0b\x12\x8a\x8c\x01\x0b\x12\x84\x8c\x01\x0b\x12\xfe\x8b\x01\x0b\x12\xf8\x8b\x01\x0b\x12\xf2\x8b\x01\x0b\x12\xec\x8b\x01\x0b\x12\xe6\x8b\x01\x0b\x12\xe0\x8b\x01\x0b\x12\xda\x8b\x01\x0b\x12\xd4\x8b\x01\x0b\x12\xce\x8b\x01\x0b\x12\xc8\x8b\x01\x0b\x12\xc2\x8b\x01\x0b\x12\xbc\x8b\x01\x0b\x12\xb6\x8b\x01\x0b\x12\xb0\x8b\x01\x0b\x12\xaa\x8b\x01\x0b\x12\xa4\x8b\x01\x0b\x12\x9e\x8b\x01\x0b\x12\x98\x8b\x01\x0b\x12\x92\x8b\x01\x0b\x12\x8c\x8b\x01\x0b\x12\x86\x8b\x01\x0b\x12\x80\x8b\x01\x0b\x12\xfa\x8a\x01\x0b\x12\xf4\x8a\x01\x0b\x12\xee\x8a\x01\x0b\x12\xe8\x8a\x01\x0b\x12\xe2\x8a\x01\x0b\x12\xdc\x8a\x01\x0b\x12\xd6\x8a\x01\x0b\x12\xd0\x8a\x01\x0b\x12\xca\x8a\x01\x0b\x12\xc4\x8a\x01\x0b\x12\xbe\x8a\x01\x0b\x12\xb8\x8a\x01\x0b\x12\xb2\x8a\x01\x0b\x12\xac\x8a\x01\x0b\x12\xa6\x8a\x01\x0b\x12\xa0\x8a\x01\x0b\x12\x9a\x8a\x01\x0b\x12\x94\x8a\x01\x0b\x12\x8e\x8a\x01\x0b\x12\x88\x8a\x01\x0b\x12\x82\x8a\x01\x0b\x12\xfc\x89\x01\x0b\x12\xf6\x89\x01\x0b\x12\xf0\x89\x01\x0b\x12\xea\x89\x01\x0b\x12\xe4\x89\x01\x0b\x12\xde\x89\x01\x0b\x12\xd8\x89\x01\x0b\x12\xd2\x89\x01\x0b\x12\xcc\x89\x01\x0b\x12\xc6\x89\x01\x0b\x12\xc0\x89\x01\x0b\x12\xba\x89\x01\x0b\x12\xb4\x89\x01\x0b\x12\xae\x89\x01\x0b\x12\xa8\x89\x01\x0b\x12\xa2\x89\x01\x0b\x12\x9c\x89\x01\x0b\x12\x96\x89\x01\x0b\x12\x90\x89\x01\x0b\x12\x8a\x89\x01\x0b\x12\x84\x89\x01\x0b\x12\xfe\x88\x01\x0b\x12\xf8\x88\x01\x0b\x12\xf2\x88\x01\x0b\x12\xec\x88\x01\x0b\x12\xe6\x88\x01\x0b\x12\xe0\x88\x01\x0b\x12\xda\x88\x01\x0b\x12\xd4\x88\x01\x0b\x12\xce\x88\x01\x0b\x12\xc8\x88\x01\x0b\x12\xc2\x88\x01\x0b\x12\xbc\x88\x01\x0b\x12\xb6\x88\x01\x0b\x12\xb0\x88\x01\x0b\x12\xaa\x88\x01\x0b\x12\xa4\x88\x01\x0b\x12\x9e\x88\x01\x0b\x12\x98\x88\x01\x0b\x12\x92\x88\x01\x0b\x12\x8c\x88\x01\x0b\x12\x86\x88\x01\x0b\x12\x80\x88\x01\x0b\x12\xfa\x87\x01\x0b\x12\xf4\x87\x01\x0b\x12\xee\x87\x01\x0b\x12\xe8\x87\x01\x0b\x12\xe2\x87\x01\x0b\x12\xdc\x87\x01\x0b\x12\xd6\x87\x01\x0b\x12\xd0\x87\x01\x0b\x12\xca\x87\x01\x0b\x12\xc4\x87\x01\x0b\x12\xbe\x87\x01\x0b\x12\xb8\x87\x01\x0b\x12\xb2\x87\x01\x0b\x12\xac\x87\x01\x0b\x12\xa6\x87\x01\x0b\x12\xa0\x87\x01\x0b\x12\x9a\x87\x01\x0b\x12\x94\x87\x01\x0b\x12\x8e\x87\x01\x0b\x12\x88\x87\x01\x0b\x12\x82\x87\x01\x0b\x12\xfc\x86\x01\x0b\x12\xf6\x86\x01\x0b\x12\xf0\x86\x01\x0b\x12\xea\x86\x01\x0b\x12\xe4\x86\x01\x0b\x12\xde\x86\x01\x0b\x12\xd8\x86\x01\x0b\x12\xd2\x86\x01\x0b\x12\xcc\x86\x01\x0b\x12\xc6\x86\x01\x0b\x12\xc0\x86\x01\x0b\x12\xba\x86\x01\x0b\x12\xb4\x86\x01\x0b\x12\xae\x86\x01\x0b\x12\xa8\x86\x01\x0b\x12\xa2\x86\x01\x0b\x12\x9c\x86\x01\x0b\x12\x96\x86\x01\x0b\x12\x90\x86\x01\x0b\x12\x8a\x86\x01\x0b\x12\x84\x86\x01\x0b\x12\xfe\x85\x01\x0b\x12\xf8\x85\x01\x0b\x12\xf2\x85\x01\x0b\x12\xec\x85\x01\x0b\x12\xe6\x85\x01\x0b\x12\xe0\x85\x01\x0b\x12\xda\x85\x01\x0b\x12\xd4\x85\x01\x0b\x12\xce\x85\x01\x0b\x12\xc8\x85\x01\x0b\x12\xc2\x85\x01\x0b\x12\xbc\x85\x01\x0b\x12\xb6\x85\x01\x0b\x12\xb0\x85\x01\x0b\x12\xaa\x85\x01\x0b\x12\xa4\x85\x01\x0b\x12\x9e\x85\x01\x0b\x12\x98\x85\x01\x0b\x12\x92\x85\x01\x0b\x12\x8c\x85\x01\x0b\x12\x86\x85\x01\x0b\x12\x80\x85\x01\x0b\x12\xfa\x84\x01\x0b\x12\xf4\x84\x01\x0b\x12\xee\x84\x01\x0b\x12\xe8\x84\x01\x0b\x12\xe2\x84\x01\x0b\x12\xdc\x84\x01\x0b\x12\xd6\x84\x01\x0b\x12\xd0\x84\x01\x0b\x12\xca\x84\x01\x0b\x12\xc4\x84\x01\x0b\x12\xbe\x84\x01\x0b\x12\xb8\x84\x01\x0b\x12\xb2\x84\x01\x0b\x12\xac\x84\x01\x0b\x12\xa6\x84\x01\x0b\x12\xa0\x84\x01\x0b\x12\x9a\x84\x01\x0b\x12\x94\x84\x01\x0b\x12\x8e\x84\x01\x0b\x12\x88\x84\x01\x0b\x12\x82\x84\x01\x0b\x12\xfc\x83\x01\x0b\x12\xf6\x83\x01\x0b\x12\xf0\x83\x01\x0b\x12\xea\x83\x01\x0b\x12\xe4\x83\x01\x0b\x12\xde\x83\x01\x0b\x12\xd8\x83\x01\x0b\x12\xd2\x83\x01\x0b\x12\xcc\x83\x01\x0b\x12\xc6\x83\x01\x0b\x12\xc0\x83\x01\x0b\x12\xba\x83\x01\x0b\x12\xb4\x83\x01\x0b\x12\xae\x83\x01\x0b\x12\xa8\x83\x01\x0b\x12\xa2\x83\x01\x0b\x12\x9c\x83\x01\x0b\x12\x96\x83\x01\x0b\x12\x90\x83\x01\x0b\x12\x8a\x83\x01\x0b\x12\x84\x83\x01\x0b\x12\xfe\x82\x01\x0b\x12\xf8\x82\x01\x0b\x12\xf2\x82\x01\x0b\x12\xec\x82\x01\x0b\x12\xe6\x82\x01\x0b\x12\xe0\x82\x01\x0b\x12\xda\x82\x01\x0b\x12\xd4\x82\x01\x0b\x12\xce\x82\x01\x0b\x12\xc8\x82\x01\x0b\x12\xc2\x82\x01\x0b\x12\xbc\x82\x01\x0b\x12\xb6\x82\x01\x0b\x12\xb0\x82\x01\x0b\x12\xaa\x82\x01\x0b\x12\xa4\x82\x01\x0b\x12\x9e\x82\x01\x0b\x12\x98\x82\x01\x0b\x12\x92\x82\x01\x0b\x12\x8c\x82\x01\x0b\x12\x86\x82\x01\x0b\x12\x80\x82\x01\x0b\x12\xfa\x81\x01\x0b\x12\xf4\x81\x01\x0b\x12\xee\x81\x01\x0b\x12\xe8\x81\x01\x0b\x12\xe2\x81\x01\x0b\x12\xdc\x81\x01\x0b\x12\xd6\x81\x01\x0b\x12\xd0\x81\x01\x0b\x12\xca\x81\x01\x0b\x12\xc4\x81\x01\x0b\x12\xbe\x81\x01\x0b\x12\xb8\x81\x01\x0b\x12\xb2\x81\x01\x0b\x12\xac\x81\x01\x0b\x12\xa6\x81\x01\x0b\x12\xa0\x81\x01\x0b\x12\x9a\x81\x01\x0b\x12\x94\x81\x01\x0b\x12\x8e\x81\x01\x0b\x12\x88\x81\x01\x0b\x12\x82\x81\x01\x0b\x12\xfc\x80\x01\x0b\x12\xf6\x80\x01\x0b\x12\xf0\x80\x01\x0b\x12\xea\x80\x01\x0b\x12\xe4\x80\x01\x0b\x12\xde\x80\x01\x0b\x12\xd8\x80\x01\x0b\x12\xd2\x80\x01\x0b\x12\xcc\x80\x01\x0b\x12\xc6\x80\x01\x0b\x12\xc0\x80\x01\x0b\x12\xba\x80\x01\x0b\x12\xb4\x80\x01\x0b\x12\xae\x80\x01\x0b\x12\xa8\x80\x01\x0b\x12\xa2\x80\x01\x0b\x12\x9c\x80\x01\x0b\x12\x96\x80\x01\x0b\x12\x90\x80\x01\x0b\x12\x8a\x80\x01\x0b\x12\x84\x80\x01\x0b\x12\xff\x7f\x0b\x12\xfa\x7f\x0b\x12\xf5\x7f\x0b\x12\xf0\x7f\x0b\x12\xeb\x7f\x0b\x12\xe6\x7f\x0b\x12\xe1\x7f\x0b\x12\xdc\x7f\x0b\x12\xd7\x7f\x0b\x12\xd2\x7f\x0b\x12\xcd\x7f\x0b\x12\xc8\x7f\x0b\x12\xc3\x7f\x0b\x12\xbe\x7f\x0b\x12\xb9\x7f\x0b\x12\xb4\x7f\x0b\x12\xaf\x7f\x0b\x12\xaa\x7f\x0b\x12\xa5\x7f\x0b\x12\xa0\x7f\x0b\x12\x9b\x7f\x0b\x12\x96\x7f\x0b\x12\x91\x7f\x0b\x12\x8c\x7f\x0b\x12\x87\x7f\x0b\x12\x82\x7f\x0b\x12\xfd~\x0b\x12\xf8~\x0b\x12\xf3~\x0b\x12\xee~\x0b\x12\xe9~\x0b\x12\xe4~\x0b\x12\xdf~\x0b\x12\xda~\x0b\x12\xd5~\x0b\x12\xd0~\x0b\x12\xcb~\x0b\x12\xc6~\x0b\x12\xc1~\x0b\x12\xbc~\x0b\x12\xb7~\x0b\x12\xb2~\x0b\x12\xad~\x0b\x12\xa8~\x0b\x12\xa3~\x0b\x12\x9e~\x0b\x12\x99~\x0b\x12\x94~\x0b\x12\x8f~\x0b\x12\x8a~\x0b\x12\x85~\x0b\x12\x80~\x0b\x12\xfb}\x0b\x12\xf6}\x0b\x12\xf1}\x0b\x12\xec}\x0b\x12\xe7}\x0b\x12\xe2}\x0b\x12\xdd}\x0b\x12\xd8}\x0b\x12\xd3}\x0b\x12\xce}\x0b\x12\xc9}\x0b\x12\xc4}\x0b\x12\xbf}\x0b\x12\xba}\x0b\x12\xb5}\x0b\x12\xb0}\x0b\x12\xab}\x0b\x12\xa6}\x0b\x12\xa1}\x0b\x12\x9c}\x0b\x12\x97}\x0b\x12\x92}\x0b\x12\x8d}\x0b\x12\x88}\x0b\x12\x83}\x0b\x12\xfe|\x0b\x12\xf9|\x0b\x12\xf4|\x0b\x12\xef|\x0b\x12\xea|\x0b\x12\xe5|\x0b\x12\xe0|\x0b\x12\xdb|\x0b\x12\xd6|\x0b\x12\xd1|\x0b\x12\xcc|\x0b\x12\xc7|\x0b\x12\xc2|\x0b\x12\xbd|\x0b\x12\xb8|\x0b\x12\xb3|\x0b\x12\xae|\x0b\x12\xa9|\x0b\x12\xa4|\x0b\x12\x9f|\x0b\x12\x9a|\x0b\x12\x95|\x0b\x12\x90|\x0b\x12\x8b|\x0b\x12\x86|\x0b\x12\x81|\x0b\x12\xfc{\x0b\x12\xf7{\x0b\x12\xf2{\x0b\x12\xed{\x0b\x12\xe8{\x0b\x12\xe3{\x0b\x12\xde{\x0b\x12\xd9{\x0b\x12\xd4{\x0b\x12\xcf{\x0b\x12\xca{\x0b\x12\xc5{\x0b\x12\xc0{\x0b\x12\xbb{\x0b\x12\xb6{\x0b\x12\xb1{\x0b\x12\xac{\x0b\x12\xa7{\x0b\x12\xa2{\x0b\x12\x9d{\x0b\x12\x98{\x0b\x12\x93{\x0b\x12\x8e{\x0b\x12\x89{\x0b\x12\x84{\x0b\x12\xffz\x0b\x12\xfaz\x0b\x12\xf5z\x0b\x12\xf0z\x0b\x12\xebz\x0b\x12\xe6z\x0b\x12\xe1z\x0b\x12\xdcz\x0b\x12\xd7z\x0b\x12\xd2z\x0b\x12\xcdz\x0b\x12\xc8z\x0b\x12\xc3z\x0b\x12\xbez\x0b\x12\xb9z\x0b\x12\xb4z\x0b\x12\xafz\x0b\x12\xaaz\x0b\x12\xa5z\x0b\x12\xa0z\x0b\x12\x9bz\x0b\x12\x96z\x0b\x12\x91z\x0b\x12\x8cz\x0b\x12\x87z\x0b\x12\x82z\x0b\x12\xfdy\x0b\x12\xf8y\x0b\x12\xf3y\x0b\x12\xeey\x0b\x12\xe9y\x0b\x12\xe4y\x0b\x12\xdfy\x0b\x12\xday\x0b\x12\xd5y\x0b\x12\xd0y\x0b\x12\xcby\x0b\x12\xc6y\x0b\x12\xc1y\x0b\x12\xbcy\x0b\x12\xb7y\x0b\x12\xb2y\x0b\x12\xady\x0b\x12\xa8y\x0b\x12\xa3y\x0b\x12\x9ey\x0b\x12\x99y\x0b\x12\x94y\x0b\x12\x8fy\x0b\x12\x8ay\x0b\x12\x85y\x0b\x12\x80y\x0b\x12\xfbx\x0b\x12\xf6x\x0b\x12\xf1x\x0b\x12\xecx\x0b\x12\xe7x\x0b\x12\xe2x\x0b\x12\xddx\x0b\x12\xd8x\x0b\x12\xd3x\x0b\x12\xcex\x0b\x12\xc9x\x0b\x12\xc4x\x0b\x12\xbfx\x0b\x12\xbax\x0b\x12\xb5x\x0b\x12\xb0x\x0b\x12\xabx\x0b\x12\xa6x\x0b\x12\xa1x\x0b\x12\x9cx\x0b\x12\x97x\x0b\x12\x92x\x0b\x12\x8dx\x0b\x12\x88x\x0b\x12\x83x\x0b\x12\xfew\x0b\x12\xf9w\x0b\x12\xf4w\x0b\x12\xefw\x0b\x12\xeaw\x0b\x12\xe5w\x0b\x12\xe0w\x0b\x12\xdbw\x0b\x12\xd6w\x0b\x12\xd1w\x0b\x12\xccw\x0b\x12\xc7w\x0b\x12\xc2w\x0b\x12\xbdw\x0b\x12\xb8w\x0b\x12\xb3w\x0b\x12\xaew\x0b\x12\xa9w\x0b\x12\xa4w\x0b\x12\x9fw\x0b\x12\x9aw\x0b\x12\x95w\x0b\x12\x90w\x0b\x12\x8bw\x0b\x12\x86w\x0b\x12\x81w\x0b\x12\xfcv\x0b\x12\xf7v\x0b\x12\xf2v\x0b\x12\xedv\x0b\x12\xe8v\x0b\x12\xe3v\x0b\x12\xdev\x0b\x12\xd9v\x0b\x12\xd4v\x0b\x12\xcfv\x0b\x12\xcav\x0b\x12\xc5v\x0b\x12\xc0v\x0b\x12\xbbv\x0b\x12\xb6v\x0b\x12\xb1v\x0b\x12\xacv\x0b\x12\xa7v\x0b\x12\xa2v\x0b\x12\x9dv\x0b\x12\x98v\x0b\x12\x93v\x0b\x12\x8ev\x0b\x12\x89v\x0b\x12\x84v\x0b\x12\xffu\x0b\x12\xfau\x0b\x12\xf5u\x0b\x12\xf0u\x0b\x12\xebu\x0b\x12\xe6u\x0b\x12\xe1u\x0b\x12\xdcu\x0b\x12\xd7u\x0b\x12\xd2u\x0b\x12\xcdu\x0b\x12\xc8u\x0b\x12\xc3u\x0b\x12\xbeu\x0b\x12\xb9u\x0b\x12\xb4u\x0b\x12\xafu\x0b\x12\xaau\x0b\x12\xa5u\x0b\x12\xa0u\x0b\x12\x9bu\x0b\x12\x96u\x0b\x12\x91u\x0b\x12\x8cu\x0b\x12\x87u\x0b\x12\x82u\x0b\x12\xfdt\x0b\x12\xf8t\x0b\x12\xf3t\x0b\x12\xeet\x0b\x12\xe9t\x0b\x12\xe4t\x0b\x12\xdft\x0b\x12\xdat\x0b\x12\xd5t\x0b\x12\xd0t\x0b\x12\xcbt\x0b\x12\xc6t\x0b\x12\xc1t\x0b\x12\xbct\x0b\x12\xb7t\x0b\x12\xb2t\x0b\x12\xadt\x0b\x12\xa8t\x0b\x12\xa3t\x0b\x12\x9et\x0b\x12\x99t\x0b\x12\x94t\x0b\x12\x8ft\x0b\x12\x8at\x0b\x12\x85t\x0b\x12\x80t\x0b\x12\xfbs\x0b\x12\xf6s\x0b\x12\xf1s\x0b\x12\xecs\x0b\x12\xe7s\x0b\x12\xe2s\x0b\x12\xdds\x0b\x12\xd8s\x0b\x12\xd3s\x0b\x12\xces\x0b\x12\xc9s\x0b\x12\xc4s\x0b\x12\xbfs\x0b\x12\xbas\x0b\x12\xb5s\x0b\x12\xb0s\x0b\x12\xabs\x0b\x12\xa6s\x0b\x12\xa1s\x0b\x12\x9cs\x0b\x12\x97s\x0b\x12\x92s\x0b\x12\x8ds\x0b\x12\x88s\x0b\x12\x83s\x0b\x12\xfer\x0b\x12\xf9r\x0b\x12\xf4r\x0b\x12\xefr\x0b\x12\xear\x0b\x12\xe5r\x0b\x12\xe0r\x0b\x12\xdbr\x0b\x12\xd6r\x0b\x12\xd1r\x0b\x12\xccr\x0b\x12\xc7r\x0b\x12\xc2r\x0b\x12\xbdr\x0b\x12\xb8r\x0b\x12\xb3r\x0b\x12\xaer\x0b\x12\xa9r\x0b\x12\xa4r\x0b\x12\x9fr\x0b\x12\x9ar\x0b\x12\x95r\x0b\x12\x90r\x0b\x12\x8br\x0b\x12\x86r\x0b\x12\x81r\x0b\x12\xfcq\x0b\x12\xf7q\x0b\x12\xf2q\x0b\x12\xedq\x0b\x12\xe8q\x0b\x12\xe3q\x0b\x12\xdeq\x0b\x12\xd9q\x0b\x12\xd4q\x0b\x12\xcfq\x0b\x12\xcaq\x0b\x12\xc5q\x0b\x12\xc0q\x0b\x12\xbbq\x0b\x12\xb6q\x0b\x12\xb1q\x0b\x12\xacq\x0b\x12\xa7q\x0b\x12\xa2q\x0b\x12\x9dq\x0b\x12\x98q\x0b\x12\x93q\x0b\x12\x8eq\x0b\x12\x89q\x0b\x12\x84q\x0b\x12\xffp\x0b\x12\xfap\x0b\x12\xf5p\x0b\x12\xf0p\x0b\x12\xebp\x0b\x12\xe6p\x0b\x12\xe1p\x0b\x12\xdcp\x0b\x12\xd7p\x0b\x12\xd2p\x0b\x12\xcdp\x0b\x12\xc8p\x0b\x12\xc3p\x0b\x12\xbep\x0b\x12\xb9p\x0b\x12\xb4p\x0b\x12\xafp\x0b\x12\xaap\x0b\x12\xa5p\x0b\x12\xa0p\x0b\x12\x9bp\x0b\x12\x96p\x0b\x12\x91p\x0b\x12\x8cp\x0b\x12\x87p\x0b\x12\x82p\x0b\x12\xfdo\x0b\x12\xf8o\x0b\x12\xf3o\x0b\x12\xeeo\x0b\x12\xe9o\x0b\x12\xe4o\x0b\x12\xdfo\x0b\x12\xdao\x0b\x12\xd5o\x0b\x12\xd0o\x0b\x12\xcbo\x0b\x12\xc6o\x0b\x12\xc1o\x0b\x12\xbco\x0b\x12\xb7o\x0b\x12\xb2o\x0b\x12\xado\x0b\x12\xa8o\x0b\x12\xa3o\x0b\x12\x9eo\x0b\x12\x99o\x0b\x12\x94o\x0b\x12\x8fo\x0b\x12\x8ao\x0b\x12\x85o\x0b\x12\x80o\x0b\x12\xfbn\x0b\x12\xf6n\x0b\x12\xf1n\x0b\x12\xecn\x0b\x12\xe7n\x0b\x12\xe2n\x0b\x12\xddn\x0b\x12\xd8n\x0b\x12\xd3n\x0b\x12\xcen\x0b\x12\xc9n\x0b\x12\xc4n\x0b\x12\xbfn\x0b\x12\xban\x0b\x12\xb5n\x0b\x12\xb0n\x0b\x12\xabn\x0b\x12\xa6n\x0b\x12\xa1n\x0b\x12\x9cn\x0b\x12\x97n\x0b\x12\x92n\x0b\x12\x8dn\x0b\x12\x88n\x0b\x12\x83n\x0b\x12\xfem\x0b\x12\xf9m\x0b\x12\xf4m\x0b\x12\xefm\x0b\x12\xeam\x0b\x12\xe5m\x0b\x12\xe0m\x0b\x12\xdbm\x0b\x12\xd6m\x0b\x12\xd1m\x0b\x12\xccm\x0b\x12\xc7m\x0b\x12\xc2m\x0b\x12\xbdm\x0b\x12\xb8m\x0b\x12\xb3m\x0b\x12\xaem\x0b\x12\xa9m\x0b\x12\xa4m\x0b\x12\x9fm\x0b\x12\x9am\x0b\x12\x95m\x0b\x12\x90m\x0b\x12\x8bm\x0b\x12\x86m\x0b\x12\x81m\x0b\x12\xfcl\x0b\x12\xf7l\x0b\x12\xf2l\x0b\x12\xedl\x0b\x12\xe8l\x0b\x12\xe3l\x0b\x12\xdel\x0b\x12\xd9l\x0b\x12\xd4l\x0b\x12\xcfl\x0b\x12\xcal\x0b\x12\xc5l\x0b\x12\xc0l\x0b\x12\xbbl\x0b\x12\xb6l\x0b\x12\xb1l\x0b\x12\xacl\x0b\x12\xa7l\x0b\x12\xa2l\x0b\x12\x9dl\x0b\x12\x98l\x0b\x12\x93l\x0b\x12\x8el\x0b\x12\x89l\x0b\x12\x84l\x0b\x12\xffk\x0b\x12\xfak\x0b\x12\xf5k\x0b\x12\xf0k\x0b\x12\xebk\x0b\x12\xe6k\x0b\x12\xe1k\x0b\x12\xdck\x0b\x12\xd7k\x0b\x12\xd2k\x0b\x12\xcdk\x0b\x12\xc8k\x0b\x12\xc3k\x0b\x12\xbek\x0b\x12\xb9k\x0b\x12\xb4k\x0b\x12\xafk\x0b\x12\xaak\x0b\x12\xa5k\x0b\x12\xa0k\x0b\x12\x9bk\x0b\x12\x96k\x0b\x12\x91k\x0b\x12\x8ck\x0b\x12\x87k\x0b\x12\x82k\x0b\x12\xfdj\x0b\x12\xf8j\x0b\x12\xf3j\x0b\x12\xeej\x0b\x12\xe9j\x0b\x12\xe4j\x0b\x12\xdfj\x0b\x12\xdaj\x0b\x12\xd5j\x0b\x12\xd0j\x0b\x12\xcbj\x0b\x12\xc6j\x0b\x12\xc1j\x0b\x12\xbcj\x0b\x12\xb7j\x0b\x12\xb2j\x0b\x12\xadj\x0b\x12\xa8j\x0b\x12\xa3j\x0b\x12\x9ej\x0b\x12\x99j\x0b\x12\x94j\x0b\x12\x8fj\x0b\x12\x8aj\x0b\x12\x85j\x0b\x12\x80j\x0b\x12\xfbi\x0b\x12\xf6i\x0b\x12\xf1i\x0b\x12\xeci\x0b\x12\xe7i\x0b\x12\xe2i\x0b\x12\xddi\x0b\x12\xd8i\x0b\x12\xd3i\x0b\x12\xcei\x0b\x12\xc9i\x0b\x12\xc4i\x0b\x12\xbfi\x0b\x12\xbai\x0b\x12\xb5i\x0b\x12\xb0i\x0b\x12\xabi\x0b\x12\xa6i\x0b\x12\xa1i\x0b\x12\x9ci\x0b\x12\x97i\x0b\x12\x92i\x0b\x12\x8di\x0b\x12\x88i\x0b\x12\x83i\x0b\x12\xfeh\x0b\x12\xf9h\x0b\x12\xf4h\x0b\x12\xefh\x0b\x12\xeah\x0b\x12\xe5h\x0b\x12\xe0h\x0b\x12\xdbh\x0b\x12\xd6h\x0b\x12\xd1h\x0b\x12\xcch\x0b\x12\xc7h\x0b\x12\xc2h\x0b\x12\xbdh\x0b\x12\xb8h\x0b\x12\xb3h\x0b\x12\xaeh\x0b\x12\xa9h\x0b\x12\xa4h\x0b\x12\x9fh\x0b\x12\x9ah\x0b\x12\x95h\x0b\x12\x90h\x0b\x12\x8bh\x0b\x12\x86h\x0b\x12\x81h\x0b\x12\xfcg\x0b\x12\xf7g\x0b\x12\xf2g\x0b\x12\xedg\x0b\x12\xe8g\x0b\x12\xe3g\x0b\x12\xdeg\x0b\x12\xd9g\x0b\x12\xd4g\x0b\x12\xcfg\x0b\x12\xcag\x0b\x12\xc5g\x0b\x12\xc0g\x0b\x12\xbbg\x0b\x12\xb6g\x0b\x12\xb1g\x0b\x12\xacg\x0b\x12\xa7g\x0b\x12\xa2g\x0b\x12\x9dg\x0b\x12\x98g\x0b\x12\x93g\x0b\x12\x8eg\x0b\x12\x89g\x0b\x12\x84g\x0b\x12\xfff\x0b\x12\xfaf\x0b\x12\xf5f\x0b\x12\xf0f\x0b\x12\xebf\x0b\x12\xe6f\x0b\x12\xe1f\x0b\x12\xdcf\x0b\x12\xd7f\x0b\x12\xd2f\x0b\x12\xcdf\x0b\x12\xc8f\x0b\x12\xc3f\x0b\x12\xbef\x0b\x12\xb9f\x0b\x12\xb4f\x0b\x12\xaff\x0b\x12\xaaf\x0b\x12\xa5f\x0b\x12\xa0f\x0b\x12\x9bf\x0b\x12\x96f\x0b\x12\x91f\x0b\x12\x8cf\x0b\x12\x87f\x0b\x12\x82f\x0b\x12\xfde\x0b\x12\xf8e\x0b\x12\xf3e\x0b\x12\xeee\x0b\x12\xe9e\x0b\x12\xe4e\x0b\x12\xdfe\x0b\x12\xdae\x0b\x12\xd5e\x0b\x12\xd0e\x0b\x12\xcbe\x0b\x12\xc6e\x0b\x12\xc1e\x0b\x12\xbce\x0b\x12\xb7e\x0b\x12\xb2e\x0b\x12\xade\x0b\x12\xa8e\x0b\x12\xa3e\x0b\x12\x9ee\x0b\x12\x99e\x0b\x12\x94e\x0b\x12\x8fe\x0b\x12\x8ae\x0b\x12\x85e\x0b\x12\x80e\x0b\x12\xfbd\x0b\x12\xf6d\x0b\x12\xf1d\x0b\x12\xecd\x0b\x12\xe7d\x0b\x12\xe2d\x0b\x12\xddd\x0b\x12\xd8d\x0b\x12\xd3d\x0b\x12\xced\x0b\x12\xc9d\x0b\x12\xc4d\x0b\x12\xbfd\x0b\x12\xbad\x0b\x12\xb5d\x0b\x12\xb0d\x0b\x12\xabd\x0b\x12\xa6d\x0b\x12\xa1d\x0b\x12\x9cd\x0b\x12\x97d\x0b\x12\x92d\x0b\x12\x8dd\x0b\x12\x88d\x0b\x12\x83d\x0b\x12\xfec\x0b\x12\xf9c\x0b\x12\xf4c\x0b\x12\xefc\x0b\x12\xeac\x0b\x12\xe5c\x0b\x12\xe0c\x0b\x12\xdbc\x0b\x12\xd6c\x0b\x12\xd1c\x0b\x12\xccc\x0b\x12\xc7c\x0b\x12\xc2c\x0b\x12\xbdc\x0b\x12\xb8c\x0b\x12\xb3c\x0b\x12\xaec\x0b\x12\xa9c\x0b\x12\xa4c\x0b\x12\x9fc\x0b\x12\x9ac\x0b\x12\x95c\x0b\x12\x90c\x0b\x12\x8bc\x0b\x12\x86c\x0b\x12\x81c\x0b\x12\xfcb\x0b\x12\xf7b\x0b\x12\xf2b\x0b\x12\xedb\x0b\x12\xe8b\x0b\x12\xe3b\x0b\x12\xdeb\x0b\x12\xd9b\x0b\x12\xd4b\x0b\x12\xcfb\x0b\x12\xcab\x0b\x12\xc5b\x0b\x12\xc0b\x0b\x12\xbbb\x0b\x12\xb6b\x0b\x12\xb1b\x0b\x12\xacb\x0b\x12\xa7b\x0b\x12\xa2b\x0b\x12\x9db\x0b\x12\x98b\x0b\x12\x93b\x0b\x12\x8eb\x0b\x12\x89b\x0b\x12\x84b\x0b\x12\xffa\x0b\x12\xfaa\x0b\x12\xf5a\x0b\x12\xf0a\x0b\x12\xeba\x0b\x12\xe6a\x0b\x12\xe1a\x0b\x12\xdca\x0b\x12\xd7a\x0b\x12\xd2a\x0b\x12\xcda\x0b\x12\xc8a\x0b\x12\xc3a\x0b\x12\xbea\x0b\x12\xb9a\x0b\x12\xb4a\x0b\x12\xafa\x0b\x12\xaaa\x0b\x12\xa5a\x0b\x12\xa0a\x0b\x12\x9ba\x0b\x12\x96a\x0b\x12\x91a\x0b\x12\x8ca\x0b\x12\x87a\x0b\x12\x82a\x0b\x12\xfd`\x0b\x12\xf8`\x0b\x12\xf3`\x0b\x12\xee`\x0b\x12\xe9`\x0b\x12\xe4`\x0b\x12\xdf`\x0b\x12\xda`\x0b\x12\xd5`\x0b\x12\xd0`\x0b\x12\xcb`\x0b\x12\xc6`\x0b\x12\xc1`\x0b\x12\xbc`\x0b\x12\xb7`\x0b\x12\xb2`\x0b\x12\xad`\x0b\x12\xa8`\x0b\x12\xa3`\x0b\x12\x9e`\x0b\x12\x99`\x0b\x12\x94`\x0b\x12\x8f`\x0b\x12\x8a`\x0b\x12\x85`\x0b\x12\x80`\x0b\x12\xfb_\x0b\x12\xf6_\x0b\x12\xf1_\x0b\x12\xec_\x0b\x12\xe7_\x0b\x12\xe2_\x0b\x12\xdd_\x0b\x12\xd8_\x0b\x12\xd3_\x0b\x12\xce_\x0b\x12\xc9_\x0b\x12\xc4_\x0b\x12\xbf_\x0b\x12\xba_\x0b\x12\xb5_\x0b\x12\xb0_\x0b\x12\xab_\x0b\x12\xa6_\x0b\x12\xa1_\x0b\x12\x9c_\x0b\x12\x97_\x0b\x12\x92_\x0b\x12\x8d_\x0b\x12\x88_\x0b\x12\x83_\x0b\x12\xfe^\x0b\x12\xf9^\x0b\x12\xf4^\x0b\x12\xef^\x0b\x12\xea^\x0b\x12\xe5^\x0b\x12\xe0^\x0b\x12\xdb^\x0b\x12\xd6^\x0b\x12\xd1^\x0b\x12\xcc^\x0b\x12\xc7^\x0b\x12\xc2^\x0b\x12\xbd^\x0b\x12\xb8^\x0b\x12\xb3^\x0b\x12\xae^\x0b\x12\xa9^\x0b\x12\xa4^\x0b\x12\x9f^\x0b\x12\x9a^\x0b\x12\x95^\x0b\x12\x90^\x0b\x12\x8b^\x0b\x12\x86^\x0b\x12\x81^\x0b\x12\xfc]\x0b\x12\xf7]\x0b\x12\xf2]\x0b\x12\xed]\x0b\x12\xe8]\x0b\x12\xe3]\x0b\x12\xde]\x0b\x12\xd9]\x0b\x12\xd4]\x0b\x12\xcf]\x0b\x12\xca]\x0b\x12\xc5]\x0b\x12\xc0]\x0b\x12\xbb]\x0b\x12\xb6]\x0b\x12\xb1]\x0b\x12\xac]\x0b\x12\xa7]\x0b\x12\xa2]\x0b\x12\x9d]\x0b\x12\x98]\x0b\x12\x93]\x0b\x12\x8e]\x0b\x12\x89]\x0b\x12\x84]\x0b\x12\xff\\\x0b\x12\xfa\\\x0b\x12\xf5\\\x0b\x12\xf0\\\x0b\x12\xeb\\\x0b\x12\xe6\\\x0b\x12\xe1\\\x0b\x12\xdc\\\x0b\x12\xd7\\\x0b\x12\xd2\\\x0b\x12\xcd\\\x0b\x12\xc8\\\x0b\x12\xc3\\\x0b\x12\xbe\\\x0b\x12\xb9\\\x0b\x12\xb4\\\x0b\x12\xaf\\\x0b\x12\xaa\\\x0b\x12\xa5\\\x0b\x12\xa0\\\x0b\x12\x9b\\\x0b\x12\x96\\\x0b\x12\x91\\\x0b\x12\x8c\\\x0b\x12\x87\\\x0b\x12\x82\\\x0b\x12\xfd[\x0b\x12\xf8[\x0b\x12\xf3[\x0b\x12\xee[\x0b\x12\xe9[\x0b\x12\xe4[\x0b\x12\xdf[\x0b\x12\xda[\x0b\x12\xd5[\x0b\x12\xd0[\x0b\x12\xcb[\x0b\x12\xc6[\x0b\x12\xc1[\x0b\x12\xbc[\x0b\x12\xb7[\x0b\x12\xb2[\x0b\x12\xad[\x0b\x12\xa8[\x0b\x12\xa3[\x0b\x12\x9e[\x0b\x12\x99[\x0b\x12\x94[\x0b\x12\x8f[\x0b\x12\x8a[\x0b\x12\x85[\x0b\x12\x80[\x0b\x12\xfbZ\x0b\x12\xf6Z\x0b\x12\xf1Z\x0b\x12\xecZ\x0b\x12\xe7Z\x0b\x12\xe2Z\x0b\x12\xddZ\x0b\x12\xd8Z\x0b\x12\xd3Z\x0b\x12\xceZ\x0b\x12\xc9Z\x0b\x12\xc4Z\x0b\x12\xbfZ\x0b\x12\xbaZ\x0b\x12\xb5Z\x0b\x12\xb0Z\x0b\x12\xabZ\x0b\x12\xa6Z\x0b\x12\xa1Z\x0b\x12\x9cZ\x0b\x12\x97Z\x0b\x12\x92Z\x0b\x12\x8dZ\x0b\x12\x88Z\x0b\x12\x83Z\x0b\x12\xfeY\x0b\x12\xf9Y\x0b\x12\xf4Y\x0b\x12\xefY\x0b\x12\xeaY\x0b\x12\xe5Y\x0b\x12\xe0Y\x0b\x12\xdbY\x0b\x12\xd6Y\x0b\x12\xd1Y\x0b\x12\xccY\x0b\x12\xc7Y\x0b\x12\xc2Y\x0b\x12\xbdY\x0b\x12\xb8Y\x0b\x12\xb3Y\x0b\x12\xaeY\x0b\x12\xa9Y\x0b\x12\xa4Y\x0b\x12\x9fY\x0b\x12\x9aY\x0b\x12\x95Y\x0b\x12\x90Y\x0b\x12\x8bY\x0b\x12\x86Y\x0b\x12\x81Y\x0b\x12\xfcX\x0b\x12\xf7X\x0b\x12\xf2X\x0b\x12\xedX\x0b\x12\xe8X\x0b\x12\xe3X\x0b\x12\xdeX\x0b\x12\xd9X\x0b\x12\xd4X\x0b\x12\xcfX\x0b\x12\xcaX\x0b\x12\xc5X\x0b\x12\xc0X\x0b\x12\xbbX\x0b\x12\xb6X\x0b\x12\xb1X\x0b\x12\xacX\x0b\x12\xa7X\x0b\x12\xa2X\x0b\x12\x9dX\x0b\x12\x98X\x0b\x12\x93X\x0b\x12\x8eX\x0b\x12\x89X\x0b\x12\x84X\x0b\x12\xffW\x0b\x12\xfaW\x0b\x12\xf5W\x0b\x12\xf0W\x0b\x12\xebW\x0b\x12\xe6W\x0b\x12\xe1W\x0b\x12\xdcW\x0b\x12\xd7W\x0b\x12\xd2W\x0b\x12\xcdW\x0b\x12\xc8W\x0b\x12\xc3W\x0b\x12\xbeW\x0b\x12\xb9W\x0b\x12\xb4W\x0b\x12\xafW\x0b\x12\xaaW\x0b\x12\xa5W\x0b\x12\xa0W\x0b\x12\x9bW\x0b\x12\x96W\x0b\x12\x91W\x0b\x12\x8cW\x0b\x12\x87W\x0b\x12\x82W\x0b\x12\xfdV\x0b\x12\xf8V\x0b\x12\xf3V\x0b\x12\xeeV\x0b\x12\xe9V\x0b\x12\xe4V\x0b\x12\xdfV\x0b\x12\xdaV\x0b\x12\xd5V\x0b\x12\xd0V\x0b\x12\xcbV\x0b\x12\xc6V\x0b\x12\xc1V\x0b\x12\xbcV\x0b\x12\xb7V\x0b\x12\xb2V\x0b\x12\xadV\x0b\x12\xa8V\x0b\x12\xa3V\x0b\x12\x9eV\x0b\x12\x99V\x0b\x12\x94V\x0b\x12\x8fV\x0b\x12\x8aV\x0b\x12\x85V\x0b\x12\x80V\x0b\x12\xfbU\x0b\x12\xf6U\x0b\x12\xf1U\x0b\x12\xecU\x0b\x12\xe7U\x0b\x12\xe2U\x0b\x12\xddU\x0b\x12\xd8U\x0b\x12\xd3U\x0b\x12\xceU\x0b\x12\xc9U\x0b\x12\xc4U\x0b\x12\xbfU\x0b\x12\xbaU\x0b\x12\xb5U\x0b\x12\xb0U\x0b\x12\xabU\x0b\x12\xa6U\x0b\x12\xa1U\x0b\x12\x9cU\x0b\x12\x97U\x0b\x12\x92U\x0b\x12\x8dU\x0b\x12\x88U\x0b\x12\x83U\x0b\x12\xfeT\x0b\x12\xf9T\x0b\x12\xf4T\x0b\x12\xefT\x0b\x12\xeaT\x0b\x12\xe5T\x0b\x12\xe0T\x0b\x12\xdbT\x0b\x12\xd6T\x0b\x12\xd1T\x0b\x12\xccT\x0b\x12\xc7T\x0b\x12\xc2T\x0b\x12\xbdT\x0b\x12\xb8T\x0b\x12\xb3T\x0b\x12\xaeT\x0b\x12\xa9T\x0b\x12\xa4T\x0b\x12\x9fT\x0b\x12\x9aT\x0b\x12\x95T\x0b\x12\x90T\x0b\x12\x8bT\x0b\x12\x86T\x0b\x12\x81T\x0b\x12\xfcS\x0b\x12\xf7S\x0b\x12\xf2S\x0b\x12\xedS\x0b\x12\xe8S\x0b\x12\xe3S\x0b\x12\xdeS\x0b\x12\xd9S\x0b\x12\xd4S\x0b\x12\xcfS\x0b\x12\xcaS\x0b\x12\xc5S\x0b\x12\xc0S\x0b\x12\xbbS\x0b\x12\xb6S\x0b\x12\xb1S\x0b\x12\xacS\x0b\x12\xa7S\x0b\x12\xa2S\x0b\x12\x9dS\x0b\x12\x98S\x0b\x12\x93S\x0b\x12\x8eS\x0b\x12\x89S\x0b\x12\x84S\x0b\x12\xffR\x0b\x12\xfaR\x0b\x12\xf5R\x0b\x12\xf0R\x0b\x12\xebR\x0b\x12\xe6R\x0b\x12\xe1R\x0b\x12\xdcR\x0b\x12\xd7R\x0b\x12\xd2R\x0b\x12\xcdR\x0b\x12\xc8R\x0b\x12\xc3R\x0b\x12\xbeR\x0b\x12\xb9R\x0b\x12\xb4R\x0b\x12\xafR\x0b\x12\xaaR\x0b\x12\xa5R\x0b\x12\xa0R\x0b\x12\x9bR\x0b\x12\x96R\x0b\x12\x91R\x0b\x12\x8cR\x0b\x12\x87R\x0b\x12\x82R\x0b\x12\xfdQ\x0b\x12\xf8Q\x0b\x12\xf3Q\x0b\x12\xeeQ\x0b\x12\xe9Q\x0b\x12\xe4Q\x0b\x12\xdfQ\x0b\x12\xdaQ\x0b\x12\xd5Q\x0b\x12\xd0Q\x0b\x12\xcbQ\x0b\x12\xc6Q\x0b\x12\xc1Q\x0b\x12\xbcQ\x0b\x12\xb7Q\x0b\x12\xb2Q\x0b\x12\xadQ\x0b\x12\xa8Q\x0b\x12\xa3Q\x0b\x12\x9eQ\x0b\x12\x99Q\x0b\x12\x94Q\x0b\x12\x8fQ\x0b\x12\x8aQ\x0b\x12\x85Q\x0b\x12\x80Q\x0b\x12\xfbP\x0b\x12\xf6P\x0b\x12\xf1P\x0b\x12\xecP\x0b\x12\xe7P\x0b\x12\xe2P\x0b\x12\xddP\x0b\x12\xd8P\x0b\x12\xd3P\x0b\x12\xceP\x0b\x12\xc9P\x0b\x12\xc4P\x0b\x12\xbfP\x0b\x12\xbaP\x0b\x12\xb5P\x0b\x12\xb0P\x0b\x12\xabP\x0b\x12\xa6P\x0b\x12\xa1P\x0b\x12\x9cP\x0b\x12\x97P\x0b\x12\x92P\x0b\x12\x8dP\x0b\x12\x88P\x0b\x12\x83P\x0b\x12\xfeO\x0b\x12\xf9O\x0b\x12\xf4O\x0b\x12\xefO\x0b\x12\xeaO\x0b\x12\xe5O\x0b\x12\xe0O\x0b\x12\xdbO\x0b\x12\xd6O\x0b\x12\xd1O\x0b\x12\xccO\x0b\x12\xc7O\x0b\x12\xc2O\x0b\x12\xbdO\x0b\x12\xb8O\x0b\x12\xb3O\x0b\x12\xaeO\x0b\x12\xa9O\x0b\x12\xa4O\x0b\x12\x9fO\x0b\x12\x9aO\x0b\x12\x95O\x0b\x12\x90O\x0b\x12\x8bO\x0b\x12\x86O\x0b\x12\x81O\x0b\x12\xfcN\x0b\x12\xf7N\x0b\x12\xf2N\x0b\x12\xedN\x0b\x12\xe8N\x0b\x12\xe3N\x0b\x12\xdeN\x0b\x12\xd9N\x0b\x12\xd4N\x0b\x12\xcfN\x0b\x12\xcaN\x0b\x12\xc5N\x0b\x12\xc0N\x0b\x12\xbbN\x0b\x12\xb6N\x0b\x12\xb1N\x0b\x12\xacN\x0b\x12\xa7N\x0b\x12\xa2N\x0b\x12\x9dN\x0b\x12\x98N\x0b\x12\x93N\x0b\x12\x8eN\x0b\x12\x89N\x0b\x12\x84N\x0b\x12\xffM\x0b\x12\xfaM\x0b\x12\xf5M\x0b\x12\xf0M\x0b\x12\xebM\x0b\x12\xe6M\x0b\x12\xe1M\x0b\x12\xdcM\x0b\x12\xd7M\x0b\x12\xd2M\x0b\x12\xcdM\x0b\x12\xc8M\x0b\x12\xc3M\x0b\x12\xbeM\x0b\x12\xb9M\x0b\x12\xb4M\x0b\x12\xafM\x0b\x12\xaaM\x0b\x12\xa5M\x0b\x12\xa0M\x0b\x12\x9bM\x0b\x12\x96M\x0b\x12\x91M\x0b\x12\x8cM\x0b\x12\x87M\x0b\x12\x82M\x0b\x12\xfdL\x0b\x12\xf8L\x0b\x12\xf3L\x0b\x12\xeeL\x0b\x12\xe9L\x0b\x12\xe4L\x0b\x12\xdfL\x0b\x12\xdaL\x0b\x12\xd5L\x0b\x12\xd0L\x0b\x12\xcbL\x0b\x12\xc6L\x0b\x12\xc1L\x0b\x12\xbcL\x0b\x12\xb7L\x0b\x12\xb2L\x0b\x12\xadL\x0b\x12\xa8L\x0b\x12\xa3L\x0b\x12\x9eL\x0b\x12\x99L\x0b\x12\x94L\x0b\x12\x8fL\x0b\x12\x8aL\x0b\x12\x85L\x0b\x12\x80L\x0b\x12\xfbK\x0b\x12\xf6K\x0b\x12\xf1K\x0b\x12\xecK\x0b\x12\xe7K\x0b\x12\xe2K\x0b\x12\xddK\x0b\x12\xd8K\x0b\x12\xd3K\x0b\x12\xceK\x0b\x12\xc9K\x0b\x12\xc4K\x0b\x12\xbfK\x0b\x12\xbaK\x0b\x12\xb5K\x0b\x12\xb0K\x0b\x12\xabK\x0b\x12\xa6K\x0b\x12\xa1K\x0b\x12\x9cK\x0b\x12\x97K\x0b\x12\x92K\x0b\x12\x8dK\x0b\x12\x88K\x0b\x12\x83K\x0b\x12\xfeJ\x0b\x12\xf9J\x0b\x12\xf4J\x0b\x12\xefJ\x0b\x12\xeaJ\x0b\x12\xe5J\x0b\x12\xe0J\x0b\x12\xdbJ\x0b\x12\xd6J\x0b\x12\xd1J\x0b\x12\xccJ\x0b\x12\xc7J\x0b\x12\xc2J\x0b\x12\xbdJ\x0b\x12\xb8J\x0b\x12\xb3J\x0b\x12\xaeJ\x0b\x12\xa9J\x0b\x12\xa4J\x0b\x12\x9fJ\x0b\x12\x9aJ\x0b\x12\x95J\x0b\x12\x90J\x0b\x12\x8bJ\x0b\x12\x86J\x0b\x12\x81J\x0b\x12\xfcI\x0b\x12\xf7I\x0b\x12\xf2I\x0b\x12\xedI\x0b\x12\xe8I\x0b\x12\xe3I\x0b\x12\xdeI\x0b\x12\xd9I\x0b\x12\xd4I\x0b\x12\xcfI\x0b\x12\xcaI\x0b\x12\xc5I\x0b\x12\xc0I\x0b\x12\xbbI\x0b\x12\xb6I\x0b\x12\xb1I\x0b\x12\xacI\x0b\x12\xa7I\x0b\x12\xa2I\x0b\x12\x9dI\x0b\x12\x98I\x0b\x12\x93I\x0b\x12\x8eI\x0b\x12\x89I\x0b\x12\x84I\x0b\x12\xffH\x0b\x12\xfaH\x0b\x12\xf5H\x0b\x12\xf0H\x0b\x12\xebH\x0b\x12\xe6H\x0b\x12\xe1H\x0b\x12\xdcH\x0b\x12\xd7H\x0b\x12\xd2H\x0b\x12\xcdH\x0b\x12\xc8H\x0b\x12\xc3H\x0b\x12\xbeH\x0b\x12\xb9H\x0b\x12\xb4H\x0b\x12\xafH\x0b\x12\xaaH\x0b\x12\xa5H\x0b\x12\xa0H\x0b\x12\x9bH\x0b\x12\x96H\x0b\x12\x91H\x0b\x12\x8cH\x0b\x12\x87H\x0b\x12\x82H\x0b\x12\xfdG\x0b\x12\xf8G\x0b\x12\xf3G\x0b\x12\xeeG\x0b\x12\xe9G\x0b\x12\xe4G\x0b\x12\xdfG\x0b\x12\xdaG\x0b\x12\xd5G\x0b\x12\xd0G\x0b\x12\xcbG\x0b\x12\xc6G\x0b\x12\xc1G\x0b\x12\xbcG\x0b\x12\xb7G\x0b\x12\xb2G\x0b\x12\xadG\x0b\x12\xa8G\x0b\x12\xa3G\x0b\x12\x9eG\x0b\x12\x99G\x0b\x12\x94G\x0b\x12\x8fG\x0b\x12\x8aG\x0b\x12\x85G\x0b\x12\x80G\x0b\x12\xfbF\x0b\x12\xf6F\x0b\x12\xf1F\x0b\x12\xecF\x0b\x12\xe7F\x0b\x12\xe2F\x0b\x12\xddF\x0b\x12\xd8F\x0b\x12\xd3F\x0b\x12\xceF\x0b\x12\xc9F\x0b\x12\xc4F\x0b\x12\xbfF\x0b\x12\xbaF\x0b\x12\xb5F\x0b\x12\xb0F\x0b\x12\xabF\x0b\x12\xa6F\x0b\x12\xa1F\x0b\x12\x9cF\x0b\x12\x97F\x0b\x12\x92F\x0b\x12\x8dF\x0b\x12\x88F\x0b\x12\x83F\x0b\x12\xfeE\x0b\x12\xf9E\x0b\x12\xf4E\x0b\x12\xefE\x0b\x12\xeaE\x0b\x12\xe5E\x0b\x12\xe0E\x0b\x12\xdbE\x0b\x12\xd6E\x0b\x12\xd1E\x0b\x12\xccE\x0b\x12\xc7E\x0b\x12\xc2E\x0b\x12\xbdE\x0b\x12\xb8E\x0b\x12\xb3E\x0b\x12\xaeE\x0b\x12\xa9E\x0b\x12\xa4E\x0b\x12\x9fE\x0b\x12\x9aE\x0b\x12\x95E\x0b\x12\x90E\x0b\x12\x8bE\x0b\x12\x86E\x0b\x12\x81E\x0b\x12\xfcD\x0b\x12\xf7D\x0b\x12\xf2D\x0b\x12\xedD\x0b\x12\xe8D\x0b\x12\xe3D\x0b\x12\xdeD\x0b\x12\xd9D\x0b\x12\xd4D\x0b\x12\xcfD\x0b\x12\xcaD\x0b\x12\xc5D\x0b\x12\xc0D\x0b\x12\xbbD\x0b\x12\xb6D\x0b\x12\xb1D\x0b\x12\xacD\x0b\x12\xa7D\x0b\x12\xa2D\x0b\x12\x9dD\x0b\x12\x98D\x0b\x12\x93D\x0b\x12\x8eD\x0b\x12\x89D\x0b\x12\x84D\x0b\x12\xffC\x0b\x12\xfaC\x0b\x12\xf5C\x0b\x12\xf0C\x0b\x12\xebC\x0b\x12\xe6C\x0b\x12\xe1C\x0b\x12\xdcC\x0b\x12\xd7C\x0b\x12\xd2C\x0b\x12\xcdC\x0b\x12\xc8C\x0b\x12\xc3C\x0b\x12\xbeC\x0b\x12\xb9C\x0b\x12\xb4C\x0b\x12\xafC\x0b\x12\xaaC\x0b\x12\xa5C\x0b\x12\xa0C\x0b\x12\x9bC\x0b\x12\x96C\x0b\x12\x91C\x0b\x12\x8cC\x0b\x12\x87C\x0b\x12\x82C\x0b\x12\xfdB\x0b\x12\xf8B\x0b\x12\xf3B\x0b\x12\xeeB\x0b\x12\xe9B\x0b\x12\xe4B\x0b\x12\xdfB\x0b\x12\xdaB\x0b\x12\xd5B\x0b\x12\xd0B\x0b\x12\xcbB\x0b\x12\xc6B\x0b\x12\xc1B\x0b\x12\xbcB\x0b\x12\xb7B\x0b\x12\xb2B\x0b\x12\xadB\x0b\x12\xa8B\x0b\x12\xa3B\x0b\x12\x9eB\x0b\x12\x99B\x0b\x12\x94B\x0b\x12\x8fB\x0b\x12\x8aB\x0b\x12\x85B\x0b\x12\x80B\x0b\x12\xfbA\x0b\x12\xf6A\x0b\x12\xf1A\x0b\x12\xecA\x0b\x12\xe7A\x0b\x12\xe2A\x0b\x12\xddA\x0b\x12\xd8A\x0b\x12\xd3A\x0b\x12\xceA\x0b\x12\xc9A\x0b\x12\xc4A\x0b\x12\xbfA\x0b\x12\xbaA\x0b\x12\xb5A\x0b\x12\xb0A\x0b\x12\xabA\x0b\x12\xa6A\x0b\x12\xa1A\x0b\x12\x9cA\x0b\x12\x97A\x0b\x12\x92A\x0b\x12\x8dA\x0b\x12\x88A\x0b\x12\x83A\x0b\x12\xfe@\x0b\x12\xf9@\x0b\x12\xf4@\x0b\x12\xef@\x0b\x12\xea@\x0b\x12\xe5@\x0b\x12\xe0@\x0b\x12\xdb@\x0b\x12\xd6@\x0b\x12\xd1@\x0b\x12\xcc@\x0b\x12\xc7@\x0b\x12\xc2@\x0b\x12\xbd@\x0b\x12\xb8@\x0b\x12\xb3@\x0b\x12\xae@\x0b\x12\xa9@\x0b\x12\xa4@\x0b\x12\x9f@\x0b\x12\x9a@\x0b\x12\x95@\x0b\x12\x90@\x0b\x12\x8b@\x0b\x12\x86@\x0b\x12\x81@\x0b\x12\xfc?\x0b\x12\xf7?\x0b\x12\xf2?\x0b\x12\xed?\x0b\x12\xe8?\x0b\x12\xe3?\x0b\x12\xde?\x0b\x12\xd9?\x0b\x12\xd4?\x0b\x12\xcf?\x0b\x12\xca?\x0b\x12\xc5?\x0b\x12\xc0?\x0b\x12\xbb?\x0b\x12\xb6?\x0b\x12\xb1?\x0b\x12\xac?\x0b\x12\xa7?\x0b\x12\xa2?\x0b\x12\x9d?\x0b\x12\x98?\x0b\x12\x93?\x0b\x12\x8e?\x0b\x12\x89?\x0b\x12\x84?\x0b\x12\xff>\x0b\x12\xfa>\x0b\x12\xf5>\x0b\x12\xf0>\x0b\x12\xeb>\x0b\x12\xe6>\x0b\x12\xe1>\x0b\x12\xdc>\x0b\x12\xd7>\x0b\x12\xd2>\x0b\x12\xcd>\x0b\x12\xc8>\x0b\x12\xc3>\x0b\x12\xbe>\x0b\x12\xb9>\x0b\x12\xb4>\x0b\x12\xaf>\x0b\x12\xaa>\x0b\x12\xa5>\x0b\x12\xa0>\x0b\x12\x9b>\x0b\x12\x96>\x0b\x12\x91>\x0b\x12\x8c>\x0b\x12\x87>\x0b\x12\x82>\x0b\x12\xfd=\x0b\x12\xf8=\x0b\x12\xf3=\x0b\x12\xee=\x0b\x12\xe9=\x0b\x12\xe4=\x0b\x12\xdf=\x0b\x12\xda=\x0b\x12\xd5=\x0b\x12\xd0=\x0b\x12\xcb=\x0b\x12\xc6=\x0b\x12\xc1=\x0b\x12\xbc=\x0b\x12\xb7=\x0b\x12\xb2=\x0b\x12\xad=\x0b\x12\xa8=\x0b\x12\xa3=\x0b\x12\x9e=\x0b\x12\x99=\x0b\x12\x94=\x0b\x12\x8f=\x0b\x12\x8a=\x0b\x12\x85=\x0b\x12\x80=\x0b\x12\xfb<\x0b\x12\xf6<\x0b\x12\xf1<\x0b\x12\xec<\x0b\x12\xe7<\x0b\x12\xe2<\x0b\x12\xdd<\x0b\x12\xd8<\x0b\x12\xd3<\x0b\x12\xce<\x0b\x12\xc9<\x0b\x12\xc4<\x0b\x12\xbf<\x0b\x12\xba<\x0b\x12\xb5<\x0b\x12\xb0<\x0b\x12\xab<\x0b\x12\xa6<\x0b\x12\xa1<\x0b\x12\x9c<\x0b\x12\x97<\x0b\x12\x92<\x0b\x12\x8d<\x0b\x12\x88<\x0b\x12\x83<\x0b\x12\xfe;\x0b\x12\xf9;\x0b\x12\xf4;\x0b\x12\xef;\x0b\x12\xea;\x0b\x12\xe5;\x0b\x12\xe0;\x0b\x12\xdb;\x0b\x12\xd6;\x0b\x12\xd1;\x0b\x12\xcc;\x0b\x12\xc7;\x0b\x12\xc2;\x0b\x12\xbd;\x0b\x12\xb8;\x0b\x12\xb3;\x0b\x12\xae;\x0b\x12\xa9;\x0b\x12\xa4;\x0b\x12\x9f;\x0b\x12\x9a;\x0b\x12\x95;\x0b\x12\x90;\x0b\x12\x8b;\x0b\x12\x86;\x0b\x12\x81;\x0b\x12\xfc:\x0b\x12\xf7:\x0b\x12\xf2:\x0b\x12\xed:\x0b\x12\xe8:\x0b\x12\xe3:\x0b\x12\xde:\x0b\x12\xd9:\x0b\x12\xd4:\x0b\x12\xcf:\x0b\x12\xca:\x0b\x12\xc5:\x0b\x12\xc0:\x0b\x12\xbb:\x0b\x12\xb6:\x0b\x12\xb1:\x0b\x12\xac:\x0b\x12\xa7:\x0b\x12\xa2:\x0b\x12\x9d:\x0b\x12\x98:\x0b\x12\x93:\x0b\x12\x8e:\x0b\x12\x89:\x0b\x12\x84:\x0b\x12\xff9\x0b\x12\xfa9\x0b\x12\xf59\x0b\x12\xf09\x0b\x12\xeb9\x0b\x12\xe69\x0b\x12\xe19\x0b\x12\xdc9\x0b\x12\xd79\x0b\x12\xd29\x0b\x12\xcd9\x0b\x12\xc89\x0b\x12\xc39\x0b\x12\xbe9\x0b\x12\xb99\x0b\x12\xb49\x0b\x12\xaf9\x0b\x12\xaa9\x0b\x12\xa59\x0b\x12\xa09\x0b\x12\x9b9\x0b\x12\x969\x0b\x12\x919\x0b\x12\x8c9\x0b\x12\x879\x0b\x12\x829\x0b\x12\xfd8\x0b\x12\xf88\x0b\x12\xf38\x0b\x12\xee8\x0b\x12\xe98\x0b\x12\xe48\x0b\x12\xdf8\x0b\x12\xda8\x0b\x12\xd58\x0b\x12\xd08\x0b\x12\xcb8\x0b\x12\xc68\x0b\x12\xc18\x0b\x12\xbc8\x0b\x12\xb78\x0b\x12\xb28\x0b\x12\xad8\x0b\x12\xa88\x0b\x12\xa38\x0b\x12\x9e8\x0b\x12\x998\x0b\x12\x948\x0b\x12\x8f8\x0b\x12\x8a8\x0b\x12\x858\x0b\x12\x808\x0b\x12\xfb7\x0b\x12\xf67\x0b\x12\xf17\x0b\x12\xec7\x0b\x12\xe77\x0b\x12\xe27\x0b\x12\xdd7\x0b\x12\xd87\x0b\x12\xd37\x0b\x12\xce7\x0b\x12\xc97\x0b\x12\xc47\x0b\x12\xbf7\x0b\x12\xba7\x0b\x12\xb57\x0b\x12\xb07\x0b\x12\xab7\x0b\x12\xa67\x0b\x12\xa17\x0b\x12\x9c7\x0b\x12\x977\x0b\x12\x927\x0b\x12\x8d7\x0b\x12\x887\x0b\x12\x837\x0b\x12\xfe6\x0b\x12\xf96\x0b\x12\xf46\x0b\x12\xef6\x0b\x12\xea6\x0b\x12\xe56\x0b\x12\xe06\x0b\x12\xdb6\x0b\x12\xd66\x0b\x12\xd16\x0b\x12\xcc6\x0b\x12\xc76\x0b\x12\xc26\x0b\x12\xbd6\x0b\x12\xb86\x0b\x12\xb36\x0b\x12\xae6\x0b\x12\xa96\x0b\x12\xa46\x0b\x12\x9f6\x0b\x12\x9a6\x0b\x12\x956\x0b\x12\x906\x0b\x12\x8b6\x0b\x12\x866\x0b\x12\x816\x0b\x12\xfc5\x0b\x12\xf75\x0b\x12\xf25\x0b\x12\xed5\x0b\x12\xe85\x0b\x12\xe35\x0b\x12\xde5\x0b\x12\xd95\x0b\x12\xd45\x0b\x12\xcf5\x0b\x12\xca5\x0b\x12\xc55\x0b\x12\xc05\x0b\x12\xbb5\x0b\x12\xb65\x0b\x12\xb15\x0b\x12\xac5\x0b\x12\xa75\x0b\x12\xa25\x0b\x12\x9d5\x0b\x12\x985\x0b\x12\x935\x0b\x12\x8e5\x0b\x12\x895\x0b\x12\x845\x0b\x12\xff4\x0b\x12\xfa4\x0b\x12\xf54\x0b\x12\xf04\x0b\x12\xeb4\x0b\x12\xe64\x0b\x12\xe14\x0b\x12\xdc4\x0b\x12\xd74\x0b\x12\xd24\x0b\x12\xcd4\x0b\x12\xc84\x0b\x12\xc34\x0b\x12\xbe4\x0b\x12\xb94\x0b\x12\xb44\x0b\x12\xaf4\x0b\x12\xaa4\x0b\x12\xa54\x0b\x12\xa04\x0b\x12\x9b4\x0b\x12\x964\x0b\x12\x914\x0b\x12\x8c4\x0b\x12\x874\x0b\x12\x824\x0b\x12\xfd3\x0b\x12\xf83\x0b\x12\xf33\x0b\x12\xee3\x0b\x12\xe93\x0b\x12\xe43\x0b\x12\xdf3\x0b\x12\xda3\x0b\x12\xd53\x0b\x12\xd03\x0b\x12\xcb3\x0b\x12\xc63\x0b\x12\xc13\x0b\x12\xbc3\x0b\x12\xb73\x0b\x12\xb23\x0b\x12\xad3\x0b\x12\xa83\x0b\x12\xa33\x0b\x12\x9e3\x0b\x12\x993\x0b\x12\x943\x0b\x12\x8f3\x0b\x12\x8a3\x0b\x12\x853\x0b\x12\x803\x0b\x12\xfb2\x0b\x12\xf62\x0b\x12\xf12\x0b\x12\xec2\x0b\x12\xe72\x0b\x12\xe22\x0b\x12\xdd2\x0b\x12\xd82\x0b\x12\xd32\x0b\x12\xce2\x0b\x12\xc92\x0b\x12\xc42\x0b\x12\xbf2\x0b\x12\xba2\x0b\x12\xb52\x0b\x12\xb02\x0b\x12\xab2\x0b\x12\xa62\x0b\x12\xa12\x0b\x12\x9c2\x0b\x12\x972\x0b\x12\x922\x0b\x12\x8d2\x0b\x12\x882\x0b\x12\x832\x0b\x12\xfe1\x0b\x12\xf91\x0b\x12\xf41\x0b\x12\xef1\x0b\x12\xea1\x0b\x12\xe51\x0b\x12\xe01\x0b\x12\xdb1\x0b\x12\xd61\x0b\x12\xd11\x0b\x12\xcc1\x0b\x12\xc71\x0b\x12\xc21\x0b\x12\xbd1\x0b\x12\xb81\x0b\x12\xb31\x0b\x12\xae1\x0b\x12\xa91\x0b\x12\xa41\x0b\x12\x9f1\x0b\x12\x9a1\x0b\x12\x951\x0b\x12\x901\x0b\x12\x8b1\x0b\x12\x861\x0b\x12\x811\x0b\x12\xfc0\x0b\x12\xf70\x0b\x12\xf20\x0b\x12\xed0\x0b\x12\xe80\x0b\x12\xe30\x0b\x12\xde0\x0b\x12\xd90\x0b\x12\xd40\x0b\x12\xcf0\x0b\x12\xca0\x0b\x12\xc50\x0b\x12\xc00\x0b\x12\xbb0\x0b\x12\xb60\x0b\x12\xb10\x0b\x12\xac0\x0b\x12\xa70\x0b\x12\xa20\x0b\x12\x9d0\x0b\x12\x980\x0b\x12\x930\x0b\x12\x8e0\x0b\x12\x890\x0b\x12\x840\x0b\x12\xff/\x0b\x12\xfa/\x0b\x12\xf5/\x0b\x12\xf0/\x0b\x12\xeb/\x0b\x12\xe6/\x0b\x12\xe1/\x0b\x12\xdc/\x0b\x12\xd7/\x0b\x12\xd2/\x0b\x12\xcd/\x0b\x12\xc8/\x0b\x12\xc3/\x0b\x12\xbe/\x0b\x12\xb9/\x0b\x12\xb4/\x0b\x12\xaf/\x0b\x12\xaa/\x0b\x12\xa5/\x0b\x12\xa0/\x0b\x12\x9b/\x0b\x12\x96/\x0b\x12\x91/\x0b\x12\x8c/\x0b\x12\x87/\x0b\x12\x82/\x0b\x12\xfd.\x0b\x12\xf8.\x0b\x12\xf3.\x0b\x12\xee.\x0b\x12\xe9.\x0b\x12\xe4.\x0b\x12\xdf.\x0b\x12\xda.\x0b\x12\xd5.\x0b\x12\xd0.\x0b\x12\xcb.\x0b\x12\xc6.\x0b\x12\xc1.\x0b\x12\xbc.\x0b\x12\xb7.\x0b\x12\xb2.\x0b\x12\xad.\x0b\x12\xa8.\x0b\x12\xa3.\x0b\x12\x9e.\x0b\x12\x99.\x0b\x12\x94.\x0b\x12\x8f.\x0b\x12\x8a.\x0b\x12\x85.\x0b\x12\x80.\x0b\x12\xfb-\x0b\x12\xf6-\x0b\x12\xf1-\x0b\x12\xec-\x0b\x12\xe7-\x0b\x12\xe2-\x0b\x12\xdd-\x0b\x12\xd8-\x0b\x12\xd3-\x0b\x12\xce-\x0b\x12\xc9-\x0b\x12\xc4-\x0b\x12\xbf-\x0b\x12\xba-\x0b\x12\xb5-\x0b\x12\xb0-\x0b\x12\xab-\x0b\x12\xa6-\x0b\x12\xa1-\x0b\x12\x9c-\x0b\x12\x97-\x0b\x12\x92-\x0b\x12\x8d-\x0b\x12\x88-\x0b\x12\x83-\x0b\x12\xfe,\x0b\x12\xf9,\x0b\x12\xf4,\x0b\x12\xef,\x0b\x12\xea,\x0b\x12\xe5,\x0b\x12\xe0,\x0b\x12\xdb,\x0b\x12\xd6,\x0b\x12\xd1,\x0b\x12\xcc,\x0b\x12\xc7,\x0b\x12\xc2,\x0b\x12\xbd,\x0b\x12\xb8,\x0b\x12\xb3,\x0b\x12\xae,\x0b\x12\xa9,\x0b\x12\xa4,\x0b\x12\x9f,\x0b\x12\x9a,\x0b\x12\x95,\x0b\x12\x90,\x0b\x12\x8b,\x0b\x12\x86,\x0b\x12\x81,\x0b\x12\xfc+\x0b\x12\xf7+\x0b\x12\xf2+\x0b\x12\xed+\x0b\x12\xe8+\x0b\x12\xe3+\x0b\x12\xde+\x0b\x12\xd9+\x0b\x12\xd4+\x0b\x12\xcf+\x0b\x12\xca+\x0b\x12\xc5+\x0b\x12\xc0+\x0b\x12\xbb+\x0b\x12\xb6+\x0b\x12\xb1+\x0b\x12\xac+\x0b\x12\xa7+\x0b\x12\xa2+\x0b\x12\x9d+\x0b\x12\x98+\x0b\x12\x93+\x0b\x12\x8e+\x0b\x12\x89+\x0b\x12\x84+\x0b\x12\xff*\x0b\x12\xfa*\x0b\x12\xf5*\x0b\x12\xf0*\x0b\x12\xeb*\x0b\x12\xe6*\x0b\x12\xe1*\x0b\x12\xdc*\x0b\x12\xd7*\x0b\x12\xd2*\x0b\x12\xcd*\x0b\x12\xc8*\x0b\x12\xc3*\x0b\x12\xbe*\x0b\x12\xb9*\x0b\x12\xb4*\x0b\x12\xaf*\x0b\x12\xaa*\x0b\x12\xa5*\x0b\x12\xa0*\x0b\x12\x9b*\x0b\x12\x96*\x0b\x12\x91*\x0b\x12\x8c*\x0b\x12\x87*\x0b\x12\x82*\x0b\x12\xfd)\x0b\x12\xf8)\x0b\x12\xf3)\x0b\x12\xee)\x0b\x12\xe9)\x0b\x12\xe4)\x0b\x12\xdf)\x0b\x12\xda)\x0b\x12\xd5)\x0b\x12\xd0)\x0b\x12\xcb)\x0b\x12\xc6)\x0b\x12\xc1)\x0b\x12\xbc)\x0b\x12\xb7)\x0b\x12\xb2)\x0b\x12\xad)\x0b\x12\xa8)\x0b\x12\xa3)\x0b\x12\x9e)\x0b\x12\x99)\x0b\x12\x94)\x0b\x12\x8f)\x0b\x12\x8a)\x0b\x12\x85)\x0b\x12\x80)\x0b\x12\xfb(\x0b\x12\xf6(\x0b\x12\xf1(\x0b\x12\xec(\x0b\x12\xe7(\x0b\x12\xe2(\x0b\x12\xdd(\x0b\x12\xd8(\x0b\x12\xd3(\x0b\x12\xce(\x0b\x12\xc9(\x0b\x12\xc4(\x0b\x12\xbf(\x0b\x12\xba(\x0b\x12\xb5(\x0b\x12\xb0(\x0b\x12\xab(\x0b\x12\xa6(\x0b\x12\xa1(\x0b\x12\x9c(\x0b\x12\x97(\x0b\x12\x92(\x0b\x12\x8d(\x0b\x12\x88(\x0b\x12\x83(\x0b\x12\xfe\'\x0b\x12\xf9\'\x0b\x12\xf4\'\x0b\x12\xef\'\x0b\x12\xea\'\x0b\x12\xe5\'\x0b\x12\xe0\'\x0b\x12\xdb\'\x0b\x12\xd6\'\x0b\x12\xd1\'\x0b\x12\xcc\'\x0b\x12\xc7\'\x0b\x12\xc2\'\x0b\x12\xbd\'\x0b\x12\xb8\'\x0b\x12\xb3\'\x0b\x12\xae\'\x0b\x12\xa9\'\x0b\x12\xa4\'\x0b\x12\x9f\'\x0b\x12\x9a\'\x0b\x12\x95\'\x0b\x12\x90\'\x0b\x12\x8b\'\x0b\x12\x86\'\x0b\x12\x81\'\x0b\x12\xfc&\x0b\x12\xf7&\x0b\x12\xf2&\x0b\x12\xed&\x0b\x12\xe8&\x0b\x12\xe3&\x0b\x12\xde&\x0b\x12\xd9&\x0b\x12\xd4&\x0b\x12\xcf&\x0b\x12\xca&\x0b\x12\xc5&\x0b\x12\xc0&\x0b\x12\xbb&\x0b\x12\xb6&\x0b\x12\xb1&\x0b\x12\xac&\x0b\x12\xa7&\x0b\x12\xa2&\x0b\x12\x9d&\x0b\x12\x98&\x0b\x12\x93&\x0b\x12\x8e&\x0b\x12\x89&\x0b\x12\x84&\x0b\x12\xff%\x0b\x12\xfa%\x0b\x12\xf5%\x0b\x12\xf0%\x0b\x12\xeb%\x0b\x12\xe6%\x0b\x12\xe1%\x0b\x12\xdc%\x0b\x12\xd7%\x0b\x12\xd2%\x0b\x12\xcd%\x0b\x12\xc8%\x0b\x12\xc3%\x0b\x12\xbe%\x0b\x12\xb9%\x0b\x12\xb4%\x0b\x12\xaf%\x0b\x12\xaa%\x0b\x12\xa5%\x0b\x12\xa0%\x0b\x12\x9b%\x0b\x12\x96%\x0b\x12\x91%\x0b\x12\x8c%\x0b\x12\x87%\x0b\x12\x82%\x0b\x12\xfd$\x0b\x12\xf8$\x0b\x12\xf3$\x0b\x12\xee$\x0b\x12\xe9$\x0b\x12\xe4$\x0b\x12\xdf$\x0b\x12\xda$\x0b\x12\xd5$\x0b\x12\xd0$\x0b\x12\xcb$\x0b\x12\xc6$\x0b\x12\xc1$\x0b\x12\xbc$\x0b\x12\xb7$\x0b\x12\xb2$\x0b\x12\xad$\x0b\x12\xa8$\x0b\x12\xa3$\x0b\x12\x9e$\x0b\x12\x99$\x0b\x12\x94$\x0b\x12\x8f$\x0b\x12\x8a$\x0b\x12\x85$\x0b\x12\x80$\x0b\x12\xfb#\x0b\x12\xf6#\x0b\x12\xf1#\x0b\x12\xec#\x0b\x12\xe7#\x0b\x12\xe2#\x0b\x12\xdd#\x0b\x12\xd8#\x0b\x12\xd3#\x0b\x12\xce#\x0b\x12\xc9#\x0b\x12\xc4#\x0b\x12\xbf#\x0b\x12\xba#\x0b\x12\xb5#\x0b\x12\xb0#\x0b\x12\xab#\x0b\x12\xa6#\x0b\x12\xa1#\x0b\x12\x9c#\x0b\x12\x97#\x0b\x12\x92#\x0b\x12\x8d#\x0b\x12\x88#\x0b\x12\x83#\x0b\x12\xfe"\x0b\x12\xf9"\x0b\x12\xf4"\x0b\x12\xef"\x0b\x12\xea"\x0b\x12\xe5"\x0b\x12\xe0"\x0b\x12\xdb"\x0b\x12\xd6"\x0b\x12\xd1"\x0b\x12\xcc"\x0b\x12\xc7"\x0b\x12\xc2"\x0b\x12\xbd"\x0b\x12\xb8"\x0b\x12\xb3"\x0b\x12\xae"\x0b\x12\xa9"\x0b\x12\xa4"\x0b\x12\x9f"\x0b\x12\x9a"\x0b\x12\x95"\x0b\x12\x90"\x0b\x12\x8b"\x0b\x12\x86"\x0b\x12\x81"\x0b\x12\xfc!\x0b\x12\xf7!\x0b\x12\xf2!\x0b\x12\xed!\x0b\x12\xe8!\x0b\x12\xe3!\x0b\x12\xde!\x0b\x12\xd9!\x0b\x12\xd4!\x0b\x12\xcf!\x0b\x12\xca!\x0b\x12\xc5!\x0b\x12\xc0!\x0b\x12\xbb!\x0b\x12\xb6!\x0b\x12\xb1!\x0b\x12\xac!\x0b\x12\xa7!\x0b\x12\xa2!\x0b\x12\x9d!\x0b\x12\x98!\x0b\x12\x93!\x0b\x12\x8e!\x0b\x12\x89!\x0b\x12\x84!\x0b\x12\xff \x0b\x12\xfa \x0b\x12\xf5 \x0b\x12\xf0 \x0b\x12\xeb \x0b\x12\xe6 \x0b\x12\xe1 \x0b\x12\xdc \x0b\x12\xd7 \x0b\x12\xd2 \x0b\x12\xcd \x0b\x12\xc8 \x0b\x12\xc3 \x0b\x12\xbe \x0b\x12\xb9 \x0b\x12\xb4 \x0b\x12\xaf \x0b\x12\xaa \x0b\x12\xa5 \x0b\x12\xa0 \x0b\x12\x9b \x0b\x12\x96 \x0b\x12\x91 \x0b\x12\x8c \x0b\x12\x87 \x0b\x12\x82 \x0b\x12\xfd\x1f\x0b\x12\xf8\x1f\x0b\x12\xf3\x1f\x0b\x12\xee\x1f\x0b\x12\xe9\x1f\x0b\x12\xe4\x1f\x0b\x12\xdf\x1f\x0b\x12\xda\x1f\x0b\x12\xd5\x1f\x0b\x12\xd0\x1f\x0b\x12\xcb\x1f\x0b\x12\xc6\x1f\x0b\x12\xc1\x1f\x0b\x12\xbc\x1f\x0b\x12\xb7\x1f\x0b\x12\xb2\x1f\x0b\x12\xad\x1f\x0b\x12\xa8\x1f\x0b\x12\xa3\x1f\x0b\x12\x9e\x1f\x0b\x12\x99\x1f\x0b\x12\x94\x1f\x0b\x12\x8f\x1f\x0b\x12\x8a\x1f\x0b\x12\x85\x1f\x0b\x12\x80\x1f\x0b\x12\xfb\x1e\x0b\x12\xf6\x1e\x0b\x12\xf1\x1e\x0b\x12\xec\x1e\x0b\x12\xe7\x1e\x0b\x12\xe2\x1e\x0b\x12\xdd\x1e\x0b\x12\xd8\x1e\x0b\x12\xd3\x1e\x0b\x12\xce\x1e\x0b\x12\xc9\x1e\x0b\x12\xc4\x1e\x0b\x12\xbf\x1e\x0b\x12\xba\x1e\x0b\x12\xb5\x1e\x0b\x12\xb0\x1e\x0b\x12\xab\x1e\x0b\x12\xa6\x1e\x0b\x12\xa1\x1e\x0b\x12\x9c\x1e\x0b\x12\x97\x1e\x0b\x12\x92\x1e\x0b\x12\x8d\x1e\x0b\x12\x88\x1e\x0b\x12\x83\x1e\x0b\x12\xfe\x1d\x0b\x12\xf9\x1d\x0b\x12\xf4\x1d\x0b\x12\xef\x1d\x0b\x12\xea\x1d\x0b\x12\xe5\x1d\x0b\x12\xe0\x1d\x0b\x12\xdb\x1d\x0b\x12\xd6\x1d\x0b\x12\xd1\x1d\x0b\x12\xcc\x1d\x0b\x12\xc7\x1d\x0b\x12\xc2\x1d\x0b\x12\xbd\x1d\x0b\x12\xb8\x1d\x0b\x12\xb3\x1d\x0b\x12\xae\x1d\x0b\x12\xa9\x1d\x0b\x12\xa4\x1d\x0b\x12\x9f\x1d\x0b\x12\x9a\x1d\x0b\x12\x95\x1d\x0b\x12\x90\x1d\x0b\x12\x8b\x1d\x0b\x12\x86\x1d\x0b\x12\x81\x1d\x0b\x12\xfc\x1c\x0b\x12\xf7\x1c\x0b\x12\xf2\x1c\x0b\x12\xed\x1c\x0b\x12\xe8\x1c\x0b\x12\xe3\x1c\x0b\x12\xde\x1c\x0b\x12\xd9\x1c\x0b\x12\xd4\x1c\x0b\x12\xcf\x1c\x0b\x12\xca\x1c\x0b\x12\xc5\x1c\x0b\x12\xc0\x1c\x0b\x12\xbb\x1c\x0b\x12\xb6\x1c\x0b\x12\xb1\x1c\x0b\x12\xac\x1c\x0b\x12\xa7\x1c\x0b\x12\xa2\x1c\x0b\x12\x9d\x1c\x0b\x12\x98\x1c\x0b\x12\x93\x1c\x0b\x12\x8e\x1c\x0b\x12\x89\x1c\x0b\x12\x84\x1c\x0b\x12\xff\x1b\x0b\x12\xfa\x1b\x0b\x12\xf5\x1b\x0b\x12\xf0\x1b\x0b\x12\xeb\x1b\x0b\x12\xe6\x1b\x0b\x12\xe1\x1b\x0b\x12\xdc\x1b\x0b\x12\xd7\x1b\x0b\x12\xd2\x1b\x0b\x12\xcd\x1b\x0b\x12\xc8\x1b\x0b\x12\xc3\x1b\x0b\x12\xbe\x1b\x0b\x12\xb9\x1b\x0b\x12\xb4\x1b\x0b\x12\xaf\x1b\x0b\x12\xaa\x1b\x0b\x12\xa5\x1b\x0b\x12\xa0\x1b\x0b\x12\x9b\x1b\x0b\x12\x96\x1b\x0b\x12\x91\x1b\x0b\x12\x8c\x1b\x0b\x12\x87\x1b\x0b\x12\x82\x1b\x0b\x12\xfd\x1a\x0b\x12\xf8\x1a\x0b\x12\xf3\x1a\x0b\x12\xee\x1a\x0b\x12\xe9\x1a\x0b\x12\xe4\x1a\x0b\x12\xdf\x1a\x0b\x12\xda\x1a\x0b\x12\xd5\x1a\x0b\x12\xd0\x1a\x0b\x12\xcb\x1a\x0b\x12\xc6\x1a\x0b\x12\xc1\x1a\x0b\x12\xbc\x1a\x0b\x12\xb7\x1a\x0b\x12\xb2\x1a\x0b\x12\xad\x1a\x0b\x12\xa8\x1a\x0b\x12\xa3\x1a\x0b\x12\x9e\x1a\x0b\x12\x99\x1a\x0b\x12\x94\x1a\x0b\x12\x8f\x1a\x0b\x12\x8a\x1a\x0b\x12\x85\x1a\x0b\x12\x80\x1a\x0b\x12\xfb\x19\x0b\x12\xf6\x19\x0b\x12\xf1\x19\x0b\x12\xec\x19\x0b\x12\xe7\x19\x0b\x12\xe2\x19\x0b\x12\xdd\x19\x0b\x12\xd8\x19\x0b\x12\xd3\x19\x0b\x12\xce\x19\x0b\x12\xc9\x19\x0b\x12\xc4\x19\x0b\x12\xbf\x19\x0b\x12\xba\x19\x0b\x12\xb5\x19\x0b\x12\xb0\x19\x0b\x12\xab\x19\x0b\x12\xa6\x19\x0b\x12\xa1\x19\x0b\x12\x9c\x19\x0b\x12\x97\x19\x0b\x12\x92\x19\x0b\x12\x8d\x19\x0b\x12\x88\x19\x0b\x12\x83\x19\x0b\x12\xfe\x18\x0b\x12\xf9\x18\x0b\x12\xf4\x18\x0b\x12\xef\x18\x0b\x12\xea\x18\x0b\x12\xe5\x18\x0b\x12\xe0\x18\x0b\x12\xdb\x18\x0b\x12\xd6\x18\x0b\x12\xd1\x18\x0b\x12\xcc\x18\x0b\x12\xc7\x18\x0b\x12\xc2\x18\x0b\x12\xbd\x18\x0b\x12\xb8\x18\x0b\x12\xb3\x18\x0b\x12\xae\x18\x0b\x12\xa9\x18\x0b\x12\xa4\x18\x0b\x12\x9f\x18\x0b\x12\x9a\x18\x0b\x12\x95\x18\x0b\x12\x90\x18\x0b\x12\x8b\x18\x0b\x12\x86\x18\x0b\x12\x81\x18\x0b\x12\xfc\x17\x0b\x12\xf7\x17\x0b\x12\xf2\x17\x0b\x12\xed\x17\x0b\x12\xe8\x17\x0b\x12\xe3\x17\x0b\x12\xde\x17\x0b\x12\xd9\x17\x0b\x12\xd4\x17\x0b\x12\xcf\x17\x0b\x12\xca\x17\x0b\x12\xc5\x17\x0b\x12\xc0\x17\x0b\x12\xbb\x17\x0b\x12\xb6\x17\x0b\x12\xb1\x17\x0b\x12\xac\x17\x0b\x12\xa7\x17\x0b\x12\xa2\x17\x0b\x12\x9d\x17\x0b\x12\x98\x17\x0b\x12\x93\x17\x0b\x12\x8e\x17\x0b\x12\x89\x17\x0b\x12\x84\x17\x0b\x12\xff\x16\x0b\x12\xfa\x16\x0b\x12\xf5\x16\x0b\x12\xf0\x16\x0b\x12\xeb\x16\x0b\x12\xe6\x16\x0b\x12\xe1\x16\x0b\x12\xdc\x16\x0b\x12\xd7\x16\x0b\x12\xd2\x16\x0b\x12\xcd\x16\x0b\x12\xc8\x16\x0b\x12\xc3\x16\x0b\x12\xbe\x16\x0b\x12\xb9\x16\x0b\x12\xb4\x16\x0b\x12\xaf\x16\x0b\x12\xaa\x16\x0b\x12\xa5\x16\x0b\x12\xa0\x16\x0b\x12\x9b\x16\x0b\x12\x96\x16\x0b\x12\x91\x16\x0b\x12\x8c\x16\x0b\x12\x87\x16\x0b\x12\x82\x16\x0b\x12\xfd\x15\x0b\x12\xf8\x15\x0b\x12\xf3\x15\x0b\x12\xee\x15\x0b\x12\xe9\x15\x0b\x12\xe4\x15\x0b\x12\xdf\x15\x0b\x12\xda\x15\x0b\x12\xd5\x15\x0b\x12\xd0\x15\x0b\x12\xcb\x15\x0b\x12\xc6\x15\x0b\x12\xc1\x15\x0b\x12\xbc\x15\x0b\x12\xb7\x15\x0b\x12\xb2\x15\x0b\x12\xad\x15\x0b\x12\xa8\x15\x0b\x12\xa3\x15\x0b\x12\x9e\x15\x0b\x12\x99\x15\x0b\x12\x94\x15\x0b\x12\x8f\x15\x0b\x12\x8a\x15\x0b\x12\x85\x15\x0b\x12\x80\x15\x0b\x12\xfb\x14\x0b\x12\xf6\x14\x0b\x12\xf1\x14\x0b\x12\xec\x14\x0b\x12\xe7\x14\x0b\x12\xe2\x14\x0b\x12\xdd\x14\x0b\x12\xd8\x14\x0b\x12\xd3\x14\x0b\x12\xce\x14\x0b\x12\xc9\x14\x0b\x12\xc4\x14\x0b\x12\xbf\x14\x0b\x12\xba\x14\x0b\x12\xb5\x14\x0b\x12\xb0\x14\x0b\x12\xab\x14\x0b\x12\xa6\x14\x0b\x12\xa1\x14\x0b\x12\x9c\x14\x0b\x12\x97\x14\x0b\x12\x92\x14\x0b\x12\x8d\x14\x0b\x12\x88\x14\x0b\x12\x83\x14\x0b\x12\xfe\x13\x0b\x12\xf9\x13\x0b\x12\xf4\x13\x0b\x12\xef\x13\x0b\x12\xea\x13\x0b\x12\xe5\x13\x0b\x12\xe0\x13\x0b\x12\xdb\x13\x0b\x12\xd6\x13\x0b\x12\xd1\x13\x0b\x12\xcc\x13\x0b\x12\xc7\x13\x0b\x12\xc2\x13\x0b\x12\xbd\x13\x0b\x12\xb8\x13\x0b\x12\xb3\x13\x0b\x12\xae\x13\x0b\x12\xa9\x13\x0b\x12\xa4\x13\x0b\x12\x9f\x13\x0b\x12\x9a\x13\x0b\x12\x95\x13\x0b\x12\x90\x13\x0b\x12\x8b\x13\x0b\x12\x86\x13\x0b\x12\x81\x13\x0b\x12\xfc\x12\x0b\x12\xf7\x12\x0b\x12\xf2\x12\x0b\x12\xed\x12\x0b\x12\xe8\x12\x0b\x12\xe3\x12\x0b\x12\xde\x12\x0b\x12\xd9\x12\x0b\x12\xd4\x12\x0b\x12\xcf\x12\x0b\x12\xca\x12\x0b\x12\xc5\x12\x0b\x12\xc0\x12\x0b\x12\xbb\x12\x0b\x12\xb6\x12\x0b\x12\xb1\x12\x0b\x12\xac\x12\x0b\x12\xa7\x12\x0b\x12\xa2\x12\x0b\x12\x9d\x12\x0b\x12\x98\x12\x0b\x12\x93\x12\x0b\x12\x8e\x12\x0b\x12\x89\x12\x0b\x12\x84\x12\x0b\x12\xff\x11\x0b\x12\xfa\x11\x0b\x12\xf5\x11\x0b\x12\xf0\x11\x0b\x12\xeb\x11\x0b\x12\xe6\x11\x0b\x12\xe1\x11\x0b\x12\xdc\x11\x0b\x12\xd7\x11\x0b\x12\xd2\x11\x0b\x12\xcd\x11\x0b\x12\xc8\x11\x0b\x12\xc3\x11\x0b\x12\xbe\x11\x0b\x12\xb9\x11\x0b\x12\xb4\x11\x0b\x12\xaf\x11\x0b\x12\xaa\x11\x0b\x12\xa5\x11\x0b\x12\xa0\x11\x0b\x12\x9b\x11\x0b\x12\x96\x11\x0b\x12\x91\x11\x0b\x12\x8c\x11\x0b\x12\x87\x11\x0b\x12\x82\x11\x0b\x12\xfd\x10\x0b\x12\xf8\x10\x0b\x12\xf3\x10\x0b\x12\xee\x10\x0b\x12\xe9\x10\x0b\x12\xe4\x10\x0b\x12\xdf\x10\x0b\x12\xda\x10\x0b\x12\xd5\x10\x0b\x12\xd0\x10\x0b\x12\xcb\x10\x0b\x12\xc6\x10\x0b\x12\xc1\x10\x0b\x12\xbc\x10\x0b\x12\xb7\x10\x0b\x12\xb2\x10\x0b\x12\xad\x10\x0b\x12\xa8\x10\x0b\x12\xa3\x10\x0b\x12\x9e\x10\x0b\x12\x99\x10\x0b\x12\x94\x10\x0b\x12\x8f\x10\x0b\x12\x8a\x10\x0b\x12\x85\x10\x0b\x12\x80\x10\x0b\x12\xfb\x0f\x0b\x12\xf6\x0f\x0b\x12\xf1\x0f\x0b\x12\xec\x0f\x0b\x12\xe7\x0f\x0b\x12\xe2\x0f\x0b\x12\xdd\x0f\x0b\x12\xd8\x0f\x0b\x12\xd3\x0f\x0b\x12\xce\x0f\x0b\x12\xc9\x0f\x0b\x12\xc4\x0f\x0b\x12\xbf\x0f\x0b\x12\xba\x0f\x0b\x12\xb5\x0f\x0b\x12\xb0\x0f\x0b\x12\xab\x0f\x0b\x12\xa6\x0f\x0b\x12\xa1\x0f\x0b\x12\x9c\x0f\x0b\x12\x97\x0f\x0b\x12\x92\x0f\x0b\x12\x8d\x0f\x0b\x12\x88\x0f\x0b\x12\x83\x0f\x0b\x12\xfe\x0e\x0b\x12\xf9\x0e\x0b\x12\xf4\x0e\x0b\x12\xef\x0e\x0b\x12\xea\x0e\x0b\x12\xe5\x0e\x0b\x12\xe0\x0e\x0b\x12\xdb\x0e\x0b\x12\xd6\x0e\x0b\x12\xd1\x0e\x0b\x12\xcc\x0e\x0b\x12\xc7\x0e\x0b\x12\xc2\x0e\x0b\x12\xbd\x0e\x0b\x12\xb8\x0e\x0b\x12\xb3\x0e\x0b\x12\xae\x0e\x0b\x12\xa9\x0e\x0b\x12\xa4\x0e\x0b\x12\x9f\x0e\x0b\x12\x9a\x0e\x0b\x12\x95\x0e\x0b\x12\x90\x0e\x0b\x12\x8b\x0e\x0b\x12\x86\x0e\x0b\x12\x81\x0e\x0b\x12\xfc\r\x0b\x12\xf7\r\x0b\x12\xf2\r\x0b\x12\xed\r\x0b\x12\xe8\r\x0b\x12\xe3\r\x0b\x12\xde\r\x0b\x12\xd9\r\x0b\x12\xd4\r\x0b\x12\xcf\r\x0b\x12\xca\r\x0b\x12\xc5\r\x0b\x12\xc0\r\x0b\x12\xbb\r\x0b\x12\xb6\r\x0b\x12\xb1\r\x0b\x12\xac\r\x0b\x12\xa7\r\x0b\x12\xa2\r\x0b\x12\x9d\r\x0b\x12\x98\r\x0b\x12\x93\r\x0b\x12\x8e\r\x0b\x12\x89\r\x0b\x12\x84\r\x0b\x12\xff\x0c\x0b\x12\xfa\x0c\x0b\x12\xf5\x0c\x0b\x12\xf0\x0c\x0b\x12\xeb\x0c\x0b\x12\xe6\x0c\x0b\x12\xe1\x0c\x0b\x12\xdc\x0c\x0b\x12\xd7\x0c\x0b\x12\xd2\x0c\x0b\x12\xcd\x0c\x0b\x12\xc8\x0c\x0b\x12\xc3\x0c\x0b\x12\xbe\x0c\x0b\x12\xb9\x0c\x0b\x12\xb4\x0c\x0b\x12\xaf\x0c\x0b\x12\xaa\x0c\x0b\x12\xa5\x0c\x0b\x12\xa0\x0c\x0b\x12\x9b\x0c\x0b\x12\x96\x0c\x0b\x12\x91\x0c\x0b\x12\x8c\x0c\x0b\x12\x87\x0c\x0b\x12\x82\x0c\x0b\x12\xfd\x0b\x0b\x12\xf8\x0b\x0b\x12\xf3\x0b\x0b\x12\xee\x0b\x0b\x12\xe9\x0b\x0b\x12\xe4\x0b\x0b\x12\xdf\x0b\x0b\x12\xda\x0b\x0b\x12\xd5\x0b\x0b\x12\xd0\x0b\x0b\x12\xcb\x0b\x0b\x12\xc6\x0b\x0b\x12\xc1\x0b\x0b\x12\xbc\x0b\x0b\x12\xb7\x0b\x0b\x12\xb2\x0b\x0b\x12\xad\x0b\x0b\x12\xa8\x0b\x0b\x12\xa3\x0b\x0b\x12\x9e\x0b\x0b\x12\x99\x0b\x0b\x12\x94\x0b\x0b\x12\x8f\x0b\x0b\x12\x8a\x0b\x0b\x12\x85\x0b\x0b\x12\x80\x0b\x0b\x12\xfb\n\x0b\x12\xf6\n\x0b\x12\xf1\n\x0b\x12\xec\n\x0b\x12\xe7\n\x0b\x12\xe2\n\x0b\x12\xdd\n\x0b\x12\xd8\n\x0b\x12\xd3\n\x0b\x12\xce\n\x0b\x12\xc9\n\x0b\x12\xc4\n\x0b\x12\xbf\n\x0b\x12\xba\n\x0b\x12\xb5\n\x0b\x12\xb0\n\x0b\x12\xab\n\x0b\x12\xa6\n\x0b\x12\xa1\n\x0b\x12\x9c\n\x0b\x12\x97\n\x0b\x12\x92\n\x0b\x12\x8d\n\x0b\x12\x88\n\x0b\x12\x83\n\x0b\x12\xfe\t\x0b\x12\xf9\t\x0b\x12\xf4\t\x0b\x12\xef\t\x0b\x12\xea\t\x0b\x12\xe5\t\x0b\x12\xe0\t\x0b\x12\xdb\t\x0b\x12\xd6\t\x0b\x12\xd1\t\x0b\x12\xcc\t\x0b\x12\xc7\t\x0b\x12\xc2\t\x0b\x12\xbd\t\x0b\x12\xb8\t\x0b\x12\xb3\t\x0b\x12\xae\t\x0b\x12\xa9\t\x0b\x12\xa4\t\x0b\x12\x9f\t\x0b\x12\x9a\t\x0b\x12\x95\t\x0b\x12\x90\t\x0b\x12\x8b\t\x0b\x12\x86\t\x0b\x12\x81\t\x0b\x12\xfc\x08\x0b\x12\xf7\x08\x0b\x12\xf2\x08\x0b\x12\xed\x08\x0b\x12\xe8\x08\x0b\x12\xe3\x08\x0b\x12\xde\x08\x0b\x12\xd9\x08\x0b\x12\xd4\x08\x0b\x12\xcf\x08\x0b\x12\xca\x08\x0b\x12\xc5\x08\x0b\x12\xc0\x08\x0b\x12\xbb\x08\x0b\x12\xb6\x08\x0b\x12\xb1\x08\x0b\x12\xac\x08\x0b\x12\xa7\x08\x0b\x12\xa2\x08\x0b\x12\x9d\x08\x0b\x12\x98\x08\x0b\x12\x93\x08\x0b\x12\x8e\x08\x0b\x12\x89\x08\x0b\x12\x84\x08\x0b\x12\xff\x07\x0b\x12\xfa\x07\x0b\x12\xf5\x07\x0b\x12\xf0\x07\x0b\x12\xeb\x07\x0b\x12\xe6\x07\x0b\x12\xe1\x07\x0b\x12\xdc\x07\x0b\x12\xd7\x07\x0b\x12\xd2\x07\x0b\x12\xcd\x07\x0b\x12\xc8\x07\x0b\x12\xc3\x07\x0b\x12\xbe\x07\x0b\x12\xb9\x07\x0b\x12\xb4\x07\x0b\x12\xaf\x07\x0b\x12\xaa\x07\x0b\x12\xa5\x07\x0b\x12\xa0\x07\x0b\x12\x9b\x07\x0b\x12\x96\x07\x0b\x12\x91\x07\x0b\x12\x8c\x07\x0b\x12\x87\x07\x0b\x12\x82\x07\x0b\x12\xfd\x06\x0b\x12\xf8\x06\x0b\x12\xf3\x06\x0b\x12\xee\x06\x0b\x12\xe9\x06\x0b\x12\xe4\x06\x0b\x12\xdf\x06\x0b\x12\xda\x06\x0b\x12\xd5\x06\x0b\x12\xd0\x06\x0b\x12\xcb\x06\x0b\x12\xc6\x06\x0b\x12\xc1\x06\x0b\x12\xbc\x06\x0b\x12\xb7\x06\x0b\x12\xb2\x06\x0b\x12\xad\x06\x0b\x12\xa8\x06\x0b\x12\xa3\x06\x0b\x12\x9e\x06\x0b\x12\x99\x06\x0b\x12\x94\x06\x0b\x12\x8f\x06\x0b\x12\x8a\x06\x0b\x12\x85\x06\x0b\x12\x80\x06\x0b\x12\xfb\x05\x0b\x12\xf6\x05\x0b\x12\xf1\x05\x0b\x12\xec\x05\x0b\x12\xe7\x05\x0b\x12\xe2\x05\x0b\x12\xdd\x05\x0b\x12\xd8\x05\x0b\x12\xd3\x05\x0b\x12\xce\x05\x0b\x12\xc9\x05\x0b\x12\xc4\x05\x0b\x12\xbf\x05\x0b\x12\xba\x05\x0b\x12\xb5\x05\x0b\x12\xb0\x05\x0b\x12\xab\x05\x0b\x12\xa6\x05\x0b\x12\xa1\x05\x0b\x12\x9c\x05\x0b\x12\x97\x05\x0b\x12\x92\x05\x0b\x12\x8d\x05\x0b\x12\x88\x05\x0b\x12\x83\x05\x0b\x12\xfe\x04\x0b\x12\xf9\x04\x0b\x12\xf4\x04\x0b\x12\xef\x04\x0b\x12\xea\x04\x0b\x12\xe5\x04\x0b\x12\xe0\x04\x0b\x12\xdb\x04\x0b\x12\xd6\x04\x0b\x12\xd1\x04\x0b\x12\xcc\x04\x0b\x12\xc7\x04\x0b\x12\xc2\x04\x0b\x12\xbd\x04\x0b\x12\xb8\x04\x0b\x12\xb3\x04\x0b\x12\xae\x04\x0b\x12\xa9\x04\x0b\x12\xa4\x04\x0b\x12\x9f\x04\x0b\x12\x9a\x04\x0b\x12\x95\x04\x0b\x12\x90\x04\x0b\x12\x8b\x04\x0b\x12\x86\x04\x0b\x12\x81\x04\x0b\x12\xfc\x03\x0b\x12\xf7\x03\x0b\x12\xf2\x03\x0b\x12\xed\x03\x0b\x12\xe8\x03\x0b\x12\xe3\x03\x0b\x12\xde\x03\x0b\x12\xd9\x03\x0b\x12\xd4\x03\x0b\x12\xcf\x03\x0b\x12\xca\x03\x0b\x12\xc5\x03\x0b\x12\xc0\x03\x0b\x12\xbb\x03\x0b\x12\xb6\x03\x0b\x12\xb1\x03\x0b\x12\xac\x03\x0b\x12\xa7\x03\x0b\x12\xa2\x03\x0b\x12\x9d\x03\x0b\x12\x98\x03\x0b\x12\x93\x03\x0b\x12\x8e\x03\x0b\x12\x89\x03\x0b\x12\x84\x03\x0b\x12\xff\x02\x0b\x12\xfa\x02\x0b\x12\xf5\x02\x0b\x12\xf0\x02\x0b\x12\xeb\x02\x0b\x12\xe6\x02\x0b\x12\xe1\x02\x0b\x12\xdc\x02\x0b\x12\xd7\x02\x0b\x12\xd2\x02\x0b\x12\xcd\x02\x0b\x12\xc8\x02\x0b\x12\xc3\x02\x0b\x12\xbe\x02\x0b\x12\xb9\x02\x0b\x12\xb4\x02\x0b\x12\xaf\x02\x0b\x12\xaa\x02\x0b\x12\xa5\x02\x0b\x12\xa0\x02\x0b\x12\x9b\x02\x0b\x12\x96\x02\x0b\x12\x91\x02\x0b\x12\x8c\x02\x0b\x12\x87\x02\x0b\x12\x82\x02\x0b\x12\xfd\x01\x0b\x12\xf8\x01\x0b\x12\xf3\x01\x0b\x12\xee\x01\x0b\x12\xe9\x01\x0b\x12\xe4\x01\x0b\x12\xdf\x01\x0b\x12\xda\x01\x0b\x12\xd5\x01\x0b\x12\xd0\x01\x0b\x12\xcb\x01\x0b\x12\xc6\x01\x0b\x12\xc1\x01\x0b\x12\xbc\x01\x0b\x12\xb7\x01\x0b\x12\xb2\x01\x0b\x12\xad\x01\x0b\x12\xa8\x01\x0b\x12\xa3\x01\x0b\x12\x9e\x01\x0b\x12\x99\x01\x0b\x12\x94\x01\x0b\x12\x8f\x01\x0b\x12\x8a\x01\x0b\x12\x85\x01\x0b\x12\x80\x01\x0b\x12|\x0b\x12x\x0b\x12t\x0b\x12p\x0b\x12l\x0b\x12h\x0b\x12d\x0b\x12`\x0b\x12\\\x0b\x12X\x0b\x12T\x0b\x12P\x0b\x12L\x0b\x12H\x0b\x12D\x0b\x12@\x0b\x12<\x0b\x128\x0b\x124\x0b\x120\x0b\x12,\x0b\x12(\x0b\x12$\x0b\x12 \x0b\x12\x1c\x0b\x12\x18\x0b\x12\x14\x0b\x12\x10\x0b\x12\x0c\x0b\x12\x08\x0b\x12\x04\x0b\x12\x00\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c\x0c', 'example2.RecursiveGroup', typedefs_43);
+  SET failed = FALSE;
+EXCEPTION WHEN ERROR THEN
+  SET failed = TRUE;
+END;
+ASSERT failed AS 'group: recursion beyond the limit';
+
+-- want error: Maximum call stack size exceeded
+BEGIN
+  SET result = parseProtobuf(b'\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14', 'example2.OptionalGroup', typedefs_40);
+  SET failed = FALSE;
+EXCEPTION WHEN ERROR THEN
+  SET failed = TRUE;
+END;
+ASSERT failed AS 'unknown group: recursion at the limit';
+
+-- want error: Maximum call stack size exceeded
+BEGIN
+  SET result = parseProtobuf(b'\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x13\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14\x14', 'example2.OptionalGroup', typedefs_40);
+  SET failed = FALSE;
+EXCEPTION WHEN ERROR THEN
+  SET failed = TRUE;
+END;
+ASSERT failed AS 'unknown group: recursion beyond the limit';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x12\x03\xe3\x81\x82', 'example.Oneof', typedefs_44)) = TO_JSON_STRING(JSON '{"stringField":"あ"}') AS 'oneof';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'', 'example.ImplicitUint32Wrapper', typedefs_45)) = TO_JSON_STRING(JSON '{}') AS 'wrapper: missing';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x00', 'example.ImplicitUint32Wrapper', typedefs_45)) = TO_JSON_STRING(JSON '{"myField":0}') AS 'wrapper: empty';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x02\x08*', 'example.ImplicitUint32Wrapper', typedefs_45)) = TO_JSON_STRING(JSON '{"myField":42}') AS 'wrapper: inhabited';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x00', 'google.protobuf.Value', typedefs_46)) = TO_JSON_STRING(JSON 'null') AS 'JSON: null';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x11\x00\x00\x00\x00\x00\x00\xf0?', 'google.protobuf.Value', typedefs_46)) = TO_JSON_STRING(JSON '1') AS 'JSON: number';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x1a\x05Hello', 'google.protobuf.Value', typedefs_46)) = TO_JSON_STRING(JSON '"Hello"') AS 'JSON: string';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b' \x01', 'google.protobuf.Value', typedefs_46)) = TO_JSON_STRING(JSON 'true') AS 'JSON: bool';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'*\t\n\x07\n\x01a\x12\x02\x08\x00', 'google.protobuf.Value', typedefs_46)) = TO_JSON_STRING(JSON '{"a":null}') AS 'JSON: object';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'2\x04\n\x02\x08\x00', 'google.protobuf.Value', typedefs_46)) = TO_JSON_STRING(JSON '[null]') AS 'JSON: list';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n\x0bfoo_bar.baz\n\x0cpork.egg_ham', 'google.protobuf.FieldMask', typedefs_46)) = TO_JSON_STRING(JSON '"fooBar.baz,pork.eggHam"') AS 'fieldmask';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\xe5\xa7\x9e\xaa\x06\x10\xd1\xa9\xa0\x1d', 'google.protobuf.Timestamp', typedefs_46)) = TO_JSON_STRING(JSON '"2023-11-05T13:08:53.061347025Z"') AS 'timestamp';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\x08\x83\xaa\x0c\x10\xc9\xbb\xf0\xc0\x02', 'google.protobuf.Duration', typedefs_46)) = TO_JSON_STRING(JSON '"201987.672931273s"') AS 'duration';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n*type.googleapis.com/example.ImplicitUint32\x12\x02\x08*', 'google.protobuf.Any', typedefs_46)) = TO_JSON_STRING(JSON '{"@type":"type.googleapis.com/example.ImplicitUint32","myField":42}') AS 'any on plain message';
+
+ASSERT TO_JSON_STRING(parseProtobuf(b'\n-type.googleapis.com/google.protobuf.FieldMask\x12\x1b\n\x0bfoo_bar.baz\n\x0cpork.egg_ham', 'google.protobuf.Any', typedefs_46)) = TO_JSON_STRING(JSON '{"@type":"type.googleapis.com/google.protobuf.FieldMask","value":"fooBar.baz,pork.eggHam"}') AS 'any on special message';
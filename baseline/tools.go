@@ -1,7 +0,0 @@
-//go:build tools
-
-package tools
-
-import (
-	_ "google.golang.org/protobuf/cmd/protoc-gen-go"
-)
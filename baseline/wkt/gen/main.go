@@ -0,0 +1,24 @@
+// Command gen writes wkt.json.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/qnighy/bqpb/baseline/wkt"
+)
+
+func main() {
+	output := flag.String("o", "wkt.json", "output file")
+	flag.Parse()
+
+	data, err := wkt.Generate()
+	if err == nil {
+		err = os.WriteFile(*output, data, 0o644)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "gen: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,87 @@
+// Package wkt bundles the typedefs of the well-known types.
+//
+// bqpb decodes some of google/protobuf/*.proto on its own (see
+// bqpb.IsSpecialType), but the others, such as google.protobuf.Empty,
+// google.protobuf.Type and google.protobuf.Api, need typedefs like any other
+// message. wkt.json has them all, derived from the descriptors registered by
+// the Go protobuf runtime. Regenerate it with go generate after upgrading
+// google.golang.org/protobuf.
+package wkt
+
+//go:generate go run ./gen -o wkt.json
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"path"
+	"sort"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	// Register the well-known types.
+	_ "google.golang.org/protobuf/types/known/anypb"
+	_ "google.golang.org/protobuf/types/known/apipb"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/emptypb"
+	_ "google.golang.org/protobuf/types/known/fieldmaskpb"
+	_ "google.golang.org/protobuf/types/known/sourcecontextpb"
+	_ "google.golang.org/protobuf/types/known/structpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	_ "google.golang.org/protobuf/types/known/typepb"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+	"github.com/qnighy/bqpb/baseline/bqpbdesc"
+)
+
+//go:embed wkt.json
+var bundle []byte
+
+// Typedefs returns the typedefs of the well-known types. The result is a
+// fresh copy that the caller may modify.
+func Typedefs() *bqpb.Typedefs {
+	typedefs := &bqpb.Typedefs{}
+	if err := json.Unmarshal(bundle, typedefs); err != nil {
+		panic("wkt: broken wkt.json: " + err.Error())
+	}
+	return typedefs
+}
+
+// Files returns the well-known type files registered in
+// protoregistry.GlobalFiles, sorted by path.
+//
+// google/protobuf/descriptor.proto is not a well-known type and is left
+// out; it is mostly used by protoc plugins and is three times as large as
+// the rest. So is google/protobuf/go_features.proto, which declares the
+// options of protoc-gen-go.
+func Files() []protoreflect.FileDescriptor {
+	var files []protoreflect.FileDescriptor
+	protoregistry.GlobalFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		switch fd.Path() {
+		case "google/protobuf/descriptor.proto", "google/protobuf/go_features.proto":
+			return true
+		}
+		if path.Dir(fd.Path()) == "google/protobuf" {
+			files = append(files, fd)
+		}
+		return true
+	})
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path() < files[j].Path()
+	})
+	return files
+}
+
+// Generate returns the contents of wkt.json.
+func Generate() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(bqpbdesc.FromFiles(Files()...)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,41 @@
+package wkt_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/qnighy/bqpb/baseline/wkt"
+)
+
+func TestGenerate(t *testing.T) {
+	want, err := os.ReadFile("wkt.json")
+	if err != nil {
+		t.Fatalf("ReadFile error: %v\n", err)
+	}
+	got, err := wkt.Generate()
+	if err != nil {
+		t.Fatalf("Generate error: %v\n", err)
+	}
+	if diff := cmp.Diff(string(want), string(got)); diff != "" {
+		t.Errorf("wkt.json is stale; run go generate (-want +got):\n%s", diff)
+	}
+}
+
+func TestTypedefs(t *testing.T) {
+	typedefs := wkt.Typedefs()
+	for _, name := range []string{"google.protobuf.Empty", "google.protobuf.Type", "google.protobuf.Api", "google.protobuf.SourceContext"} {
+		if typedefs.Message(name) == nil {
+			t.Errorf("message %s is missing", name)
+		}
+	}
+	for _, name := range []string{"google.protobuf.Field.Kind", "google.protobuf.Syntax"} {
+		if typedefs.Enum(name) == nil {
+			t.Errorf("enum %s is missing", name)
+		}
+	}
+	if typedefs.Message("google.protobuf.Timestamp") != nil {
+		t.Errorf("special type google.protobuf.Timestamp is included")
+	}
+}
@@ -0,0 +1,207 @@
+package baseline_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/apipb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/sourcecontextpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/typepb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/qnighy/bqpb/baseline/bqpb"
+	"github.com/qnighy/bqpb/baseline/wkt"
+)
+
+// wktTestCases returns a populated and an empty instance of every message in
+// the well-known type files.
+func wktTestCases() []proto.Message {
+	option := &typepb.Option{
+		Name:  "deprecated",
+		Value: mustAny(wrapperspb.Bool(true)),
+	}
+	field := &typepb.Field{
+		Kind:         typepb.Field_TYPE_MESSAGE,
+		Cardinality:  typepb.Field_CARDINALITY_REPEATED,
+		Number:       2,
+		Name:         "sub_fields",
+		TypeUrl:      "type.googleapis.com/example.Sub",
+		OneofIndex:   1,
+		Packed:       true,
+		Options:      []*typepb.Option{option},
+		JsonName:     "subFields",
+		DefaultValue: "x",
+	}
+	enumValue := &typepb.EnumValue{Name: "FOO", Number: -1, Options: []*typepb.Option{option}}
+	sourceContext := &sourcecontextpb.SourceContext{FileName: "example.proto"}
+	method := &apipb.Method{
+		Name:              "Get",
+		RequestTypeUrl:    "type.googleapis.com/example.GetRequest",
+		RequestStreaming:  true,
+		ResponseTypeUrl:   "type.googleapis.com/example.GetResponse",
+		ResponseStreaming: false,
+		Options:           []*typepb.Option{option},
+		Syntax:            typepb.Syntax_SYNTAX_PROTO3,
+	}
+	mixin := &apipb.Mixin{Name: "google.iam.v1.IAMPolicy", Root: "v1"}
+	populated := []proto.Message{
+		&emptypb.Empty{},
+		sourceContext,
+		option,
+		field,
+		enumValue,
+		&typepb.Type{
+			Name:          "example.Main",
+			Fields:        []*typepb.Field{field, {Kind: typepb.Field_Kind(99)}},
+			Oneofs:        []string{"choice"},
+			Options:       []*typepb.Option{option},
+			SourceContext: sourceContext,
+			Syntax:        typepb.Syntax_SYNTAX_EDITIONS,
+			Edition:       "2023",
+		},
+		&typepb.Enum{
+			Name:          "example.MyEnum",
+			Enumvalue:     []*typepb.EnumValue{enumValue},
+			Options:       []*typepb.Option{option},
+			SourceContext: sourceContext,
+			Syntax:        typepb.Syntax_SYNTAX_PROTO2,
+			Edition:       "2023",
+		},
+		method,
+		mixin,
+		&apipb.Api{
+			Name:          "example.Service",
+			Methods:       []*apipb.Method{method},
+			Options:       []*typepb.Option{option},
+			Version:       "v1",
+			SourceContext: sourceContext,
+			Mixins:        []*apipb.Mixin{mixin},
+			Syntax:        typepb.Syntax_SYNTAX_PROTO3,
+		},
+		mustAny(field),
+		durationpb.New(-1500000000),
+		&fieldmaskpb.FieldMask{Paths: []string{"sub_fields", "type_url"}},
+		mustStruct(map[string]interface{}{"a": []interface{}{nil, 1.5, "x", true}}),
+		structpb.NewNullValue(),
+		&structpb.ListValue{Values: []*structpb.Value{structpb.NewBoolValue(false)}},
+		&timestamppb.Timestamp{Seconds: 1700000000, Nanos: 123456789},
+		wrapperspb.Double(-0.5),
+		wrapperspb.Float(0.25),
+		wrapperspb.Int64(-1),
+		wrapperspb.UInt64(18446744073709551615),
+		wrapperspb.Int32(-1),
+		wrapperspb.UInt32(4294967295),
+		wrapperspb.Bool(true),
+		wrapperspb.String("x"),
+		wrapperspb.Bytes([]byte{0, 255}),
+	}
+	var testcases []proto.Message
+	for _, m := range populated {
+		testcases = append(testcases, m, m.ProtoReflect().Type().New().Interface())
+	}
+	return testcases
+}
+
+func mustAny(m proto.Message) *anypb.Any {
+	a, err := anypb.New(m)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+func mustStruct(m map[string]interface{}) *structpb.Struct {
+	s, err := structpb.NewStruct(m)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// wktDeviations lists the well-known type cases where bqpb and protojson
+// disagree, keyed by "<message type> <hex input>".
+var wktDeviations = map[string]string{
+	"google.protobuf.Duration 08ffffffffffffffffff011080b6ca91feffffffff01": "bqpb always prints nine fractional digits; protojson prints 0, 3, 6 or 9",
+	"google.protobuf.Duration ":  "bqpb always prints nine fractional digits; protojson prints 0, 3, 6 or 9",
+	"google.protobuf.Timestamp ": "bqpb always prints nine fractional digits; protojson prints 0, 3, 6 or 9",
+}
+
+// TestWellKnownTypes decodes every well-known type with the typedefs of
+// package wkt, and compares the output of bqpb with protojson. Both outputs
+// are recorded in the golden file.
+func TestWellKnownTypes(t *testing.T) {
+	typedefs := wkt.Typedefs()
+	var buf bytes.Buffer
+	seen := map[string]bool{}
+	for _, m := range wktTestCases() {
+		md := m.ProtoReflect().Descriptor()
+		seen[string(md.FullName())] = true
+		data, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+		if err != nil {
+			t.Fatalf("Marshal error: %v\n", err)
+		}
+		key := fmt.Sprintf("%s %s", md.FullName(), hex.EncodeToString(data))
+		fmt.Fprintf(&buf, "=== %s\n", key)
+
+		wantJSON, wantErr := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(m)
+		// protojson seems to have some random behavior on whitespace.
+		want := strings.ReplaceAll(string(wantJSON), " ", "")
+		if wantErr != nil {
+			// The message is randomized too.
+			want = "error"
+		}
+		fmt.Fprintf(&buf, "protojson: %s\n", want)
+		got, err := bqpb.Parse(data, string(md.FullName()), *typedefs)
+		gotText := string(got)
+		if err != nil {
+			gotText = "error: " + err.Error()
+		}
+		fmt.Fprintf(&buf, "bqpb:      %s\n", gotText)
+
+		if reason, ok := wktDeviations[key]; ok {
+			fmt.Fprintf(&buf, "deviation: %s\n", reason)
+			continue
+		}
+		if wantErr != nil {
+			// The error messages differ; bqpb only has to fail too.
+			if err == nil {
+				t.Errorf("%s: Parse() = %s, want error like %q", key, gotText, wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: Parse error: %v", key, err)
+			continue
+		}
+		wantCanonical, err := canonicalJSON(want)
+		if err != nil {
+			t.Fatalf("canonicalJSON error: %v\n", err)
+		}
+		gotCanonical, err := canonicalJSON(gotText)
+		if err != nil {
+			t.Fatalf("canonicalJSON error: %v\n", err)
+		}
+		if wantCanonical != gotCanonical {
+			t.Errorf("%s: Parse() = %s, want %s", key, gotText, want)
+		}
+	}
+	for _, fd := range wkt.Files() {
+		for i := 0; i < fd.Messages().Len(); i++ {
+			if name := fd.Messages().Get(i).FullName(); !seen[string(name)] {
+				t.Errorf("no test case for %s", name)
+			}
+		}
+	}
+	checkGolden(t, "testdata/wkt.golden", buf.Bytes())
+}
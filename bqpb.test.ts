@@ -1,4 +1,4 @@
-import { assertEquals } from "@std/assert";
+import { assertEquals, assertThrows } from "@std/assert";
 import { decodeBase64, encodeBase64, parseBytes, parseWire } from "./bqpb.ts";
 import { b } from "./utils/b.ts";
 
@@ -100,6 +100,16 @@ Deno.test("parseWire", async (t) => {
       },
     ]);
   });
+  await t.step("keeps the bits of VARINT beyond 64", () => {
+    const actual = parseWire(b`\x08\xff\xff\xff\xff\xff\xff\xff\xff\xff\x03`);
+    assertEquals(actual, [
+      {
+        f: 1n,
+        w: 0,
+        v: 36893488147419103231n,
+      },
+    ]);
+  });
   await t.step("parses I64", () => {
     const actual = parseWire(
       b`\x31\x11\x12\x13\x14\x15\x16\x17\x18`,
@@ -281,6 +291,60 @@ Deno.test("parseBytes", async (t) => {
         "#1": "unknown:bytes:CgsM",
       });
     });
+    await t.step("infers int64 from varints beyond 32 bits", () => {
+      const actual = parseBytes(
+        Uint8Array.from([
+          ...b`\x08\xff\xff\xff\xff\x0f`,
+          ...b`\x08\x80\x80\x80\x80\x10`,
+        ]),
+        "Main",
+        {},
+      );
+      assertEquals(actual, {
+        "#1": ["unknown:int32:-1", "unknown:int64:4294967296"],
+      });
+    });
+    await t.step("prefers string over submessage", () => {
+      const actual = parseBytes(b`\x0a\x02HI`, "Main", {});
+      assertEquals(actual, {
+        "#1": "unknown:string:HI",
+      });
+    });
+    await t.step("parses empty length-delimited as string", () => {
+      const actual = parseBytes(b`\x0a\x00`, "Main", {});
+      assertEquals(actual, {
+        "#1": "unknown:string:",
+      });
+    });
+    await t.step("parses string with control characters as submessage", () => {
+      const actual = parseBytes(b`\x0a\x02\x00\x01`, "Main", {});
+      assertEquals(actual, {
+        "#1": { "#0": "unknown:int32:1" },
+      });
+    });
+    await t.step("parses repeated unknowns with mixed wire types", () => {
+      const actual = parseBytes(
+        b`\x08\x01\x0d\x00\x00\xc0\x3f\x0a\x01a\x0b\x0c`,
+        "Main",
+        {},
+      );
+      assertEquals(actual, {
+        "#1": [
+          "unknown:int32:1",
+          "unknown:float:1.5",
+          "unknown:string:a",
+          {},
+        ],
+      });
+    });
+    await t.step("orders unknowns by field number", () => {
+      const actual = parseBytes(
+        b`\x10\x01\x08\x02\x18\x03\x08\x04`,
+        "Main",
+        {},
+      ) as Record<string, unknown>;
+      assertEquals(Object.keys(actual), ["#1", "#2", "#3"]);
+    });
   });
   await t.step("when schema is provided", async (t) => {
     await t.step("when the field has implicit presence", async (t) => {
@@ -887,6 +951,257 @@ Deno.test("parseBytes", async (t) => {
       });
     });
 
+    await t.step("parsing of packed and expanded encodings", async (t) => {
+      await t.step("parses packed enum", () => {
+        const actual = parseBytes(
+          b`\x0a\x04\x00\x01\x02\x03`,
+          "Main",
+          {
+            "message Main": {
+              myField: {
+                repeated: true,
+                type: "MyEnum",
+                id: 1,
+              },
+            },
+            "enum MyEnum": {
+              MY_ENUM_UNSPECIFIED: 0,
+              MY_ENUM_VALUE_1: 1,
+              MY_ENUM_VALUE_2: 2,
+            },
+          },
+        );
+        assertEquals(actual, {
+          myField: [
+            "MY_ENUM_UNSPECIFIED",
+            "MY_ENUM_VALUE_1",
+            "MY_ENUM_VALUE_2",
+            3,
+          ],
+        });
+      });
+      await t.step("parses packed bool", () => {
+        const actual = parseBytes(
+          b`\x0a\x03\x00\x01\x02`,
+          "Main",
+          {
+            "message Main": {
+              myField: {
+                repeated: true,
+                type: "bool",
+                id: 1,
+              },
+            },
+          },
+        );
+        assertEquals(actual, {
+          myField: [false, true, true],
+        });
+      });
+      await t.step("parses packed sint32", () => {
+        const actual = parseBytes(
+          b`\x0a\x05\x00\x01\x02\x03\x04`,
+          "Main",
+          {
+            "message Main": {
+              myField: {
+                repeated: true,
+                type: "sint32",
+                id: 1,
+              },
+            },
+          },
+        );
+        assertEquals(actual, {
+          myField: [0, -1, 1, -2, 2],
+        });
+      });
+      await t.step("parses packed sint64", () => {
+        const actual = parseBytes(
+          b`\x0a\x05\x00\x01\x02\x03\x04`,
+          "Main",
+          {
+            "message Main": {
+              myField: {
+                repeated: true,
+                type: "sint64",
+                id: 1,
+              },
+            },
+          },
+        );
+        assertEquals(actual, {
+          myField: ["0", "-1", "1", "-2", "2"],
+        });
+      });
+      await t.step("parses packed float", () => {
+        const actual = parseBytes(
+          Uint8Array.from([
+            ...b`\x0a\x0c`,
+            ...b`\x00\x00\x00\x00`,
+            ...b`\x00\x00\xc0\x3f`,
+            ...b`\x00\x00\x80\xff`,
+          ]),
+          "Main",
+          {
+            "message Main": {
+              myField: {
+                repeated: true,
+                type: "float",
+                id: 1,
+              },
+            },
+          },
+        );
+        assertEquals(actual, {
+          myField: [0, 1.5, "-Infinity"],
+        });
+      });
+      await t.step("parses packed double", () => {
+        const actual = parseBytes(
+          Uint8Array.from([
+            ...b`\x0a\x10`,
+            ...b`\x00\x00\x00\x00\x00\x00\xf8\xbf`,
+            ...b`\x00\x00\x00\x00\x00\x00\xf8\x7f`,
+          ]),
+          "Main",
+          {
+            "message Main": {
+              myField: {
+                repeated: true,
+                type: "double",
+                id: 1,
+              },
+            },
+          },
+        );
+        assertEquals(actual, {
+          myField: [-1.5, "NaN"],
+        });
+      });
+      await t.step("parses packed and expanded interleaved", () => {
+        const actual = parseBytes(
+          Uint8Array.from([
+            ...b`\x08\x01`,
+            ...b`\x0a\x02\x02\x03`,
+            ...b`\x08\x04`,
+            ...b`\x0a\x01\x05`,
+          ]),
+          "Main",
+          {
+            "message Main": {
+              myField: {
+                repeated: true,
+                type: "uint32",
+                id: 1,
+              },
+            },
+          },
+        );
+        assertEquals(actual, {
+          myField: [1, 2, 3, 4, 5],
+        });
+      });
+      await t.step("parses packed and expanded I32 interleaved", () => {
+        const actual = parseBytes(
+          Uint8Array.from([
+            ...b`\x0d\x01\x00\x00\x00`,
+            ...b`\x0a\x08\x02\x00\x00\x00\x03\x00\x00\x00`,
+            ...b`\x0d\xff\xff\xff\xff`,
+          ]),
+          "Main",
+          {
+            "message Main": {
+              myField: {
+                repeated: true,
+                type: "sfixed32",
+                id: 1,
+              },
+            },
+          },
+        );
+        assertEquals(actual, {
+          myField: [1, 2, 3, -1],
+        });
+      });
+      await t.step("parses empty packed", () => {
+        const actual = parseBytes(
+          b`\x08\x01\x0a\x00\x08\x02\x0a\x00`,
+          "Main",
+          {
+            "message Main": {
+              myField: {
+                repeated: true,
+                type: "uint32",
+                id: 1,
+              },
+            },
+          },
+        );
+        assertEquals(actual, {
+          myField: [1, 2],
+        });
+      });
+      for (
+        const [type, input] of [
+          ["uint32", b`\x0a\x02\x01\x80`],
+          ["fixed32", b`\x0a\x06\x01\x00\x00\x00\x02\x00`],
+          ["fixed64", b`\x0a\x0a\x01\x00\x00\x00\x00\x00\x00\x00\x02\x00`],
+        ] as const
+      ) {
+        await t.step(`rejects packed ${type} with truncated element`, () => {
+          assertThrows(
+            () =>
+              parseBytes(input, "Main", {
+                "message Main": {
+                  myField: {
+                    repeated: true,
+                    type,
+                    id: 1,
+                  },
+                },
+              }),
+            Error,
+            "Unexpected EOF",
+          );
+        });
+      }
+      await t.step("rejects LEN-encoded singular uint32", () => {
+        // protobuf-go keeps it as an unknown field instead.
+        assertThrows(
+          () =>
+            parseBytes(b`\x0a\x01\x05`, "Main", {
+              "message Main": {
+                myField: {
+                  type: "uint32",
+                  id: 1,
+                  fieldPresence: "implicit",
+                },
+              },
+            }),
+          Error,
+          "Expected wire type 0, got 2",
+        );
+      });
+      await t.step("rejects VARINT-encoded repeated string", () => {
+        // protobuf-go keeps it as an unknown field instead.
+        assertThrows(
+          () =>
+            parseBytes(b`\x0a\x01a\x08\x01`, "Main", {
+              "message Main": {
+                myField: {
+                  repeated: true,
+                  type: "string",
+                  id: 1,
+                },
+              },
+            }),
+          Error,
+          "Expected wire type 2, got 0",
+        );
+      });
+    });
+
     await t.step("parsing of LEN datatypes", async (t) => {
       await t.step("parses bytes", () => {
         const actual = parseBytes(
@@ -1212,6 +1527,273 @@ Deno.test("parseBytes", async (t) => {
           },
         });
       });
+      await t.step("parses map with duplicate key", () => {
+        const actual = parseBytes(
+          Uint8Array.from([
+            ...b`\x0a\x05\x0a\x01a\x10\x01`,
+            ...b`\x0a\x05\x0a\x01a\x10\x02`,
+          ]),
+          "Main",
+          {
+            "message Main": {
+              myField: {
+                type: "map<string,uint32>",
+                id: 1,
+              },
+            },
+          },
+        );
+        assertEquals(actual, {
+          myField: {
+            a: 2,
+          },
+        });
+      });
+      await t.step("drops map entry with missing key", () => {
+        const actual = parseBytes(
+          Uint8Array.from([
+            ...b`\x0a\x02\x10\x64`,
+            ...b`\x0a\x00`,
+          ]),
+          "Main",
+          {
+            "message Main": {
+              myField: {
+                type: "map<string,uint32>",
+                id: 1,
+              },
+            },
+          },
+        );
+        assertEquals(actual, {
+          myField: {},
+        });
+      });
+      await t.step("parses map with duplicate key within entry", () => {
+        const actual = parseBytes(
+          b`\x0a\x08\x0a\x01a\x0a\x01b\x10\x64`,
+          "Main",
+          {
+            "message Main": {
+              myField: {
+                type: "map<string,uint32>",
+                id: 1,
+              },
+            },
+          },
+        );
+        assertEquals(actual, {
+          myField: {
+            b: 100,
+          },
+        });
+      });
+      await t.step("parses map with unknown fields in entry", () => {
+        const actual = parseBytes(
+          b`\x0a\x0e\x18\x07\x0a\x01a\x22\x00\x10\x64\x2d\x01\x00\x00\x00`,
+          "Main",
+          {
+            "message Main": {
+              myField: {
+                type: "map<string,uint32>",
+                id: 1,
+              },
+            },
+          },
+        );
+        assertEquals(actual, {
+          myField: {
+            a: 100,
+          },
+        });
+      });
+      await t.step("parses map with message value", () => {
+        const actual = parseBytes(
+          Uint8Array.from([
+            ...b`\x0a\x07\x0a\x01a\x12\x02\x08\x2a`,
+            ...b`\x0a\x03\x0a\x01b`,
+            ...b`\x0a\x0b\x0a\x01c\x12\x02\x08\x01\x12\x02\x08\x02`,
+          ]),
+          "Main",
+          {
+            "message Main": {
+              myField: {
+                type: "map<string,Sub>",
+                id: 1,
+              },
+            },
+            "message Sub": {
+              submessageField: {
+                repeated: true,
+                type: "uint32",
+                id: 1,
+              },
+            },
+          },
+        );
+        assertEquals(actual, {
+          myField: {
+            a: { submessageField: [42] },
+            // protojson emits an empty message instead.
+            b: null,
+            // protojson merges the two occurrences instead.
+            c: { submessageField: [2] },
+          },
+        });
+      });
+      await t.step("parses map with enum value", () => {
+        const actual = parseBytes(
+          Uint8Array.from([
+            ...b`\x0a\x05\x0a\x01a\x10\x01`,
+            ...b`\x0a\x05\x0a\x01b\x10\x05`,
+            ...b`\x0a\x03\x0a\x01c`,
+          ]),
+          "Main",
+          {
+            "message Main": {
+              myField: {
+                type: "map<string,MyEnum>",
+                id: 1,
+              },
+            },
+            "enum MyEnum": {
+              MY_ENUM_UNSPECIFIED: 0,
+              MY_ENUM_VALUE_1: 1,
+              MY_ENUM_VALUE_2: 2,
+            },
+          },
+        );
+        assertEquals(actual, {
+          myField: {
+            a: "MY_ENUM_VALUE_1",
+            b: 5,
+            c: "MY_ENUM_UNSPECIFIED",
+          },
+        });
+      });
+      await t.step("parses map with wrapper value", () => {
+        const actual = parseBytes(
+          Uint8Array.from([
+            ...b`\x0a\x07\x0a\x01a\x12\x02\x08\x2a`,
+            ...b`\x0a\x05\x0a\x01b\x12\x00`,
+            ...b`\x0a\x03\x0a\x01c`,
+          ]),
+          "Main",
+          {
+            "message Main": {
+              myField: {
+                type: "map<string,google.protobuf.UInt32Value>",
+                id: 1,
+              },
+            },
+          },
+        );
+        assertEquals(actual, {
+          myField: {
+            a: 42,
+            b: 0,
+            // protojson emits 0 instead.
+            c: null,
+          },
+        });
+      });
+      await t.step("parses map with Struct value", () => {
+        const actual = parseBytes(
+          Uint8Array.from([
+            ...b`\x0a\x0e\x0a\x01a\x12\x09\x0a\x07\x0a\x01x\x12\x02\x08\x00`,
+            ...b`\x0a\x03\x0a\x01b`,
+          ]),
+          "Main",
+          {
+            "message Main": {
+              myField: {
+                type: "map<string,google.protobuf.Struct>",
+                id: 1,
+              },
+            },
+          },
+        );
+        assertEquals(actual, {
+          myField: {
+            a: { x: null },
+            // protojson emits {} instead.
+            b: null,
+          },
+        });
+      });
+      for (
+        const [keyType, entries] of [
+          ["int64", [
+            b`\x0a\x04\x08\x01\x10\x64`,
+            b`\x0a\x0d\x08\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01\x10\x65`,
+            b`\x0a\x0b\x08\x81\x80\x80\x80\x80\x80\x80\x10\x10\x66`,
+          ]],
+          ["sint64", [
+            b`\x0a\x04\x08\x02\x10\x64`,
+            b`\x0a\x04\x08\x01\x10\x65`,
+            b`\x0a\x0b\x08\x82\x80\x80\x80\x80\x80\x80\x20\x10\x66`,
+          ]],
+          ["sfixed64", [
+            b`\x0a\x0b\x09\x01\x00\x00\x00\x00\x00\x00\x00\x10\x64`,
+            b`\x0a\x0b\x09\xff\xff\xff\xff\xff\xff\xff\xff\x10\x65`,
+            b`\x0a\x0b\x09\x01\x00\x00\x00\x00\x00\x20\x00\x10\x66`,
+          ]],
+        ] as const
+      ) {
+        await t.step(`parses map with ${keyType} key`, () => {
+          const actual = parseBytes(
+            Uint8Array.from(entries.flatMap((entry) => [...entry])),
+            "Main",
+            {
+              "message Main": {
+                myField: {
+                  type: `map<${keyType},uint32>`,
+                  id: 1,
+                },
+              },
+            },
+          ) as { myField: Record<string, number> };
+          assertEquals(actual, {
+            myField: {
+              "1": 100,
+              "-1": 101,
+              "9007199254740993": 102,
+            },
+          });
+          // Array-index-like keys come first in JavaScript; protojson sorts
+          // the keys numerically instead.
+          assertEquals(Object.keys(actual.myField), [
+            "1",
+            "-1",
+            "9007199254740993",
+          ]);
+        });
+      }
+      await t.step("keeps the order of bool keys", () => {
+        const actual = parseBytes(
+          Uint8Array.from([
+            ...b`\x0a\x04\x08\x01\x10\x65`,
+            ...b`\x0a\x04\x08\x00\x10\x64`,
+          ]),
+          "Main",
+          {
+            "message Main": {
+              myField: {
+                type: "map<bool,uint32>",
+                id: 1,
+              },
+            },
+          },
+        ) as { myField: Record<string, number> };
+        assertEquals(actual, {
+          myField: {
+            false: 100,
+            true: 101,
+          },
+        });
+        // protojson sorts the keys instead.
+        assertEquals(Object.keys(actual.myField), ["true", "false"]);
+      });
       await t.step("parses group", () => {
         const actual = parseBytes(
           b`\x0b\x08\x2a\x0c`,
@@ -1240,6 +1822,215 @@ Deno.test("parseBytes", async (t) => {
           }],
         });
       });
+      await t.step("when the group is optional", async (t) => {
+        const typedefs = {
+          "message Main": {
+            myField: {
+              type: "Sub",
+              id: 1,
+              messageEncoding: "delimited",
+            },
+          },
+          "message Sub": {
+            submessageField: {
+              type: "uint32",
+              id: 1,
+            },
+          },
+        } as const;
+        await t.step("parses group", () => {
+          const actual = parseBytes(b`\x0b\x08\x2a\x0c`, "Main", typedefs);
+          assertEquals(actual, {
+            myField: { submessageField: 42 },
+          });
+        });
+        await t.step("parses empty group", () => {
+          const actual = parseBytes(b`\x0b\x0c`, "Main", typedefs);
+          // protojson emits null for the unpopulated proto2 scalar instead.
+          assertEquals(actual, {
+            myField: {},
+          });
+        });
+        await t.step("omits missing group", () => {
+          const actual = parseBytes(b``, "Main", typedefs);
+          // protojson emits null instead.
+          assertEquals(actual, {});
+        });
+        await t.step("rejects LEN-encoded group", () => {
+          // protobuf-go keeps it as an unknown field instead.
+          assertThrows(
+            () => parseBytes(b`\x0a\x02\x08\x2a`, "Main", typedefs),
+            Error,
+            "Expected wire type 3, got 2",
+          );
+        });
+        await t.step("rejects END_GROUP with wrong field number", () => {
+          assertThrows(
+            () => parseBytes(b`\x0b\x08\x2a\x14`, "Main", typedefs),
+            Error,
+            "Invalid group",
+          );
+        });
+        await t.step("rejects stray END_GROUP", () => {
+          assertThrows(
+            () => parseBytes(b`\x0c`, "Main", typedefs),
+            Error,
+            "Invalid group",
+          );
+        });
+        await t.step("rejects missing END_GROUP", () => {
+          assertThrows(
+            () => parseBytes(b`\x0b\x08\x2a`, "Main", typedefs),
+            Error,
+            "Unexpected EOF",
+          );
+        });
+        await t.step("parses unknown group", () => {
+          const actual = parseBytes(b`\x13\x08\x2a\x14`, "Main", typedefs);
+          // protojson discards it instead.
+          assertEquals(actual, {
+            "#2": { "#1": "unknown:int32:42" },
+          });
+        });
+        await t.step(
+          "rejects unknown group with wrong END_GROUP field number",
+          () => {
+            assertThrows(
+              () => parseBytes(b`\x13\x1c`, "Main", typedefs),
+              Error,
+              "Invalid group",
+            );
+          },
+        );
+      });
+      await t.step("parses nested group", () => {
+        const actual = parseBytes(
+          b`\x0b\x13\x18\x2a\x14\x0c`,
+          "Main",
+          {
+            "message Main": {
+              outerField: {
+                type: "Outer",
+                id: 1,
+                messageEncoding: "delimited",
+              },
+            },
+            "message Outer": {
+              innerField: {
+                type: "Inner",
+                id: 2,
+                messageEncoding: "delimited",
+              },
+            },
+            "message Inner": {
+              submessageField: {
+                type: "uint32",
+                id: 3,
+              },
+            },
+          },
+        );
+        assertEquals(actual, {
+          outerField: { innerField: { submessageField: 42 } },
+        });
+      });
+      await t.step("parses group in oneof", () => {
+        const typedefs = {
+          "message Main": {
+            uint32Field: {
+              type: "uint32",
+              id: 1,
+              oneofGroup: "myField",
+            },
+            groupField: {
+              type: "Sub",
+              id: 2,
+              messageEncoding: "delimited",
+              oneofGroup: "myField",
+            },
+          },
+          "message Sub": {
+            submessageField: {
+              type: "uint32",
+              id: 1,
+            },
+          },
+        } as const;
+        assertEquals(parseBytes(b`\x13\x08\x2a\x14`, "Main", typedefs), {
+          groupField: { submessageField: 42 },
+        });
+        // protojson only emits the last member instead.
+        assertEquals(
+          parseBytes(b`\x13\x08\x2a\x14\x08\x01`, "Main", typedefs),
+          {
+            uint32Field: 1,
+            groupField: { submessageField: 42 },
+          },
+        );
+      });
+      const recursiveTypedefs = {
+        "message Main": {
+          myField: {
+            type: "Sub",
+            id: 1,
+            messageEncoding: "delimited",
+          },
+        },
+        "message Sub": {
+          recursiveField: {
+            type: "Main",
+            id: 2,
+          },
+        },
+      } as const;
+      function recursiveGroup(levels: number): Uint8Array {
+        let input: number[] = [];
+        for (let i = 0; i < levels; i++) {
+          const length: number[] = [];
+          for (let n = input.length; ; n >>>= 7) {
+            if (n < 0x80) {
+              length.push(n);
+              break;
+            }
+            length.push((n & 0x7f) | 0x80);
+          }
+          input = [0x0b, 0x12, ...length, ...input, 0x0c];
+        }
+        return Uint8Array.from(input);
+      }
+      await t.step("parses recursive group", () => {
+        // Deep enough for lengths of several bytes.
+        let expected = {};
+        for (let i = 0; i < 200; i++) {
+          expected = { myField: { recursiveField: expected } };
+        }
+        const actual = parseBytes(
+          recursiveGroup(200),
+          "Main",
+          recursiveTypedefs,
+        );
+        assertEquals(actual, expected);
+      });
+      await t.step("runs out of stack on deep recursion", () => {
+        // There is no explicit limit; V8 runs out of stack at about 1000
+        // levels of nesting, that is 500 levels of the recursion.
+        assertThrows(
+          () => parseBytes(recursiveGroup(5000), "Main", recursiveTypedefs),
+          RangeError,
+          "Maximum call stack size exceeded",
+        );
+      });
+      await t.step("runs out of stack on deep unknown groups", () => {
+        const input = Uint8Array.from([
+          ...new Array(10001).fill(0x13),
+          ...new Array(10001).fill(0x14),
+        ]);
+        assertThrows(
+          () => parseBytes(input, "Main", {}),
+          RangeError,
+          "Maximum call stack size exceeded",
+        );
+      });
       await t.step("parses oneof", () => {
         const actual = parseBytes(
           b`\x12\x03\xe3\x81\x82`,